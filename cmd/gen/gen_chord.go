@@ -13,8 +13,11 @@ type chordEntry struct {
 	ID             int64
 	ChordQuality   chord.Quality
 	Root           pitch.Type
+	Bass           pitch.Type
+	Inversion      chord.Inversion
 	ChordQualityID int64
 	RootID         int64
+	BassID         int64
 	Name           string
 	ZeitlerNumber  int // Numbering according to Willam Zeitler's system
 	RingNumber     int // Numbering according to Ian Ring's system
@@ -26,34 +29,53 @@ var chordEntries []chordEntry
 func buildChordsTableSeed(logger *zap.Logger, writer io.Writer) error {
 	logger.Info("generating chords table seed")
 
+	// root position chords come first, followed by their inversions
 	id := int64(1)
 	for _, v := range chord.AllQualities() {
 		chordQualityID := findChordQualityID(v)
 		for _, w := range pitch.AllPitches() {
-			pitches := v.Pitches(w)
-			chordEntries = append(chordEntries, chordEntry{
-				ID:             id,
-				ChordQuality:   v,
-				Root:           w,
-				ChordQualityID: chordQualityID,
-				RootID:         findPitchID(w),
-				Name:           fmt.Sprintf("%s%s", w.String(), v.String()),
-				ZeitlerNumber:  pitch.Slice(pitches).ZeitlerSignature(),
-				RingNumber:     pitch.Slice(pitches).RingSignature(),
-				Pitches:        pitches,
-			})
+			chordEntries = append(chordEntries, newChordEntry(id, chordQualityID, chord.New(v, w)))
 			id++
 		}
 	}
 
-	_, _ = fmt.Fprintf(writer, "INSERT INTO chords (chord_quality_id, root_id, name, zeitler_number, ring_number)\nVALUES\n")
+	for _, v := range chord.AllQualities() {
+		chordQualityID := findChordQualityID(v)
+		for _, w := range pitch.AllPitches() {
+			for _, x := range v.Inversions(w)[1:] {
+				chordEntries = append(chordEntries, newChordEntry(id, chordQualityID, x))
+				id++
+			}
+		}
+	}
+
+	_, _ = fmt.Fprintf(writer, "INSERT INTO chords (chord_quality_id, root_id, bass_id, inversion, name, zeitler_number, ring_number)\nVALUES\n")
 	for i, v := range chordEntries {
 		if i < len(chordEntries)-1 {
-			_, _ = fmt.Fprintf(writer, "(%d, %d, '%s', %d, %d),\n", v.ChordQualityID, v.RootID, v.Name, v.ZeitlerNumber, v.RingNumber)
+			_, _ = fmt.Fprintf(writer, "(%d, %d, %d, %d, '%s', %d, %d),\n", v.ChordQualityID, v.RootID, v.BassID, v.Inversion, v.Name, v.ZeitlerNumber, v.RingNumber)
 		} else {
-			_, _ = fmt.Fprintf(writer, "(%d, %d, '%s', %d, %d);\n\n", v.ChordQualityID, v.RootID, v.Name, v.ZeitlerNumber, v.RingNumber)
+			_, _ = fmt.Fprintf(writer, "(%d, %d, %d, %d, '%s', %d, %d);\n\n", v.ChordQualityID, v.RootID, v.BassID, v.Inversion, v.Name, v.ZeitlerNumber, v.RingNumber)
 		}
 	}
 
 	return nil
 }
+
+func newChordEntry(id int64, chordQualityID int64, c chord.Chord) chordEntry {
+	inversion, _ := c.Inversion()
+	pitches := c.Pitches()
+	return chordEntry{
+		ID:             id,
+		ChordQuality:   c.Quality,
+		Root:           c.Root,
+		Bass:           c.Bass,
+		Inversion:      inversion,
+		ChordQualityID: chordQualityID,
+		RootID:         findPitchID(c.Root),
+		BassID:         findPitchID(c.Bass),
+		Name:           c.Name(),
+		ZeitlerNumber:  pitch.Slice(pitches).ZeitlerSignature(),
+		RingNumber:     pitch.Slice(pitches).RingSignature(),
+		Pitches:        pitches,
+	}
+}
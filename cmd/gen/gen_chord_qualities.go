@@ -6,6 +6,7 @@ import (
 	"io"
 
 	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/pcset"
 	"go.uber.org/zap"
 )
 
@@ -17,6 +18,10 @@ type chordQualityEntry struct {
 	RingNumber      int
 	PitchClass      []int
 	IntervalPattern []int
+	Intervals       []int
+	ForteNumber     string
+	PrimeForm       []int
+	IntervalVector  []int
 }
 
 var chordQualityEntries []chordQualityEntry
@@ -25,6 +30,8 @@ func buildChordQualitiesTableSeed(logger *zap.Logger, writer io.Writer) error {
 	logger.Info("generating chord_qualities table seed")
 
 	for i, v := range chord.AllQualities() {
+		set := pcset.New(v.PitchClass()...)
+		intervalVector := set.IntervalVector()
 		chordQualityEntries = append(chordQualityEntries, chordQualityEntry{
 			ID:              int64(i + 1),
 			Name:            v.String(),
@@ -33,17 +40,24 @@ func buildChordQualitiesTableSeed(logger *zap.Logger, writer io.Writer) error {
 			RingNumber:      v.RingNumber(),
 			PitchClass:      v.PitchClass(),
 			IntervalPattern: v.IntervalPattern(),
+			Intervals:       v.Intervals(),
+			ForteNumber:     set.ForteNumber(),
+			PrimeForm:       set.PrimeForm(pcset.Forte),
+			IntervalVector:  intervalVector[:],
 		})
 	}
 
-	_, _ = fmt.Fprintf(writer, "INSERT INTO chord_qualities (name, cardinality, zeitler_number, ring_number, pitch_class, interval_pattern)\nVALUES\n")
+	_, _ = fmt.Fprintf(writer, "INSERT INTO chord_qualities (name, cardinality, zeitler_number, ring_number, pitch_class, interval_pattern, intervals, forte_number, prime_form, interval_vector)\nVALUES\n")
 	for i, v := range chordQualityEntries {
 		encodedPitchClass, _ := json.Marshal(v.PitchClass)
 		encodedIntervalPattern, _ := json.Marshal(v.IntervalPattern)
+		encodedIntervals, _ := json.Marshal(v.Intervals)
+		encodedPrimeForm, _ := json.Marshal(v.PrimeForm)
+		encodedIntervalVector, _ := json.Marshal(v.IntervalVector)
 		if i < len(chordQualityEntries)-1 {
-			_, _ = fmt.Fprintf(writer, "('%s', %d, %d, %d, '%s', '%s'),\n", v.Name, v.Cardinality, v.ZeitlerNumber, v.RingNumber, encodedPitchClass, encodedIntervalPattern)
+			_, _ = fmt.Fprintf(writer, "('%s', %d, %d, %d, '%s', '%s', '%s', '%s', '%s', '%s'),\n", v.Name, v.Cardinality, v.ZeitlerNumber, v.RingNumber, encodedPitchClass, encodedIntervalPattern, encodedIntervals, v.ForteNumber, encodedPrimeForm, encodedIntervalVector)
 		} else {
-			_, _ = fmt.Fprintf(writer, "('%s', %d, %d, %d, '%s', '%s');\n\n", v.Name, v.Cardinality, v.ZeitlerNumber, v.RingNumber, encodedPitchClass, encodedIntervalPattern)
+			_, _ = fmt.Fprintf(writer, "('%s', %d, %d, %d, '%s', '%s', '%s', '%s', '%s', '%s');\n\n", v.Name, v.Cardinality, v.ZeitlerNumber, v.RingNumber, encodedPitchClass, encodedIntervalPattern, encodedIntervals, v.ForteNumber, encodedPrimeForm, encodedIntervalVector)
 		}
 	}
 
@@ -16,9 +16,14 @@ func buildKeyPitchChordsTableSeed(logger *zap.Logger, writer io.Writer) error {
 		ChordID int64
 	}
 
+	// chords are linked in root position only, their inversions share the same pitches
 	entries := make([]entry, 0)
 	for _, key := range keyEntries {
 		for _, chord := range chordEntries {
+			if chord.Inversion != 0 {
+				continue
+			}
+
 			if key.ZeitlerNumber&chord.ZeitlerNumber == chord.ZeitlerNumber {
 				entries = append(entries, entry{KeyID: key.ID, PitchID: chord.RootID, ChordID: chord.ID})
 			}
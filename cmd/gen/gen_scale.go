@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/edipermadi/music-db/pkg/theory/pcset"
 	"github.com/edipermadi/music-db/pkg/theory/scale"
 	"go.uber.org/zap"
 )
@@ -26,6 +27,9 @@ type scaleEntry struct {
 	ReflectionalSymmetryAxes []int
 	Balanced                 bool
 	FifthGeneratorRootDegree int
+	ForteNumber              string
+	PrimeForm                []int
+	IntervalVector           []int
 }
 
 var scaleEntries []scaleEntry
@@ -35,7 +39,7 @@ func buildScalesTableSeed(logger *zap.Logger, writer io.Writer) error {
 	max := len(allScales)
 
 	logger.Info("generating scale seed")
-	_, _ = fmt.Fprintf(writer, "INSERT INTO scales (name, cardinality, zeitler_number, ring_number, perfection, imperfection, pitch_class, interval_pattern, rotational_symmetric, rotational_symmetry_level, palindromic, reflectional_symmetric, reflectional_symmetry_axes, balanced, fifth_generator_root_degree)\nVALUES\n")
+	_, _ = fmt.Fprintf(writer, "INSERT INTO scales (name, cardinality, zeitler_number, ring_number, perfection, imperfection, pitch_class, interval_pattern, rotational_symmetric, rotational_symmetry_level, palindromic, reflectional_symmetric, reflectional_symmetry_axes, balanced, fifth_generator_root_degree, forte_number, prime_form, interval_vector)\nVALUES\n")
 	for i, v := range allScales {
 		result := v.Perfection()
 		pitchClass := v.PitchClass()
@@ -51,6 +55,12 @@ func buildScalesTableSeed(logger *zap.Logger, writer io.Writer) error {
 		balanced := v.Balanced()
 		fifthGenerator := v.FifthGeneratorRoot()
 		fifthGeneratorRootDegree := int(fifthGenerator.Root().Degree)
+		set := pcset.New(pitchClass...)
+		forteNumber := set.ForteNumber()
+		primeForm := set.PrimeForm(pcset.Forte)
+		encodedPrimeForm, _ := json.Marshal(primeForm)
+		intervalVector := set.IntervalVector()
+		encodedIntervalVector, _ := json.Marshal(intervalVector)
 		scaleEntries = append(scaleEntries, scaleEntry{
 			ID:                       int64(i + 1),
 			Name:                     v.String(),
@@ -68,12 +78,15 @@ func buildScalesTableSeed(logger *zap.Logger, writer io.Writer) error {
 			ReflectionalSymmetryAxes: reflectiveSymmetryAxes,
 			Balanced:                 balanced,
 			FifthGeneratorRootDegree: fifthGeneratorRootDegree,
+			ForteNumber:              forteNumber,
+			PrimeForm:                primeForm,
+			IntervalVector:           intervalVector[:],
 		})
 
 		if i < max-1 {
-			_, _ = fmt.Fprintf(writer, "\t('%s', %d, %d, %d, %d, %d, '%s', '%s', %t, %d, %t, %t, '%s', %t, %d),\n", v.String(), v.Cardinality(), v.ZeitlerNumber(), v.RingNumber(), result.Perfection, result.Imperfection, encodedPitchClass, encodedIntervalPattern, rotationalSymmetric, rotationalSymmetryLevel, palindromic, reflectiveSymmetric, encodedReflectiveSymmetryAxes, balanced, fifthGeneratorRootDegree)
+			_, _ = fmt.Fprintf(writer, "\t('%s', %d, %d, %d, %d, %d, '%s', '%s', %t, %d, %t, %t, '%s', %t, %d, '%s', '%s', '%s'),\n", v.String(), v.Cardinality(), v.ZeitlerNumber(), v.RingNumber(), result.Perfection, result.Imperfection, encodedPitchClass, encodedIntervalPattern, rotationalSymmetric, rotationalSymmetryLevel, palindromic, reflectiveSymmetric, encodedReflectiveSymmetryAxes, balanced, fifthGeneratorRootDegree, forteNumber, encodedPrimeForm, encodedIntervalVector)
 		} else {
-			_, _ = fmt.Fprintf(writer, "\t('%s', %d, %d, %d, %d, %d, '%s', '%s', %t, %d, %t, %t, '%s', %t, %d);\n\n", v.String(), v.Cardinality(), v.ZeitlerNumber(), v.RingNumber(), result.Perfection, result.Imperfection, encodedPitchClass, encodedIntervalPattern, rotationalSymmetric, rotationalSymmetryLevel, palindromic, reflectiveSymmetric, encodedReflectiveSymmetryAxes, balanced, fifthGeneratorRootDegree)
+			_, _ = fmt.Fprintf(writer, "\t('%s', %d, %d, %d, %d, %d, '%s', '%s', %t, %d, %t, %t, '%s', %t, %d, '%s', '%s', '%s');\n\n", v.String(), v.Cardinality(), v.ZeitlerNumber(), v.RingNumber(), result.Perfection, result.Imperfection, encodedPitchClass, encodedIntervalPattern, rotationalSymmetric, rotationalSymmetryLevel, palindromic, reflectiveSymmetric, encodedReflectiveSymmetryAxes, balanced, fifthGeneratorRootDegree, forteNumber, encodedPrimeForm, encodedIntervalVector)
 		}
 	}
 
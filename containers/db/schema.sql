@@ -19,27 +19,36 @@ CREATE TABLE chord_qualities
     zeitler_number   INTEGER NOT NULL,
     ring_number      INTEGER NOT NULL,
     pitch_class      JSONB   NOT NULL,
-    interval_pattern JSONB   NOT NULL
+    interval_pattern JSONB   NOT NULL,
+    intervals        JSONB   NOT NULL,
+    forte_number     TEXT    NOT NULL,
+    prime_form       JSONB   NOT NULL,
+    interval_vector  JSONB   NOT NULL
 );
 
 CREATE UNIQUE INDEX ON chord_qualities (name);
 CREATE UNIQUE INDEX ON chord_qualities (zeitler_number);
 CREATE UNIQUE INDEX ON chord_qualities (ring_number);
 CREATE INDEX ON chord_qualities (cardinality);
+CREATE INDEX ON chord_qualities (forte_number);
 
 CREATE TABLE chords
 (
     id               BIGSERIAL PRIMARY KEY,
     chord_quality_id BIGINT  NOT NULL REFERENCES chord_qualities (id),
     root_id          BIGINT  NOT NULL REFERENCES pitches (id),
+    bass_id          BIGINT  NOT NULL REFERENCES pitches (id),
+    inversion        INTEGER NOT NULL,
     name             TEXT    NOT NULL,
     zeitler_number   INTEGER NOT NULL,
     ring_number      INTEGER NOT NULL
 );
 
-CREATE UNIQUE INDEX ON chords (chord_quality_id, root_id);
+CREATE UNIQUE INDEX ON chords (chord_quality_id, root_id, bass_id);
 CREATE INDEX ON chords (chord_quality_id);
 CREATE INDEX ON chords (root_id);
+CREATE INDEX ON chords (bass_id);
+CREATE INDEX ON chords (inversion);
 CREATE INDEX ON chords (zeitler_number);
 CREATE INDEX ON chords (ring_number);
 
@@ -71,7 +80,10 @@ CREATE TABLE scales
     reflectional_symmetric      BOOLEAN NOT NULL,
     reflectional_symmetry_axes  JSONB   NOT NULL,
     balanced                    BOOLEAN NOT NULL,
-    fifth_generator_root_degree INTEGER NOT NULL
+    fifth_generator_root_degree INTEGER NOT NULL,
+    forte_number                TEXT    NOT NULL,
+    prime_form                  JSONB   NOT NULL,
+    interval_vector             JSONB   NOT NULL
 );
 
 CREATE UNIQUE INDEX ON scales (name);
@@ -86,6 +98,7 @@ CREATE INDEX ON scales (palindromic);
 CREATE INDEX ON scales (reflectional_symmetric);
 CREATE INDEX ON scales (balanced);
 CREATE INDEX ON scales (fifth_generator_root_degree);
+CREATE INDEX ON scales (forte_number);
 
 CREATE TABLE keys
 (
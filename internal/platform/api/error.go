@@ -10,5 +10,6 @@ type Error struct {
 var (
 	ErrInternalServer    = Error{Code: 500101, Message: "internal server error"}
 	ErrBadQueryParameter = Error{Code: 400101, Message: "bad query parameter"}
+	ErrBadRequestBody    = Error{Code: 400102, Message: "bad request body"}
 	ErrResourceNotFound  = Error{Code: 404101, Message: "resource not found"}
 )
@@ -0,0 +1,120 @@
+package theory
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+
+	"github.com/edipermadi/music-db/internal/platform/api"
+	"github.com/edipermadi/music-db/pkg/midi"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/tuning"
+	"github.com/youpy/go-wav"
+	"go.uber.org/zap"
+)
+
+const sampleRate = 44100
+
+// encodeWav normalizes rendered samples to the peak amplitude and encodes them as 16 bit stereo wav file
+func encodeWav(left []float32, right []float32) ([]byte, error) {
+	numSamples := len(left)
+
+	// determine peak amplitude
+	var maxValue float64
+	for i := 0; i < numSamples; i++ {
+		absLeft := math.Abs(float64(left[i]))
+		absRight := math.Abs(float64(right[i]))
+		if maxValue < absLeft {
+			maxValue = absLeft
+		}
+		if maxValue < absRight {
+			maxValue = absRight
+		}
+	}
+
+	// convert to integer relative to amplitude
+	var a float32
+	if maxValue > 0 {
+		a = 32768 * float32(0.99/maxValue)
+	}
+
+	samples := make([]wav.Sample, numSamples)
+	for i := 0; i < numSamples; i++ {
+		samples[i].Values[0] = int(a * left[i])
+		samples[i].Values[1] = int(a * right[i])
+	}
+
+	var buff bytes.Buffer
+	encoder := wav.NewWriter(&buff, uint32(numSamples), 2, sampleRate, 16)
+	if err := encoder.WriteSamples(samples); err != nil {
+		return nil, err
+	}
+
+	return buff.Bytes(), nil
+}
+
+// replyWav encodes rendered samples and writes them as wav file
+func (h theoryHandler) replyWav(writer http.ResponseWriter, left []float32, right []float32, filename string) {
+	encoded, err := encodeWav(left, right)
+	if err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to convert PCM to wav file")
+		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "audio/wav")
+	writer.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", filename))
+	writer.WriteHeader(http.StatusOK)
+	_, _ = io.Copy(writer, bytes.NewReader(encoded))
+}
+
+// replySequenceWav plays notes sequentially according to sequence filter and writes them as wav file
+func (h theoryHandler) replySequenceWav(writer http.ResponseWriter, notes []pitch.Note, filter SequenceFilter, t tuning.Tuning, filename string) {
+	sequence, err := filter.Sequence(notes, t)
+	if err != nil {
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	h.replyRenderedWav(writer, sequence, filename)
+}
+
+// replyRenderedWav renders sequence using synthesizer and writes it as wav file
+func (h theoryHandler) replyRenderedWav(writer http.ResponseWriter, sequence midi.Sequence, filename string) {
+	// instantiate synthesizer
+	synthesizer, err := h.synthesizerFactory.Instantiate(int32(sampleRate))
+	if err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to instantiate synthesizer")
+		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
+		return
+	}
+
+	left, right := sequence.Render(synthesizer, sampleRate)
+	h.replyWav(writer, left, right, filename)
+}
+
+// replyMidi encodes sequence as standard MIDI file using parameters of MIDI filter
+func (h theoryHandler) replyMidi(writer http.ResponseWriter, sequence midi.Sequence, filter MidiFilter, filename string) {
+	sequence.Program = filter.Program
+	sequence.Velocity = filter.Velocity
+
+	file, err := sequence.File(midi.Format(filter.Format))
+	if err != nil {
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	encoded, err := file.Bytes()
+	if err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to encode midi file")
+		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "audio/midi")
+	writer.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", filename))
+	writer.WriteHeader(http.StatusOK)
+	_, _ = io.Copy(writer, bytes.NewReader(encoded))
+}
@@ -4,9 +4,35 @@ import "errors"
 
 // Error messages
 var (
-	ErrScaleNotFound        = errors.New("scale not found")
-	ErrKeyNotFound          = errors.New("key not found")
-	ErrChordNotFound        = errors.New("chord not found")
-	ErrChordQualityNotFound = errors.New("chord quality not found")
-	ErrPitchNotFound        = errors.New("pitch not found")
+	ErrScaleNotFound         = errors.New("scale not found")
+	ErrKeyNotFound           = errors.New("key not found")
+	ErrChordNotFound         = errors.New("chord not found")
+	ErrChordQualityNotFound  = errors.New("chord quality not found")
+	ErrPitchNotFound         = errors.New("pitch not found")
+	ErrInvalidPitch          = errors.New("invalid pitch")
+	ErrInvalidTuning         = errors.New("invalid tuning")
+	ErrInvalidDirection      = errors.New("invalid direction")
+	ErrInvalidMidiFile       = errors.New("invalid midi file")
+	ErrInvalidDistribution   = errors.New("invalid pitch distribution")
+	ErrInvalidProfile        = errors.New("invalid key profile")
+	ErrInvalidChordSymbol    = errors.New("invalid chord symbol")
+	ErrUnsupportedSlashChord = errors.New("slash chord bass is not a chord pitch")
+	ErrInvalidTransformation = errors.New("invalid transformation")
+	ErrChordNotTriad         = errors.New("chord is not a major or minor triad")
+	ErrChordPathNotFound     = errors.New("chord path not found")
+	ErrEmptyProgression      = errors.New("empty chord progression")
+	ErrInvalidStyle          = errors.New("invalid progression style")
+	ErrProgressionNotFound   = errors.New("progression not found")
+	ErrProgressionTooLong    = errors.New("chord progression too long")
+	ErrInvalidVoiceRange     = errors.New("invalid voice range")
+	ErrVoiceLeadingNotFound  = errors.New("voice leading not found")
+	ErrInvalidVoicing        = errors.New("invalid chord voicing")
+	ErrVoicingNotApplicable  = errors.New("chord voicing not applicable to chord")
+	ErrInvalidInstrument     = errors.New("invalid instrument tuning")
+	ErrInvalidFormat         = errors.New("invalid illustration format")
+	ErrInvalidPalette        = errors.New("invalid illustration palette")
+	ErrInvalidLabelStyle     = errors.New("invalid label style")
+	ErrInvalidKeyboardRange  = errors.New("invalid keyboard range")
+	ErrInvalidStaff          = errors.New("invalid staff")
+	ErrInvalidIntervalVector = errors.New("invalid interval vector")
 )
@@ -12,9 +12,12 @@ import (
 type Handler interface {
 	InstallEndpoints(router *mux.Router)
 
+	analysisHandlers
 	chordHandlers
+	identificationHandlers
 	keyHandlers
 	pitchHandlers
+	progressionHandlers
 	scaleHandlers
 }
 
@@ -36,8 +39,11 @@ type theoryHandler struct {
 }
 
 func (h theoryHandler) InstallEndpoints(router *mux.Router) {
+	h.installAnalysisEndpoints(router)
 	h.installChordEndpoints(router)
+	h.installIdentificationEndpoints(router)
 	h.installKeyEndpoints(router)
 	h.installPitchEndpoints(router)
+	h.installProgressionEndpoints(router)
 	h.installScaleEndpoints(router)
 }
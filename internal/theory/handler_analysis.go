@@ -0,0 +1,90 @@
+package theory
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/edipermadi/music-db/internal/platform/api"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+// maxMidiFileSize is maximum size of uploaded MIDI file in bytes
+const maxMidiFileSize = 4 << 20
+
+type analysisHandlers interface {
+	AnalyzeMidi(writer http.ResponseWriter, request *http.Request)
+	EstimateKeys(writer http.ResponseWriter, request *http.Request)
+}
+
+func (h theoryHandler) installAnalysisEndpoints(router *mux.Router) {
+	router.HandleFunc("/analyze/midi", h.AnalyzeMidi).Methods(http.MethodPost).Name("ANALYZE_MIDI")
+	router.HandleFunc("/analyze/keys", h.EstimateKeys).Methods(http.MethodGet).Name("ESTIMATE_KEYS")
+}
+
+func (h theoryHandler) AnalyzeMidi(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	var data AnalysisFilter
+	if err := h.decoder.Decode(&data, request.URL.Query()); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to analyze midi file")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	// accept either raw file as request body or multipart form with file field
+	request.Body = http.MaxBytesReader(writer, request.Body, maxMidiFileSize)
+
+	var reader io.Reader = request.Body
+	if strings.HasPrefix(request.Header.Get("Content-Type"), "multipart/form-data") {
+		file, _, err := request.FormFile("file")
+		if err != nil {
+			h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadRequestBody)
+			return
+		}
+
+		defer func() { _ = file.Close() }()
+		reader = file
+	}
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadRequestBody)
+		return
+	}
+
+	analysis, err := h.service.AnalyzeMidi(ctx, content, data)
+	switch {
+	case errors.Is(err, ErrInvalidMidiFile):
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadRequestBody)
+	case err != nil:
+		h.Logger().With(zap.Error(err)).Error("failed to analyze midi file")
+		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
+	default:
+		h.ReplyJSON(writer, http.StatusOK, analysis)
+	}
+}
+
+func (h theoryHandler) EstimateKeys(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	var data KeyEstimationFilter
+	if err := h.decoder.Decode(&data, request.URL.Query()); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to estimate keys")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	keys, err := h.service.EstimateKeys(ctx, data)
+	switch {
+	case errors.Is(err, ErrInvalidDistribution), errors.Is(err, ErrInvalidProfile):
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+	case err != nil:
+		h.Logger().With(zap.Error(err)).Error("failed to estimate keys")
+		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
+	default:
+		h.ReplyJSON(writer, http.StatusOK, keys)
+	}
+}
@@ -0,0 +1,158 @@
+package theory_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/edipermadi/music-db/internal/theory"
+	"github.com/edipermadi/music-db/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTheoryHandler_AnalyzeMidi(t *testing.T) {
+	testCases := []handlerTestCase{
+		{
+			Title: "Returns200WhenSucceeded",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				AnalyzeMidi: []interface{}{&theory.MidiAnalysis{Format: 1, Tracks: 2}, nil},
+			},
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Title: "Returns400WhenFileIsInvalid",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				AnalyzeMidi: []interface{}{nil, theory.ErrInvalidMidiFile},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns500WhenFailed",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				AnalyzeMidi: []interface{}{nil, errors.New("error")},
+			},
+			ExpectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			server := tc.mockServer()
+			defer server.Close()
+
+			resp, err := tc.httpPost("/analyze/midi", "audio/midi", bytes.NewReader([]byte("MThd")))
+			require.NoError(t, err)
+
+			defer func() { _ = resp.Body.Close() }()
+
+			require.Equal(t, tc.ExpectedStatus, resp.StatusCode)
+			if tc.ExpectedStatus == http.StatusOK {
+				var decoded theory.MidiAnalysis
+				require.NoError(t, json.NewDecoder(resp.Body).Decode(&decoded))
+				require.NotEmpty(t, decoded)
+			}
+		})
+	}
+}
+
+func TestTheoryHandler_AnalyzeMidi_Multipart(t *testing.T) {
+	tc := handlerTestCase{
+		ServiceReturnValues: mock.TheoryServiceReturnValues{
+			AnalyzeMidi: []interface{}{&theory.MidiAnalysis{Format: 1, Tracks: 2}, nil},
+		},
+	}
+
+	server := tc.mockServer()
+	defer server.Close()
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	part, err := form.CreateFormFile("file", "song.mid")
+	require.NoError(t, err)
+	_, err = part.Write([]byte("MThd"))
+	require.NoError(t, err)
+	require.NoError(t, form.Close())
+
+	resp, err := tc.httpPost("/analyze/midi", form.FormDataContentType(), &body)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// multipart form without file field is rejected
+	body.Reset()
+	form = multipart.NewWriter(&body)
+	require.NoError(t, form.WriteField("name", "song.mid"))
+	require.NoError(t, form.Close())
+
+	resp, err = tc.httpPost("/analyze/midi", form.FormDataContentType(), &body)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestTheoryHandler_EstimateKeys(t *testing.T) {
+	testCases := []handlerTestCase{
+		{
+			Title: "Returns200WhenSucceeded",
+			GivenQueryStrings: url.Values{
+				"weight":  []string{"4", "0", "2", "0", "3", "2", "0", "4", "0", "2", "0", "1"},
+				"profile": []string{"aarden_essen"},
+			},
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				EstimateKeys: []interface{}{[]theory.EstimatedKey{{ID: 1, Name: "CNaturalIonian", Correlation: 0.9}}, nil},
+			},
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Title: "Returns400WhenWeightIsMalformed",
+			GivenQueryStrings: url.Values{
+				"weight": []string{"a"},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns400WhenDistributionIsInvalid",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				EstimateKeys: []interface{}{nil, theory.ErrInvalidDistribution},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns400WhenProfileIsInvalid",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				EstimateKeys: []interface{}{nil, theory.ErrInvalidProfile},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns500WhenFailed",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				EstimateKeys: []interface{}{nil, errors.New("error")},
+			},
+			ExpectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			server := tc.mockServer()
+			defer server.Close()
+
+			resp, err := tc.httpGet("/analyze/keys")
+			require.NoError(t, err)
+
+			defer func() { _ = resp.Body.Close() }()
+
+			require.Equal(t, tc.ExpectedStatus, resp.StatusCode)
+			if tc.ExpectedStatus == http.StatusOK {
+				var decoded []theory.EstimatedKey
+				require.NoError(t, json.NewDecoder(resp.Body).Decode(&decoded))
+				require.NotEmpty(t, decoded)
+			}
+		})
+	}
+}
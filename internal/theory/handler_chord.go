@@ -1,20 +1,17 @@
 package theory
 
 import (
-	"bytes"
 	"errors"
 	"fmt"
-	"image/png"
-	"io"
-	"math"
 	"net/http"
 	"strconv"
 
 	"github.com/edipermadi/music-db/internal/platform/api"
 	"github.com/edipermadi/music-db/pkg/illustations"
+	"github.com/edipermadi/music-db/pkg/midi"
+	"github.com/edipermadi/music-db/pkg/theory/chord"
 	"github.com/edipermadi/music-db/pkg/theory/pitch"
 	"github.com/gorilla/mux"
-	"github.com/youpy/go-wav"
 	"go.uber.org/zap"
 )
 
@@ -25,17 +22,31 @@ type chordHandlers interface {
 	ListChordPitches(writer http.ResponseWriter, request *http.Request)
 	ListChordScales(writer http.ResponseWriter, request *http.Request)
 	ListChords(writer http.ResponseWriter, request *http.Request)
+	LookupChord(writer http.ResponseWriter, request *http.Request)
+	ListChordTransformations(writer http.ResponseWriter, request *http.Request)
+	FindChordPath(writer http.ResponseWriter, request *http.Request)
+	ListChordFingerings(writer http.ResponseWriter, request *http.Request)
+	IllustrateChordWithChordBox(writer http.ResponseWriter, request *http.Request)
 }
 
 func (h theoryHandler) installChordEndpoints(router *mux.Router) {
 	router.HandleFunc("/chords", h.ListChords).Methods(http.MethodGet).Name("LIST_CHORDS")
+	router.HandleFunc("/chords/lookup", h.LookupChord).Methods(http.MethodGet).Name("LOOKUP_CHORD")
 	router.HandleFunc("/chords/{id:[0-9]+}", h.GetChord).Methods(http.MethodGet).Name("GET_CHORD")
 	router.HandleFunc("/chords/{id:[0-9]+}/keys", h.ListChordKeys).Methods(http.MethodGet).Name("LIST_CHORD_KEYS")
 	router.HandleFunc("/chords/{id:[0-9]+}/pitches", h.ListChordPitches).Methods(http.MethodGet).Name("GET_CHORD_PITCHES")
 	router.HandleFunc("/chords/{id:[0-9]+}/quality", h.GetChordQuality).Methods(http.MethodGet).Name("GET_CHORD_QUALITY")
 	router.HandleFunc("/chords/{id:[0-9]+}/scales", h.ListChordScales).Methods(http.MethodGet).Name("LIST_CHORD_SCALES")
+	router.HandleFunc("/chords/{id:[0-9]+}/transformations", h.ListChordTransformations).Methods(http.MethodGet).Name("LIST_CHORD_TRANSFORMATIONS")
+	router.HandleFunc("/chords/{id:[0-9]+}/fingerings", h.ListChordFingerings).Methods(http.MethodGet).Name("LIST_CHORD_FINGERINGS")
+	router.HandleFunc("/chords/{id:[0-9]+}/paths/{target_id:[0-9]+}", h.FindChordPath).Methods(http.MethodGet).Name("FIND_CHORD_PATH")
+	router.HandleFunc("/chords/{id:[0-9]+}/paths/{target_id:[0-9]+}/illustrations/tonnetz", h.IllustrateChordPathWithTonnetz).Methods(http.MethodGet).Name("ILLUSTRATE_CHORD_PATH_WITH_TONNETZ")
+	router.HandleFunc("/chords/{id:[0-9]+}/illustrations/chord_box", h.IllustrateChordWithChordBox).Methods(http.MethodGet).Name("ILLUSTRATE_CHORD_WITH_CHORD_BOX")
 	router.HandleFunc("/chords/{id:[0-9]+}/illustrations/keyboard", h.IllustrateChordWithKeyboard).Methods(http.MethodGet).Name("ILLUSTRATE_CHORD_WITH_KEYBOARD")
+	router.HandleFunc("/chords/{id:[0-9]+}/illustrations/staff", h.IllustrateChordWithStaff).Methods(http.MethodGet).Name("ILLUSTRATE_CHORD_WITH_STAFF")
+	router.HandleFunc("/chords/{id:[0-9]+}/illustrations/tonnetz", h.IllustrateChordWithTonnetz).Methods(http.MethodGet).Name("ILLUSTRATE_CHORD_WITH_TONNETZ")
 	router.HandleFunc("/chords/{id:[0-9]+}/illustrations/wav", h.IllustrateChordAsWavFile).Methods(http.MethodGet).Name("ILLUSTRATE_CHORD_AS_WAVE_FILE")
+	router.HandleFunc("/chords/{id:[0-9]+}/illustrations/midi", h.IllustrateChordAsMidiFile).Methods(http.MethodGet).Name("ILLUSTRATE_CHORD_AS_MIDI_FILE")
 }
 
 func (h theoryHandler) ListChords(writer http.ResponseWriter, request *http.Request) {
@@ -53,6 +64,12 @@ func (h theoryHandler) ListChords(writer http.ResponseWriter, request *http.Requ
 		return
 	}
 
+	if err := data.ChordFilter.Validate(); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to list chords")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
 	chords, paginationOut, err := h.service.ListChords(ctx, data.ChordFilter, data.Pagination)
 	if err != nil {
 		h.Logger().With(zap.Error(err)).Error("failed to list chords")
@@ -63,6 +80,34 @@ func (h theoryHandler) ListChords(writer http.ResponseWriter, request *http.Requ
 	}
 }
 
+func (h theoryHandler) LookupChord(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	type params struct {
+		Symbol string `form:"symbol"`
+	}
+
+	var data params
+	if err := h.decoder.Decode(&data, request.URL.Query()); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to lookup chord")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	chord, err := h.service.LookupChord(ctx, data.Symbol)
+	switch {
+	case errors.Is(err, ErrInvalidChordSymbol), errors.Is(err, ErrUnsupportedSlashChord):
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+	case errors.Is(err, ErrChordNotFound):
+		h.ReplyJSON(writer, http.StatusNotFound, api.ErrResourceNotFound)
+	case err != nil:
+		h.Logger().With(zap.Error(err)).Error("failed to lookup chord")
+		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
+	default:
+		h.ReplyJSON(writer, http.StatusOK, chord)
+	}
+}
+
 func (h theoryHandler) ListChordPitches(writer http.ResponseWriter, request *http.Request) {
 	ctx := request.Context()
 
@@ -117,6 +162,12 @@ func (h theoryHandler) ListChordScales(writer http.ResponseWriter, request *http
 		return
 	}
 
+	if err := data.ScaleFilter.Validate(); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to list chord scales")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
 	chordID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
 	scales, paginationOut, err := h.service.ListChordScales(ctx, chordID, data.ScaleFilter, data.Pagination)
 	if err != nil {
@@ -160,53 +211,300 @@ func (h theoryHandler) GetChordQuality(writer http.ResponseWriter, request *http
 	}
 }
 
-func (h theoryHandler) IllustrateChordWithKeyboard(writer http.ResponseWriter, request *http.Request) {
+func (h theoryHandler) ListChordTransformations(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	chordID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
+	transformations, err := h.service.ListChordTransformations(ctx, chordID)
+	switch {
+	case errors.Is(err, ErrChordNotFound), errors.Is(err, ErrChordNotTriad):
+		h.ReplyJSON(writer, http.StatusNotFound, api.ErrResourceNotFound)
+	case err != nil:
+		h.Logger().With(zap.Error(err)).Error("failed to list chord transformations")
+		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
+	default:
+		h.ReplyJSON(writer, http.StatusOK, transformations)
+	}
+}
+
+func (h theoryHandler) FindChordPath(writer http.ResponseWriter, request *http.Request) {
+	if path, ok := h.findChordPath(writer, request); ok {
+		h.ReplyJSON(writer, http.StatusOK, path)
+	}
+}
+
+func (h theoryHandler) ListChordFingerings(writer http.ResponseWriter, request *http.Request) {
 	ctx := request.Context()
 
+	var data FingeringFilter
+	if err := h.decoder.Decode(&data, request.URL.Query()); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to parse fingering parameters")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	chordID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
+	fingerings, err := h.service.ListChordFingerings(ctx, chordID, data)
+	switch {
+	case errors.Is(err, ErrInvalidInstrument):
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+	case errors.Is(err, ErrChordNotFound):
+		h.ReplyJSON(writer, http.StatusNotFound, api.ErrResourceNotFound)
+	case err != nil:
+		h.Logger().With(zap.Error(err)).Error("failed to list chord fingerings")
+		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
+	default:
+		h.ReplyJSON(writer, http.StatusOK, fingerings)
+	}
+}
+
+func (h theoryHandler) IllustrateChordWithChordBox(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	type params struct {
+		FingeringFilter
+		Index int `form:"index"`
+	}
+
+	var data params
+	if err := h.decoder.Decode(&data, request.URL.Query()); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to parse fingering parameters")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	options, ok := h.illustrationOptions(writer, request)
+	if !ok {
+		return
+	}
+
+	if data.Index < 0 || data.Index >= 100 {
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
 	chordID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
 
 	// get chord
-	chord, err := h.service.GetChord(ctx, chordID)
+	detailed, err := h.service.GetChord(ctx, chordID)
 	switch {
 	case errors.Is(err, ErrChordNotFound):
 		h.ReplyJSON(writer, http.StatusNotFound, api.ErrResourceNotFound)
 		return
 	case err != nil:
-		h.Logger().With(zap.Error(err)).Error("failed to list chord pitches")
+		h.Logger().With(zap.Error(err)).Error("failed to get chord")
 		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
 		return
 	}
 
-	// list pitches
-	simplifiedPitches, err := h.service.ListChordPitches(ctx, chordID)
+	// list fingerings up to the requested one, the easiest one by default
+	data.Limit = data.Index + 1
+	fingerings, err := h.service.ListChordFingerings(ctx, chordID, data.FingeringFilter)
+	switch {
+	case errors.Is(err, ErrInvalidInstrument):
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	case errors.Is(err, ErrChordNotFound):
+		h.ReplyJSON(writer, http.StatusNotFound, api.ErrResourceNotFound)
+		return
+	case err != nil:
+		h.Logger().With(zap.Error(err)).Error("failed to list chord fingerings")
+		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
+		return
+	case data.Index >= len(fingerings):
+		h.ReplyJSON(writer, http.StatusNotFound, api.ErrResourceNotFound)
+		return
+	}
+
+	// draw chord box illustration
+	fingering := fingerings[data.Index]
+	img, err := illustations.ChordBox(fingering.Strings, fingering.Frets, pitch.FromInt(int(detailed.Root.ID)), options)
+	if err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to draw chord box illustration for chord")
+		writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	h.replyIllustration(writer, img, fmt.Sprintf("%sChordBox", detailed.Name))
+}
+
+func (h theoryHandler) IllustrateChordWithTonnetz(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	options, ok := h.illustrationOptions(writer, request)
+	if !ok {
+		return
+	}
+
+	chordID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
+
+	// get chord
+	detailed, err := h.service.GetChord(ctx, chordID)
+	switch {
+	case errors.Is(err, ErrChordNotFound):
+		h.ReplyJSON(writer, http.StatusNotFound, api.ErrResourceNotFound)
+		return
+	case err != nil:
+		h.Logger().With(zap.Error(err)).Error("failed to get chord")
+		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
+		return
+	}
+
+	// only major and minor triads are found on tonnetz
+	triad := detailedChord(*detailed)
+	if !triad.IsTriad() {
+		h.ReplyJSON(writer, http.StatusNotFound, api.ErrResourceNotFound)
+		return
+	}
+
+	h.replyTonnetz(writer, []chord.Chord{triad}, options, fmt.Sprintf("%sTonnetz", detailed.Name))
+}
+
+func (h theoryHandler) IllustrateChordPathWithTonnetz(writer http.ResponseWriter, request *http.Request) {
+	options, ok := h.illustrationOptions(writer, request)
+	if !ok {
+		return
+	}
+
+	path, ok := h.findChordPath(writer, request)
+	if !ok {
+		return
+	}
+
+	// replay transformations from the first chord, in root position
+	detailed, err := h.service.GetChord(request.Context(), path.From.ID)
+	if err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to get chord")
+		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
+		return
+	}
+
+	current := detailedChord(*detailed)
+	current = chord.New(current.Quality, current.Root)
+	chords := []chord.Chord{current}
+	for _, v := range path.Steps {
+		current, _ = current.Transform(chord.TransformationFromString(v.Transformation))
+		chords = append(chords, current)
+	}
+
+	h.replyTonnetz(writer, chords, options, fmt.Sprintf("%sTo%sTonnetz", path.From.Name, path.To.Name))
+}
+
+// findChordPath finds path between chords given in request, replying error when not found
+func (h theoryHandler) findChordPath(writer http.ResponseWriter, request *http.Request) (*ChordPath, bool) {
+	ctx := request.Context()
+
+	var data TransformationFilter
+	if err := h.decoder.Decode(&data, request.URL.Query()); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to parse transformations")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return nil, false
+	}
+
+	transformations, err := data.Resolve()
+	if err != nil {
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return nil, false
+	}
+
+	fromChordID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
+	toChordID, _ := strconv.ParseInt(mux.Vars(request)["target_id"], 10, 64)
+	path, err := h.service.FindChordPath(ctx, fromChordID, toChordID, transformations)
+	switch {
+	case errors.Is(err, ErrChordNotFound), errors.Is(err, ErrChordNotTriad), errors.Is(err, ErrChordPathNotFound):
+		h.ReplyJSON(writer, http.StatusNotFound, api.ErrResourceNotFound)
+		return nil, false
+	case err != nil:
+		h.Logger().With(zap.Error(err)).Error("failed to find chord path")
+		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
+		return nil, false
+	}
+
+	return path, true
+}
+
+// replyTonnetz draws chords on tonnetz and writes it as illustration of given options
+func (h theoryHandler) replyTonnetz(writer http.ResponseWriter, chords []chord.Chord, options illustations.Options, name string) {
+	img, err := illustations.Tonnetz(chords, options)
 	if err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to draw tonnetz illustration for chord")
+		writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	h.replyIllustration(writer, img, name)
+}
+
+func (h theoryHandler) IllustrateChordWithKeyboard(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	options, ok := h.illustrationOptions(writer, request)
+	if !ok {
+		return
+	}
+
+	keys, ok := h.keyboardRange(writer, request)
+	if !ok {
+		return
+	}
+
+	chordID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
+
+	// get chord
+	chord, err := h.service.GetChord(ctx, chordID)
+	switch {
+	case errors.Is(err, ErrChordNotFound):
+		h.ReplyJSON(writer, http.StatusNotFound, api.ErrResourceNotFound)
+		return
+	case err != nil:
 		h.Logger().With(zap.Error(err)).Error("failed to list chord pitches")
 		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
 		return
 	}
 
-	pitches := make([]pitch.Type, 0)
-	for _, v := range simplifiedPitches {
-		pitches = append(pitches, pitch.FromInt(int(v.ID)))
+	var data VoicingFilter
+	if err := h.decoder.Decode(&data, request.URL.Query()); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to parse chord voicing")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	// chord notes ascend from the bass, which is highlighted
+	notes, names := chordNotes(*chord, 4), chordSpelling(*chord)
+	if data.Voicing != "" {
+		notes, err = data.Notes(detailedChord(*chord), 4)
+		if err != nil {
+			h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+			return
+		}
+
+		names = voicedSpelling(*chord, notes)
 	}
 
 	// draw keyboard illustration
-	img, err := illustations.Keyboard(pitches)
+	img, err := illustations.KeyboardNotes(notes, names, keys, options)
 	if err != nil {
 		h.Logger().With(zap.Error(err)).Error("failed to draw keyboard illustration for chord")
 		writer.WriteHeader(http.StatusInternalServerError)
 		return
 	}
 
-	writer.WriteHeader(http.StatusOK)
-	writer.Header().Set("Content-Type", "image/png")
-	writer.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", fmt.Sprintf("%sKeyboard.png", chord.Name)))
-	_ = png.Encode(writer, img)
+	h.replyIllustration(writer, img, fmt.Sprintf("%sKeyboard", chord.Name))
 }
 
-func (h theoryHandler) IllustrateChordAsWavFile(writer http.ResponseWriter, request *http.Request) {
+func (h theoryHandler) IllustrateChordWithStaff(writer http.ResponseWriter, request *http.Request) {
 	ctx := request.Context()
 
+	options, ok := h.illustrationOptions(writer, request)
+	if !ok {
+		return
+	}
+
+	staff, ok := h.staffType(writer, request)
+	if !ok {
+		return
+	}
+
 	chordID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
 
 	// get chord
@@ -221,16 +519,81 @@ func (h theoryHandler) IllustrateChordAsWavFile(writer http.ResponseWriter, requ
 		return
 	}
 
-	// list pitches
-	simplifiedPitches, err := h.service.ListChordPitches(ctx, chordID)
+	var data VoicingFilter
+	if err := h.decoder.Decode(&data, request.URL.Query()); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to parse chord voicing")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	// chord notes ascend from the bass, which is highlighted
+	octave := staffOctave(staff)
+	notes, names := chordNotes(*chord, octave), chordSpelling(*chord)
+	if data.Voicing != "" {
+		notes, err = data.Notes(detailedChord(*chord), octave)
+		if err != nil {
+			h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+			return
+		}
+
+		names = voicedSpelling(*chord, notes)
+	}
+
+	// draw staff illustration, chord tones are written together without key signature
+	img, err := illustations.Staff([][]pitch.Note{notes}, names, staff, 0, options)
 	if err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to draw staff illustration for chord")
+		writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	h.replyIllustration(writer, img, fmt.Sprintf("%sStaff", chord.Name))
+}
+
+func (h theoryHandler) IllustrateChordAsWavFile(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	chordID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
+
+	// get chord
+	chord, err := h.service.GetChord(ctx, chordID)
+	switch {
+	case errors.Is(err, ErrChordNotFound):
+		h.ReplyJSON(writer, http.StatusNotFound, api.ErrResourceNotFound)
+		return
+	case err != nil:
 		h.Logger().With(zap.Error(err)).Error("failed to list chord pitches")
 		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
 		return
 	}
 
+	// resolve tuning and voicing
+	type params struct {
+		TuningFilter
+		VoicingFilter
+	}
+
+	var data params
+	if err := h.decoder.Decode(&data, request.URL.Query()); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to parse tuning")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	t, err := data.TuningFilter.Resolve()
+	if err != nil {
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	// voice chord with the bass at the fourth octave
+	notes, err := data.VoicingFilter.Notes(detailedChord(*chord), 4)
+	if err != nil {
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
 	// instantiate synthesizer
-	sampleRate := 44100
 	synthesizer, err := h.synthesizerFactory.Instantiate(int32(sampleRate))
 	if err != nil {
 		h.Logger().With(zap.Error(err)).Error("failed to instantiate synthesizer")
@@ -238,51 +601,78 @@ func (h theoryHandler) IllustrateChordAsWavFile(writer http.ResponseWriter, requ
 		return
 	}
 
-	for _, v := range simplifiedPitches {
-		p := pitch.FromInt(int(v.ID))
-		if p >= pitch.CNatural && p < pitch.BNatural {
-			synthesizer.NoteOn(0, int32(p)+59, 100)
-		}
-	}
+	midi.TunedNotesOn(synthesizer, notes, t, 100)
 
+	// render
 	numSamples := 3 * sampleRate
 	left := make([]float32, numSamples)
 	right := make([]float32, numSamples)
-
-	// render
 	synthesizer.Render(left, right)
 
-	// determine peak amplitude
-	var maxValue float64
-	for i := 0; i < numSamples; i++ {
-		absLeft := math.Abs(float64(left[i]))
-		absRight := math.Abs(float64(right[i]))
-		if maxValue < absLeft {
-			maxValue = absLeft
-		}
-		if maxValue < absRight {
-			maxValue = absRight
-		}
+	h.replyWav(writer, left, right, fmt.Sprintf("%sPiano.wav", chord.Name))
+}
+
+func (h theoryHandler) IllustrateChordAsMidiFile(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	type params struct {
+		SequenceFilter
+		TuningFilter
+		MidiFilter
+		Style string `form:"style"`
 	}
 
-	// convert to integer relative to amplitude
-	a := 32768 * float32(0.99/maxValue)
-	samples := make([]wav.Sample, numSamples)
-	for i := 0; i < numSamples; i++ {
-		samples[i].Values[0] = int(a * left[i])
-		samples[i].Values[1] = int(a * right[i])
+	var data params
+	if err := h.decoder.Decode(&data, request.URL.Query()); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to parse chord midi parameters")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	data.SequenceFilter.Sanitize()
+	data.MidiFilter.Sanitize()
+	t, err := data.TuningFilter.Resolve()
+	if err != nil {
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
 	}
 
-	var buff bytes.Buffer
-	encoder := wav.NewWriter(&buff, uint32(numSamples), 2, 44100, 16)
-	if err := encoder.WriteSamples(samples); err != nil {
-		h.Logger().With(zap.Error(err)).Error("failed to convert PCM to wav file")
+	chordID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
+
+	// get chord
+	chord, err := h.service.GetChord(ctx, chordID)
+	switch {
+	case errors.Is(err, ErrChordNotFound):
+		h.ReplyJSON(writer, http.StatusNotFound, api.ErrResourceNotFound)
+		return
+	case err != nil:
+		h.Logger().With(zap.Error(err)).Error("failed to get chord")
 		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
 		return
 	}
 
-	writer.WriteHeader(http.StatusOK)
-	writer.Header().Set("Content-Type", "audio/wav")
-	writer.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", fmt.Sprintf("%sPiano.wav", chord.Name)))
-	_, _ = io.Copy(writer, bytes.NewReader(buff.Bytes()))
+	// voice chord in close position with the bass at the fourth octave
+	notes := chordNotes(*chord, 4)
+
+	var sequence midi.Sequence
+	switch data.Style {
+	case "", StyleBlock:
+		sequence = midi.Sequence{
+			Notes:      notes,
+			Tempo:      data.SequenceFilter.Tempo,
+			NoteLength: data.SequenceFilter.NoteLength,
+			Tuning:     t,
+			Block:      true,
+		}
+	case StyleArpeggiated:
+		if sequence, err = data.SequenceFilter.Sequence(notes, t); err != nil {
+			h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+			return
+		}
+	default:
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	h.replyMidi(writer, sequence, data.MidiFilter, fmt.Sprintf("%s.mid", chord.Name))
 }
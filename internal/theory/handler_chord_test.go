@@ -3,8 +3,10 @@ package theory_test
 import (
 	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
 	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/edipermadi/music-db/internal/platform/api"
@@ -416,6 +418,23 @@ func TestTheoryHandler_ListChords(t *testing.T) {
 			},
 			ExpectedStatus: http.StatusOK,
 		},
+		{
+			Title: "Returns200WhenSucceededWithIntervalVectorFilter",
+			GivenQueryStrings: url.Values{
+				"interval_vector": []string{"0", "0", "1", "1", "1", "0"},
+			},
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				ListChords: []interface{}{[]theory.SimplifiedChord{{ID: 1, Name: "name"}}, &api.Pagination{}, nil},
+			},
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Title: "Returns400WhenIntervalVectorIsInvalid",
+			GivenQueryStrings: url.Values{
+				"interval_vector": []string{"0", "0", "1"},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
 		{
 			Title: "Returns500WhenFailed",
 			ServiceReturnValues: mock.TheoryServiceReturnValues{
@@ -490,6 +509,501 @@ func TestTheoryHandler_GetChord(t *testing.T) {
 	}
 }
 
+func TestTheoryHandler_LookupChord(t *testing.T) {
+	testCases := []handlerTestCase{
+		{
+			Title: "Returns200WhenSucceeded",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				LookupChord: []interface{}{&theory.DetailedChord{ID: 1, Name: "ANaturalMinorSeventhOverGNatural", Symbol: "Am7/G"}, nil},
+			},
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Title: "Returns400WhenSymbolIsInvalid",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				LookupChord: []interface{}{nil, theory.ErrInvalidChordSymbol},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns400WhenSlashChordIsUnsupported",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				LookupChord: []interface{}{nil, theory.ErrUnsupportedSlashChord},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns404WhenNotFound",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				LookupChord: []interface{}{nil, theory.ErrChordNotFound},
+			},
+			ExpectedStatus: http.StatusNotFound,
+		},
+		{
+			Title: "Returns500WhenFailed",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				LookupChord: []interface{}{nil, errors.New("error")},
+			},
+			ExpectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			server := tc.mockServer()
+			defer server.Close()
+
+			resp, err := tc.httpGet("/chords/lookup?symbol=Am7%2FG")
+			require.NoError(t, err)
+
+			defer func() { _ = resp.Body.Close() }()
+
+			require.Equal(t, tc.ExpectedStatus, resp.StatusCode)
+			if tc.ExpectedStatus == http.StatusOK {
+				var decoded theory.DetailedChord
+				require.NoError(t, json.NewDecoder(resp.Body).Decode(&decoded))
+				require.Equal(t, "Am7/G", decoded.Symbol)
+			}
+		})
+	}
+}
+
+func TestTheoryHandler_ListChordTransformations(t *testing.T) {
+	testCases := []handlerTestCase{
+		{
+			Title: "Returns200WhenSucceeded",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				ListChordTransformations: []interface{}{[]theory.ChordTransformation{{Transformation: "P", Name: "Parallel", Chord: theory.SimplifiedChord{ID: 13, Name: "CNaturalMinor"}}}, nil},
+			},
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Title: "Returns404WhenChordIsNotTriad",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				ListChordTransformations: []interface{}{nil, theory.ErrChordNotTriad},
+			},
+			ExpectedStatus: http.StatusNotFound,
+		},
+		{
+			Title: "Returns500WhenFailed",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				ListChordTransformations: []interface{}{nil, errors.New("error")},
+			},
+			ExpectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			server := tc.mockServer()
+			defer server.Close()
+
+			resp, err := tc.httpGet("/chords/1/transformations")
+			require.NoError(t, err)
+
+			defer func() { _ = resp.Body.Close() }()
+
+			require.Equal(t, tc.ExpectedStatus, resp.StatusCode)
+		})
+	}
+}
+
+func TestTheoryHandler_ListChordFingerings(t *testing.T) {
+	testCases := []handlerTestCase{
+		{
+			Title: "Returns200WhenSucceeded",
+			GivenQueryStrings: url.Values{
+				"tuning":   []string{"drop_d"},
+				"max_fret": []string{"5"},
+			},
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				ListChordFingerings: []interface{}{[]theory.ChordFingering{{Tuning: "drop_d", Frets: []int{0, 0, 0, 2, 3, 2}, Chart: "000232"}}, nil},
+			},
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Title: "Returns400WhenQueryIsMalformed",
+			GivenQueryStrings: url.Values{
+				"max_fret": []string{"high"},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns400WhenTuningIsInvalid",
+			GivenQueryStrings: url.Values{
+				"tuning": []string{"banjo"},
+			},
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				ListChordFingerings: []interface{}{nil, theory.ErrInvalidInstrument},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns404WhenChordNotFound",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				ListChordFingerings: []interface{}{nil, theory.ErrChordNotFound},
+			},
+			ExpectedStatus: http.StatusNotFound,
+		},
+		{
+			Title: "Returns500WhenFailed",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				ListChordFingerings: []interface{}{nil, errors.New("error")},
+			},
+			ExpectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			server := tc.mockServer()
+			defer server.Close()
+
+			resp, err := tc.httpGet("/chords/1/fingerings")
+			require.NoError(t, err)
+
+			defer func() { _ = resp.Body.Close() }()
+
+			require.Equal(t, tc.ExpectedStatus, resp.StatusCode)
+		})
+	}
+}
+
+func TestTheoryHandler_IllustrateChordWithChordBox(t *testing.T) {
+	cMajor := &theory.DetailedChord{
+		ID:      1,
+		Quality: theory.SimplifiedChordQuality{ID: 1, Name: "Major"},
+		Root:    theory.SimplifiedPitch{ID: 1, Name: "CNatural"},
+		Bass:    theory.SimplifiedPitch{ID: 1, Name: "CNatural"},
+		Name:    "CNaturalMajor",
+	}
+
+	testCases := []handlerTestCase{
+		{
+			Title: "Returns400WhenIndexIsNegative",
+			GivenQueryStrings: url.Values{
+				"index": []string{"-1"},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns400WhenTuningIsInvalid",
+			GivenQueryStrings: url.Values{
+				"tuning": []string{"banjo"},
+			},
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GetChord:            []interface{}{cMajor, nil},
+				ListChordFingerings: []interface{}{nil, theory.ErrInvalidInstrument},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns404WhenChordNotFound",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GetChord: []interface{}{nil, theory.ErrChordNotFound},
+			},
+			ExpectedStatus: http.StatusNotFound,
+		},
+		{
+			Title: "Returns404WhenFingeringNotFound",
+			GivenQueryStrings: url.Values{
+				"index": []string{"1"},
+			},
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GetChord:            []interface{}{cMajor, nil},
+				ListChordFingerings: []interface{}{[]theory.ChordFingering{{Tuning: "standard", Frets: []int{-1, 3, 2, 0, 1, 0}, Chart: "x32010"}}, nil},
+			},
+			ExpectedStatus: http.StatusNotFound,
+		},
+		{
+			Title: "Returns500WhenFailed",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GetChord:            []interface{}{cMajor, nil},
+				ListChordFingerings: []interface{}{nil, errors.New("error")},
+			},
+			ExpectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			server := tc.mockServer()
+			defer server.Close()
+
+			resp, err := tc.httpGet("/chords/1/illustrations/chord_box")
+			require.NoError(t, err)
+
+			defer func() { _ = resp.Body.Close() }()
+
+			require.Equal(t, tc.ExpectedStatus, resp.StatusCode)
+		})
+	}
+}
+
+func TestTheoryHandler_IllustrateChordWithKeyboard(t *testing.T) {
+	chord := &theory.DetailedChord{
+		ID:      1,
+		Name:    "CNaturalMajor",
+		Quality: theory.SimplifiedChordQuality{ID: 1, Name: "Major"},
+		Root:    theory.SimplifiedPitch{ID: 1, Name: "CNatural"},
+	}
+
+	type testCase struct {
+		handlerTestCase
+		ExpectedContentType string
+	}
+
+	testCases := []testCase{
+		{
+			handlerTestCase: handlerTestCase{
+				Title: "Returns400WhenFormatIsInvalid",
+				GivenQueryStrings: url.Values{
+					"format": []string{"gif"},
+				},
+				ExpectedStatus: http.StatusBadRequest,
+			},
+		},
+		{
+			handlerTestCase: handlerTestCase{
+				Title: "Returns400WhenPaletteIsInvalid",
+				GivenQueryStrings: url.Values{
+					"palette": []string{"#2196f3,#f44336"},
+				},
+				ExpectedStatus: http.StatusBadRequest,
+			},
+		},
+		{
+			handlerTestCase: handlerTestCase{
+				Title: "Returns400WhenLabelStyleIsInvalid",
+				GivenQueryStrings: url.Values{
+					"labels": []string{"roman"},
+				},
+				ExpectedStatus: http.StatusBadRequest,
+			},
+		},
+		{
+			handlerTestCase: handlerTestCase{
+				Title: "Returns400WhenWidthIsMalformed",
+				GivenQueryStrings: url.Values{
+					"width": []string{"wide"},
+				},
+				ExpectedStatus: http.StatusBadRequest,
+			},
+		},
+		{
+			handlerTestCase: handlerTestCase{
+				Title: "Returns400WhenKeysIsUnsupported",
+				GivenQueryStrings: url.Values{
+					"keys": []string{"64"},
+				},
+				ExpectedStatus: http.StatusBadRequest,
+			},
+		},
+		{
+			handlerTestCase: handlerTestCase{
+				Title: "Returns400WhenKeyboardRangeIsInverted",
+				GivenQueryStrings: url.Values{
+					"from": []string{"C5"},
+					"to":   []string{"C4"},
+				},
+				ExpectedStatus: http.StatusBadRequest,
+			},
+		},
+		{
+			handlerTestCase: handlerTestCase{
+				Title: "Returns400WhenKeysAndKeyboardRangeAreGiven",
+				GivenQueryStrings: url.Values{
+					"keys": []string{"88"},
+					"from": []string{"C2"},
+					"to":   []string{"C6"},
+				},
+				ExpectedStatus: http.StatusBadRequest,
+			},
+		},
+		{
+			handlerTestCase: handlerTestCase{
+				Title: "ReturnsSvgWithKeys",
+				GivenQueryStrings: url.Values{
+					"format":  []string{"svg"},
+					"keys":    []string{"88"},
+					"voicing": []string{"drop_2"},
+				},
+				ServiceReturnValues: mock.TheoryServiceReturnValues{
+					GetChord: []interface{}{chord, nil},
+				},
+				ExpectedStatus: http.StatusOK,
+			},
+			ExpectedContentType: "image/svg+xml",
+		},
+		{
+			handlerTestCase: handlerTestCase{
+				Title: "ReturnsSvgWithKeyboardRange",
+				GivenQueryStrings: url.Values{
+					"format": []string{"svg"},
+					"from":   []string{"A3"},
+					"to":     []string{"Bb5"},
+				},
+				ServiceReturnValues: mock.TheoryServiceReturnValues{
+					GetChord: []interface{}{chord, nil},
+				},
+				ExpectedStatus: http.StatusOK,
+			},
+			ExpectedContentType: "image/svg+xml",
+		},
+		{
+			handlerTestCase: handlerTestCase{
+				Title: "ReturnsSvgWithOptions",
+				GivenQueryStrings: url.Values{
+					"format":  []string{"svg"},
+					"width":   []string{"900"},
+					"palette": []string{"color_blind"},
+					"dark":    []string{"true"},
+					"labels":  []string{"degrees"},
+					"legend":  []string{"true"},
+				},
+				ServiceReturnValues: mock.TheoryServiceReturnValues{
+					GetChord: []interface{}{chord, nil},
+				},
+				ExpectedStatus: http.StatusOK,
+			},
+			ExpectedContentType: "image/svg+xml",
+		},
+		{
+			handlerTestCase: handlerTestCase{
+				Title: "ReturnsSvgWhenFormatIsSvg",
+				GivenQueryStrings: url.Values{
+					"format": []string{"svg"},
+				},
+				GivenHeaders: http.Header{
+					"Accept": []string{"image/png"},
+				},
+				ServiceReturnValues: mock.TheoryServiceReturnValues{
+					GetChord: []interface{}{chord, nil},
+				},
+				ExpectedStatus: http.StatusOK,
+			},
+			ExpectedContentType: "image/svg+xml",
+		},
+		{
+			handlerTestCase: handlerTestCase{
+				Title: "ReturnsSvgWhenSvgIsPreferred",
+				GivenHeaders: http.Header{
+					"Accept": []string{"image/png;q=0.5, image/svg+xml"},
+				},
+				ServiceReturnValues: mock.TheoryServiceReturnValues{
+					GetChord: []interface{}{chord, nil},
+				},
+				ExpectedStatus: http.StatusOK,
+			},
+			ExpectedContentType: "image/svg+xml",
+		},
+		{
+			handlerTestCase: handlerTestCase{
+				Title: "Returns404WhenNotFound",
+				ServiceReturnValues: mock.TheoryServiceReturnValues{
+					GetChord: []interface{}{nil, theory.ErrChordNotFound},
+				},
+				ExpectedStatus: http.StatusNotFound,
+			},
+		},
+		{
+			handlerTestCase: handlerTestCase{
+				Title: "Returns500WhenFailed",
+				ServiceReturnValues: mock.TheoryServiceReturnValues{
+					GetChord: []interface{}{nil, errors.New("error")},
+				},
+				ExpectedStatus: http.StatusInternalServerError,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			server := tc.mockServer()
+			defer server.Close()
+
+			resp, err := tc.httpGet("/chords/1/illustrations/keyboard")
+			require.NoError(t, err)
+
+			defer func() { _ = resp.Body.Close() }()
+
+			require.Equal(t, tc.ExpectedStatus, resp.StatusCode)
+			if tc.ExpectedStatus == http.StatusOK {
+				require.Equal(t, tc.ExpectedContentType, resp.Header.Get("Content-Type"))
+
+				body, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				require.True(t, strings.HasPrefix(string(body), "<svg"))
+			}
+		})
+	}
+}
+
+func TestTheoryHandler_FindChordPath(t *testing.T) {
+	path := &theory.ChordPath{
+		From:            theory.SimplifiedChord{ID: 1, Name: "CNaturalMajor"},
+		To:              theory.SimplifiedChord{ID: 13, Name: "CNaturalMinor"},
+		Transformations: "P",
+		Steps:           []theory.ChordTransformation{{Transformation: "P", Name: "Parallel", Chord: theory.SimplifiedChord{ID: 13, Name: "CNaturalMinor"}}},
+	}
+
+	testCases := []handlerTestCase{
+		{
+			Title: "Returns200WhenSucceeded",
+			GivenQueryStrings: url.Values{
+				"transformation": []string{"P", "Slide"},
+			},
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				FindChordPath: []interface{}{path, nil},
+			},
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Title: "Returns400WhenTransformationIsInvalid",
+			GivenQueryStrings: url.Values{
+				"transformation": []string{"X"},
+			},
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				FindChordPath: []interface{}{path, nil},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns404WhenPathNotFound",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				FindChordPath: []interface{}{nil, theory.ErrChordPathNotFound},
+			},
+			ExpectedStatus: http.StatusNotFound,
+		},
+		{
+			Title: "Returns500WhenFailed",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				FindChordPath: []interface{}{nil, errors.New("error")},
+			},
+			ExpectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			server := tc.mockServer()
+			defer server.Close()
+
+			resp, err := tc.httpGet("/chords/1/paths/13")
+			require.NoError(t, err)
+
+			defer func() { _ = resp.Body.Close() }()
+
+			require.Equal(t, tc.ExpectedStatus, resp.StatusCode)
+			if tc.ExpectedStatus == http.StatusOK {
+				var decoded theory.ChordPath
+				require.NoError(t, json.NewDecoder(resp.Body).Decode(&decoded))
+				require.Equal(t, "P", decoded.Transformations)
+			}
+		})
+	}
+}
+
 func TestTheoryHandler_GetChordQuality(t *testing.T) {
 	testCases := []handlerTestCase{
 		{
@@ -534,3 +1048,231 @@ func TestTheoryHandler_GetChordQuality(t *testing.T) {
 		})
 	}
 }
+
+func TestTheoryHandler_IllustrateChordWithStaff(t *testing.T) {
+	chord := &theory.DetailedChord{
+		ID:      1,
+		Name:    "CNaturalMajor",
+		Quality: theory.SimplifiedChordQuality{ID: 1, Name: "Major"},
+		Root:    theory.SimplifiedPitch{ID: 1, Name: "CNatural"},
+	}
+
+	testCases := []handlerTestCase{
+		{
+			Title: "Returns400WhenStaffIsInvalid",
+			GivenQueryStrings: url.Values{
+				"staff": []string{"alto"},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns400WhenFormatIsInvalid",
+			GivenQueryStrings: url.Values{
+				"format": []string{"gif"},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns400WhenVoicingIsInvalid",
+			GivenQueryStrings: url.Values{
+				"voicing": []string{"spread"},
+			},
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GetChord: []interface{}{chord, nil},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns200WhenStaffIsGrand",
+			GivenQueryStrings: url.Values{
+				"staff":  []string{"grand"},
+				"format": []string{"svg"},
+			},
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GetChord: []interface{}{chord, nil},
+			},
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Title: "Returns404WhenNotFound",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GetChord: []interface{}{nil, theory.ErrChordNotFound},
+			},
+			ExpectedStatus: http.StatusNotFound,
+		},
+		{
+			Title: "Returns500WhenFailed",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GetChord: []interface{}{nil, errors.New("error")},
+			},
+			ExpectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			server := tc.mockServer()
+			defer server.Close()
+
+			resp, err := tc.httpGet("/chords/1/illustrations/staff")
+			require.NoError(t, err)
+
+			defer func() { _ = resp.Body.Close() }()
+
+			require.Equal(t, tc.ExpectedStatus, resp.StatusCode)
+		})
+	}
+}
+
+func TestTheoryHandler_IllustrateChordAsMidiFile(t *testing.T) {
+	chord := &theory.DetailedChord{
+		ID:      1,
+		Name:    "CMajorTriad",
+		Quality: theory.SimplifiedChordQuality{ID: 1, Name: "MajorTriad"},
+		Root:    theory.SimplifiedPitch{ID: 1, Name: "CNatural"},
+	}
+
+	testCases := []handlerTestCase{
+		{
+			Title: "Returns200WhenSucceeded",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GetChord: []interface{}{chord, nil},
+			},
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Title: "Returns200WhenArpeggiated",
+			GivenQueryStrings: url.Values{
+				"style":      []string{"arpeggiated"},
+				"direction":  []string{"both"},
+				"smf_format": []string{"1"},
+			},
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GetChord: []interface{}{chord, nil},
+			},
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Title: "Returns400WhenStyleIsInvalid",
+			GivenQueryStrings: url.Values{
+				"style": []string{"strummed"},
+			},
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GetChord: []interface{}{chord, nil},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns400WhenFormatIsInvalid",
+			GivenQueryStrings: url.Values{
+				"smf_format": []string{"2"},
+			},
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GetChord: []interface{}{chord, nil},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns404WhenNotFound",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GetChord: []interface{}{nil, theory.ErrChordNotFound},
+			},
+			ExpectedStatus: http.StatusNotFound,
+		},
+		{
+			Title: "Returns500WhenFailed",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GetChord: []interface{}{nil, errors.New("error")},
+			},
+			ExpectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			server := tc.mockServer()
+			defer server.Close()
+
+			resp, err := tc.httpGet("/chords/1/illustrations/midi")
+			require.NoError(t, err)
+
+			defer func() { _ = resp.Body.Close() }()
+
+			require.Equal(t, tc.ExpectedStatus, resp.StatusCode)
+			if tc.ExpectedStatus == http.StatusOK {
+				require.Equal(t, "audio/midi", resp.Header.Get("Content-Type"))
+
+				body, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				require.Equal(t, "MThd", string(body[:4]))
+			}
+		})
+	}
+}
+
+func TestTheoryHandler_IllustrateChordWithVoicing(t *testing.T) {
+	chord := &theory.DetailedChord{
+		ID:      1,
+		Name:    "CNaturalMajor",
+		Quality: theory.SimplifiedChordQuality{ID: 1, Name: "Major"},
+		Root:    theory.SimplifiedPitch{ID: 1, Name: "CNatural"},
+	}
+
+	testCases := []handlerTestCase{
+		{
+			Title: "Returns400WhenVoicingIsInvalid",
+			GivenQueryStrings: url.Values{
+				"voicing": []string{"cluster"},
+			},
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GetChord: []interface{}{chord, nil},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns400WhenVoicingIsNotApplicable",
+			GivenQueryStrings: url.Values{
+				"voicing": []string{"rootless_a"},
+			},
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GetChord: []interface{}{chord, nil},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns404WhenNotFound",
+			GivenQueryStrings: url.Values{
+				"voicing": []string{"drop_2"},
+			},
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GetChord: []interface{}{nil, theory.ErrChordNotFound},
+			},
+			ExpectedStatus: http.StatusNotFound,
+		},
+		{
+			Title: "Returns500WhenFailed",
+			GivenQueryStrings: url.Values{
+				"voicing": []string{"drop_2"},
+			},
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GetChord: []interface{}{nil, errors.New("error")},
+			},
+			ExpectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			server := tc.mockServer()
+			defer server.Close()
+
+			for _, path := range []string{"/chords/1/illustrations/keyboard", "/chords/1/illustrations/wav"} {
+				resp, err := tc.httpGet(path)
+				require.NoError(t, err)
+
+				require.Equal(t, tc.ExpectedStatus, resp.StatusCode)
+				_ = resp.Body.Close()
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package theory
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/edipermadi/music-db/internal/platform/api"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+type identificationHandlers interface {
+	Identify(writer http.ResponseWriter, request *http.Request)
+}
+
+func (h theoryHandler) installIdentificationEndpoints(router *mux.Router) {
+	router.HandleFunc("/identify", h.Identify).Methods(http.MethodGet).Name("IDENTIFY")
+}
+
+func (h theoryHandler) Identify(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	var data IdentificationFilter
+	if err := h.decoder.Decode(&data, request.URL.Query()); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to identify pitches")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	identification, err := h.service.Identify(ctx, data)
+	switch {
+	case errors.Is(err, ErrInvalidPitch):
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+	case err != nil:
+		h.Logger().With(zap.Error(err)).Error("failed to identify pitches")
+		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
+	default:
+		h.ReplyJSON(writer, http.StatusOK, identification)
+	}
+}
@@ -0,0 +1,86 @@
+package theory_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/edipermadi/music-db/internal/theory"
+	"github.com/edipermadi/music-db/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTheoryHandler_Identify(t *testing.T) {
+	testCases := []handlerTestCase{
+		{
+			Title: "Returns200WhenSucceeded",
+			GivenQueryStrings: url.Values{
+				"pitch_id": []string{"1", "5", "8"},
+			},
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				Identify: []interface{}{&theory.Identification{ZeitlerNumber: 2192}, nil},
+			},
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Title: "Returns200WhenSucceededWithMaxDistanceAndLimit",
+			GivenQueryStrings: url.Values{
+				"pitch_id":     []string{"1", "5", "8"},
+				"max_distance": []string{"2"},
+				"limit":        []string{"10"},
+			},
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				Identify: []interface{}{&theory.Identification{ZeitlerNumber: 2192}, nil},
+			},
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Title: "Returns400WhenQueryParameterIsMalformed",
+			GivenQueryStrings: url.Values{
+				"pitch_id": []string{"C"},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns400WhenPitchIsInvalid",
+			GivenQueryStrings: url.Values{
+				"pitch_id": []string{"13"},
+			},
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				Identify: []interface{}{nil, theory.ErrInvalidPitch},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns500WhenFailed",
+			GivenQueryStrings: url.Values{
+				"pitch_id": []string{"1"},
+			},
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				Identify: []interface{}{nil, errors.New("error")},
+			},
+			ExpectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			server := tc.mockServer()
+			defer server.Close()
+
+			resp, err := tc.httpGet("/identify")
+			require.NoError(t, err)
+
+			defer func() { _ = resp.Body.Close() }()
+
+			require.Equal(t, tc.ExpectedStatus, resp.StatusCode)
+			if tc.ExpectedStatus == http.StatusOK {
+				var decoded theory.Identification
+				require.NoError(t, json.NewDecoder(resp.Body).Decode(&decoded))
+				require.NotEmpty(t, decoded)
+			}
+		})
+	}
+}
@@ -3,13 +3,13 @@ package theory
 import (
 	"errors"
 	"fmt"
-	"image/png"
 	"net/http"
 	"strconv"
 
 	"github.com/edipermadi/music-db/internal/platform/api"
 	"github.com/edipermadi/music-db/pkg/illustations"
-	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/fretboard"
+	"github.com/edipermadi/music-db/pkg/theory/spelling"
 	"github.com/gorilla/mux"
 	"go.uber.org/zap"
 )
@@ -20,6 +20,7 @@ type keyHandlers interface {
 	ListKeyChords(writer http.ResponseWriter, request *http.Request)
 	ListKeyPitches(writer http.ResponseWriter, request *http.Request)
 	GetKey(writer http.ResponseWriter, request *http.Request)
+	ListKeyHarmony(writer http.ResponseWriter, request *http.Request)
 }
 
 func (h theoryHandler) installKeyEndpoints(router *mux.Router) {
@@ -28,9 +29,14 @@ func (h theoryHandler) installKeyEndpoints(router *mux.Router) {
 	router.HandleFunc("/keys/{id:[0-9]+}/chords", h.ListKeyChords).Methods(http.MethodGet).Name("LIST_KEY_CHORDS")
 	router.HandleFunc("/keys/{id:[0-9]+}/modes", h.ListKeyModes).Methods(http.MethodGet).Name("LIST_KEY_MODES")
 	router.HandleFunc("/keys/{id:[0-9]+}/pitches", h.ListKeyPitches).Methods(http.MethodGet).Name("LIST_KEY_PITCHES")
+	router.HandleFunc("/keys/{id:[0-9]+}/harmony", h.ListKeyHarmony).Methods(http.MethodGet).Name("LIST_KEY_HARMONY")
 	router.HandleFunc("/keys/{id:[0-9]+}/illustrations/pitch_class_bracelet", h.IllustrateKeyAsPitchClassBraceletDiagram).Methods(http.MethodGet).Name("ILLUSTRATE_KEY_AS_PITCH_CLASSES_BRACELET")
 	router.HandleFunc("/keys/{id:[0-9]+}/illustrations/circle_of_fifth_bracelet", h.IllustrateKeyAsCircleOfFifthBraceletDiagram).Methods(http.MethodGet).Name("ILLUSTRATE_KEY_AS_CIRCLE_OF_FIFTH_BRACELET")
 	router.HandleFunc("/keys/{id:[0-9]+}/illustrations/keyboard", h.IllustrateKeyWithKeyboard).Methods(http.MethodGet).Name("ILLUSTRATE_KEY_WITH_KEYBOARD")
+	router.HandleFunc("/keys/{id:[0-9]+}/illustrations/fretboard", h.IllustrateKeyWithFretboard).Methods(http.MethodGet).Name("ILLUSTRATE_KEY_WITH_FRETBOARD")
+	router.HandleFunc("/keys/{id:[0-9]+}/illustrations/staff", h.IllustrateKeyWithStaff).Methods(http.MethodGet).Name("ILLUSTRATE_KEY_WITH_STAFF")
+	router.HandleFunc("/keys/{id:[0-9]+}/illustrations/wav", h.IllustrateKeyAsWavFile).Methods(http.MethodGet).Name("ILLUSTRATE_KEY_AS_WAVE_FILE")
+	router.HandleFunc("/keys/{id:[0-9]+}/illustrations/midi", h.IllustrateKeyAsMidiFile).Methods(http.MethodGet).Name("ILLUSTRATE_KEY_AS_MIDI_FILE")
 }
 
 func (h theoryHandler) ListKeys(writer http.ResponseWriter, request *http.Request) {
@@ -93,6 +99,12 @@ func (h theoryHandler) ListKeyChords(writer http.ResponseWriter, request *http.R
 		return
 	}
 
+	if err := data.ChordFilter.Validate(); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to list key chords")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
 	keyID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
 	keys, paginationOut, err := h.service.ListKeyChords(ctx, keyID, data.ChordFilter, data.Pagination)
 	if err != nil {
@@ -133,122 +145,311 @@ func (h theoryHandler) GetKey(writer http.ResponseWriter, request *http.Request)
 	}
 }
 
+func (h theoryHandler) ListKeyHarmony(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	keyID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
+	harmony, err := h.service.ListKeyHarmony(ctx, keyID)
+	switch {
+	case errors.Is(err, ErrKeyNotFound):
+		h.ReplyJSON(writer, http.StatusNotFound, api.ErrResourceNotFound)
+	case err != nil:
+		h.Logger().With(zap.Error(err)).Error("failed to list key harmony")
+		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
+	default:
+		h.ReplyJSON(writer, http.StatusOK, harmony)
+	}
+}
+
 func (h theoryHandler) IllustrateKeyAsPitchClassBraceletDiagram(writer http.ResponseWriter, request *http.Request) {
 	ctx := request.Context()
 
+	options, ok := h.illustrationOptions(writer, request)
+	if !ok {
+		return
+	}
+
 	// get key
 	keyID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
 	key, err := h.service.GetKey(ctx, keyID)
 	switch {
 	case errors.Is(err, ErrKeyNotFound):
 		h.ReplyJSON(writer, http.StatusNotFound, api.ErrResourceNotFound)
+		return
 	case err != nil:
 		h.Logger().With(zap.Error(err)).Error("failed to get key")
 		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
+		return
 	}
 
-	// list pitches
-	simplifiedPitches, err := h.service.ListKeyPitches(ctx, keyID)
+	// spell key pitches
+	names := keySpelling(*key)
+
+	// draw bracelet diagram
+	img, err := illustations.PitchClassBracelet(names, options)
 	if err != nil {
-		h.Logger().With(zap.Error(err)).Error("failed to list key pitches")
-		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
+		h.Logger().With(zap.Error(err)).Error("failed to draw bracelet diagram illustration for key")
+		writer.WriteHeader(http.StatusInternalServerError)
+		return
 	}
 
-	pitches := make([]pitch.Type, 0)
-	for _, v := range simplifiedPitches {
-		pitches = append(pitches, pitch.FromInt(int(v.ID)))
+	h.replyIllustration(writer, img, fmt.Sprintf("%sPitchClassBracelet", key.Name))
+}
+
+func (h theoryHandler) IllustrateKeyAsCircleOfFifthBraceletDiagram(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	options, ok := h.illustrationOptions(writer, request)
+	if !ok {
+		return
 	}
 
+	// get key
+	keyID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
+	key, err := h.service.GetKey(ctx, keyID)
+	switch {
+	case errors.Is(err, ErrKeyNotFound):
+		h.ReplyJSON(writer, http.StatusNotFound, api.ErrResourceNotFound)
+		return
+	case err != nil:
+		h.Logger().With(zap.Error(err)).Error("failed to get key")
+		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
+		return
+	}
+
+	// spell key pitches
+	names := keySpelling(*key)
+
 	// draw bracelet diagram
-	img, err := illustations.PitchClassBracelet(pitches)
+	img, err := illustations.CircleOfFifthBracelet(names, options)
 	if err != nil {
 		h.Logger().With(zap.Error(err)).Error("failed to draw bracelet diagram illustration for key")
 		writer.WriteHeader(http.StatusInternalServerError)
 		return
 	}
 
-	writer.WriteHeader(http.StatusOK)
-	writer.Header().Set("Content-Type", "image/png")
-	writer.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", fmt.Sprintf("%sCircleOfFifthBracelet.png", key.Name)))
-	_ = png.Encode(writer, img)
+	h.replyIllustration(writer, img, fmt.Sprintf("%sCircleOfFifthBracelet", key.Name))
 }
 
-func (h theoryHandler) IllustrateKeyAsCircleOfFifthBraceletDiagram(writer http.ResponseWriter, request *http.Request) {
+func (h theoryHandler) IllustrateKeyWithKeyboard(writer http.ResponseWriter, request *http.Request) {
 	ctx := request.Context()
 
+	options, ok := h.illustrationOptions(writer, request)
+	if !ok {
+		return
+	}
+
+	keys, ok := h.keyboardRange(writer, request)
+	if !ok {
+		return
+	}
+
 	// get key
 	keyID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
 	key, err := h.service.GetKey(ctx, keyID)
 	switch {
 	case errors.Is(err, ErrKeyNotFound):
 		h.ReplyJSON(writer, http.StatusNotFound, api.ErrResourceNotFound)
+		return
 	case err != nil:
 		h.Logger().With(zap.Error(err)).Error("failed to get key")
 		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
+		return
 	}
 
-	// list pitches
-	simplifiedPitches, err := h.service.ListKeyPitches(ctx, keyID)
+	// draw keyboard illustration, keys are ascending from the tonic
+	img, err := illustations.KeyboardNotes(keyNotes(*key, 4), keySpelling(*key), keys, options)
 	if err != nil {
-		h.Logger().With(zap.Error(err)).Error("failed to list key pitches")
+		h.Logger().With(zap.Error(err)).Error("failed to draw keyboard illustration for key")
+		writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	h.replyIllustration(writer, img, fmt.Sprintf("%sKeyboard", key.Name))
+}
+
+func (h theoryHandler) IllustrateKeyWithStaff(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	options, ok := h.illustrationOptions(writer, request)
+	if !ok {
+		return
+	}
+
+	staff, ok := h.staffType(writer, request)
+	if !ok {
+		return
+	}
+
+	// get key
+	keyID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
+	key, err := h.service.GetKey(ctx, keyID)
+	switch {
+	case errors.Is(err, ErrKeyNotFound):
+		h.ReplyJSON(writer, http.StatusNotFound, api.ErrResourceNotFound)
+		return
+	case err != nil:
+		h.Logger().With(zap.Error(err)).Error("failed to get key")
 		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
+		return
+	}
+
+	// draw staff illustration, notes ascend from the tonic in key signature of the spelled key
+	names := keySpelling(*key)
+	img, err := illustations.Staff(staffMelody(keyNotes(*key, staffOctave(staff))), names, staff, spelling.KeySignature(names), options)
+	if err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to draw staff illustration for key")
+		writer.WriteHeader(http.StatusInternalServerError)
+		return
 	}
 
-	pitches := make([]pitch.Type, 0)
-	for _, v := range simplifiedPitches {
-		pitches = append(pitches, pitch.FromInt(int(v.ID)))
+	h.replyIllustration(writer, img, fmt.Sprintf("%sStaff", key.Name))
+}
+
+func (h theoryHandler) IllustrateKeyWithFretboard(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	var data FretboardFilter
+	if err := h.decoder.Decode(&data, request.URL.Query()); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to parse fretboard parameters")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
 	}
 
-	// draw bracelet diagram
-	img, err := illustations.CircleOfFifthBracelet(pitches)
+	data.Sanitize()
+	t, err := data.Resolve()
 	if err != nil {
-		h.Logger().With(zap.Error(err)).Error("failed to draw bracelet diagram illustration for key")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	options, ok := h.illustrationOptions(writer, request)
+	if !ok {
+		return
+	}
+
+	// get key
+	keyID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
+	key, err := h.service.GetKey(ctx, keyID)
+	switch {
+	case errors.Is(err, ErrKeyNotFound):
+		h.ReplyJSON(writer, http.StatusNotFound, api.ErrResourceNotFound)
+		return
+	case err != nil:
+		h.Logger().With(zap.Error(err)).Error("failed to get key")
+		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
+		return
+	}
+
+	// spell key pitches, the tonic is spelled first so it is highlighted
+	h.replyFretboard(writer, t, keySpelling(*key), data.Frets, options, fmt.Sprintf("%sFretboard", key.Name))
+}
+
+// replyFretboard draws spelled pitches on a fretboard of given tuning and writes it as illustration of given options
+func (h theoryHandler) replyFretboard(writer http.ResponseWriter, t fretboard.Tuning, names []spelling.Name, frets int, options illustations.Options, name string) {
+	img, err := illustations.Fretboard(t.Strings(), names, frets, options)
+	if err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to draw fretboard illustration")
 		writer.WriteHeader(http.StatusInternalServerError)
 		return
 	}
 
-	writer.WriteHeader(http.StatusOK)
-	writer.Header().Set("Content-Type", "image/png")
-	writer.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", fmt.Sprintf("%sCircleOfFifthBracelet.png", key.Name)))
-	_ = png.Encode(writer, img)
+	h.replyIllustration(writer, img, name)
 }
 
-func (h theoryHandler) IllustrateKeyWithKeyboard(writer http.ResponseWriter, request *http.Request) {
+func (h theoryHandler) IllustrateKeyAsWavFile(writer http.ResponseWriter, request *http.Request) {
 	ctx := request.Context()
 
+	type params struct {
+		SequenceFilter
+		TuningFilter
+	}
+
+	var data params
+	if err := h.decoder.Decode(&data, request.URL.Query()); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to parse key audio parameters")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	data.SequenceFilter.Sanitize()
+
 	// get key
 	keyID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
 	key, err := h.service.GetKey(ctx, keyID)
 	switch {
 	case errors.Is(err, ErrKeyNotFound):
 		h.ReplyJSON(writer, http.StatusNotFound, api.ErrResourceNotFound)
+		return
 	case err != nil:
 		h.Logger().With(zap.Error(err)).Error("failed to get key")
 		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
+		return
+	}
+
+	// tune relative to the key tonic unless requested otherwise
+	if data.TuningFilter.TonicID == 0 {
+		data.TuningFilter.TonicID = key.Tonic.ID
 	}
 
-	// list pitches
-	simplifiedPitches, err := h.service.ListKeyPitches(ctx, keyID)
+	t, err := data.TuningFilter.Resolve()
 	if err != nil {
-		h.Logger().With(zap.Error(err)).Error("failed to list key pitches")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	// play key starting from the tonic at the fourth octave
+	h.replySequenceWav(writer, keyNotes(*key, 4), data.SequenceFilter, t, fmt.Sprintf("%sPiano.wav", key.Name))
+}
+
+func (h theoryHandler) IllustrateKeyAsMidiFile(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	type params struct {
+		SequenceFilter
+		TuningFilter
+		MidiFilter
+	}
+
+	var data params
+	if err := h.decoder.Decode(&data, request.URL.Query()); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to parse key midi parameters")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	data.SequenceFilter.Sanitize()
+	data.MidiFilter.Sanitize()
+
+	// get key
+	keyID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
+	key, err := h.service.GetKey(ctx, keyID)
+	switch {
+	case errors.Is(err, ErrKeyNotFound):
+		h.ReplyJSON(writer, http.StatusNotFound, api.ErrResourceNotFound)
+		return
+	case err != nil:
+		h.Logger().With(zap.Error(err)).Error("failed to get key")
 		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
+		return
 	}
 
-	pitches := make([]pitch.Type, 0)
-	for _, v := range simplifiedPitches {
-		pitches = append(pitches, pitch.FromInt(int(v.ID)))
+	// tune relative to the key tonic unless requested otherwise
+	if data.TuningFilter.TonicID == 0 {
+		data.TuningFilter.TonicID = key.Tonic.ID
 	}
 
-	// draw keyboard illustration
-	img, err := illustations.Keyboard(pitches)
+	t, err := data.TuningFilter.Resolve()
 	if err != nil {
-		h.Logger().With(zap.Error(err)).Error("failed to draw keyboard illustration for key")
-		writer.WriteHeader(http.StatusInternalServerError)
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	sequence, err := data.SequenceFilter.Sequence(keyNotes(*key, 4), t)
+	if err != nil {
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
 		return
 	}
 
-	writer.WriteHeader(http.StatusOK)
-	writer.Header().Set("Content-Type", "image/png")
-	writer.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", fmt.Sprintf("%sKeyboard.png", key.Name)))
-	_ = png.Encode(writer, img)
+	h.replyMidi(writer, sequence, data.MidiFilter, fmt.Sprintf("%s.mid", key.Name))
 }
@@ -499,3 +499,160 @@ func TestTheoryHandler_GetKey(t *testing.T) {
 		})
 	}
 }
+
+func TestTheoryHandler_ListKeyHarmony(t *testing.T) {
+	testCases := []handlerTestCase{
+		{
+			Title: "Returns200WhenSucceeded",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				ListKeyHarmony: []interface{}{[]theory.KeyHarmony{{Degree: 1, Root: theory.SimplifiedPitch{ID: 1, Name: "CNatural"}}}, nil},
+			},
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Title: "Returns404WhenKeyNotFound",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				ListKeyHarmony: []interface{}{nil, theory.ErrKeyNotFound},
+			},
+			ExpectedStatus: http.StatusNotFound,
+		},
+		{
+			Title: "Returns500WhenFailed",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				ListKeyHarmony: []interface{}{nil, errors.New("error")},
+			},
+			ExpectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			server := tc.mockServer()
+			defer server.Close()
+
+			resp, err := tc.httpGet("/keys/1/harmony")
+			require.NoError(t, err)
+
+			defer func() { _ = resp.Body.Close() }()
+
+			require.Equal(t, tc.ExpectedStatus, resp.StatusCode)
+			if tc.ExpectedStatus == http.StatusOK {
+				var decoded []theory.KeyHarmony
+				require.NoError(t, json.NewDecoder(resp.Body).Decode(&decoded))
+				require.NotEmpty(t, decoded)
+			}
+		})
+	}
+}
+
+func TestTheoryHandler_IllustrateKeyWithStaff(t *testing.T) {
+	testCases := []handlerTestCase{
+		{
+			Title: "Returns400WhenStaffIsInvalid",
+			GivenQueryStrings: url.Values{
+				"staff": []string{"alto"},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns400WhenFormatIsInvalid",
+			GivenQueryStrings: url.Values{
+				"format": []string{"gif"},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns200WhenStaffIsGrand",
+			GivenQueryStrings: url.Values{
+				"staff":  []string{"grand"},
+				"format": []string{"svg"},
+			},
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GetKey: []interface{}{&theory.DetailedKey{ID: 1, Name: "CNaturalMydian", Scale: theory.SimplifiedScale{ID: 1, Name: "Mydian"}, Tonic: theory.SimplifiedPitch{ID: 1, Name: "CNatural"}}, nil},
+			},
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Title: "Returns404WhenNotFound",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GetKey: []interface{}{nil, theory.ErrKeyNotFound},
+			},
+			ExpectedStatus: http.StatusNotFound,
+		},
+		{
+			Title: "Returns500WhenFailed",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GetKey: []interface{}{nil, errors.New("error")},
+			},
+			ExpectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			server := tc.mockServer()
+			defer server.Close()
+
+			resp, err := tc.httpGet("/keys/1/illustrations/staff")
+			require.NoError(t, err)
+
+			defer func() { _ = resp.Body.Close() }()
+
+			require.Equal(t, tc.ExpectedStatus, resp.StatusCode)
+		})
+	}
+}
+
+func TestTheoryHandler_IllustrateKeyWithFretboard(t *testing.T) {
+	testCases := []handlerTestCase{
+		{
+			Title: "Returns400WhenTuningIsInvalid",
+			GivenQueryStrings: url.Values{
+				"tuning": []string{"banjo"},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns400WhenFretsIsMalformed",
+			GivenQueryStrings: url.Values{
+				"frets": []string{"many"},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns400WhenFormatIsInvalid",
+			GivenQueryStrings: url.Values{
+				"format": []string{"gif"},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns404WhenNotFound",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GetKey: []interface{}{nil, theory.ErrKeyNotFound},
+			},
+			ExpectedStatus: http.StatusNotFound,
+		},
+		{
+			Title: "Returns500WhenFailed",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GetKey: []interface{}{nil, errors.New("error")},
+			},
+			ExpectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			server := tc.mockServer()
+			defer server.Close()
+
+			resp, err := tc.httpGet("/keys/1/illustrations/fretboard")
+			require.NoError(t, err)
+
+			defer func() { _ = resp.Body.Close() }()
+
+			require.Equal(t, tc.ExpectedStatus, resp.StatusCode)
+		})
+	}
+}
@@ -29,11 +29,20 @@ func (h theoryHandler) installPitchEndpoints(router *mux.Router) {
 func (h theoryHandler) GetPitch(writer http.ResponseWriter, request *http.Request) {
 	ctx := request.Context()
 
+	var data TuningFilter
+	if err := h.decoder.Decode(&data, request.URL.Query()); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to get pitch")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
 	pitchID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
-	pitch, err := h.service.GetPitch(ctx, pitchID)
+	pitch, err := h.service.GetPitch(ctx, pitchID, data)
 	switch {
 	case errors.Is(err, ErrPitchNotFound):
 		h.ReplyJSON(writer, http.StatusNotFound, api.ErrResourceNotFound)
+	case errors.Is(err, ErrInvalidTuning):
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
 	case err != nil:
 		h.Logger().With(zap.Error(err)).Error("failed to get pitch")
 		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
@@ -57,6 +66,12 @@ func (h theoryHandler) ListPitchChords(writer http.ResponseWriter, request *http
 		return
 	}
 
+	if err := data.ChordFilter.Validate(); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to list pitch chords")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
 	pitchID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
 	pitch, paginationOut, err := h.service.ListPitchChords(ctx, pitchID, data.ChordFilter, data.Pagination)
 	switch {
@@ -111,6 +126,12 @@ func (h theoryHandler) ListPitchScales(writer http.ResponseWriter, request *http
 		return
 	}
 
+	if err := data.ScaleFilter.Validate(); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to list pitch scales")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
 	pitchID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
 	pitch, paginationOut, err := h.service.ListPitchScales(ctx, pitchID, data.ScaleFilter, data.Pagination)
 	switch {
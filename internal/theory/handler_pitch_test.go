@@ -28,6 +28,13 @@ func TestTheoryHandler_GetPitch(t *testing.T) {
 			},
 			ExpectedStatus: http.StatusNotFound,
 		},
+		{
+			Title: "Returns400WhenTuningIsInvalid",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GetPitch: []interface{}{nil, theory.ErrInvalidTuning},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
 		{
 			Title: "Returns500WhenFailed",
 			ServiceReturnValues: mock.TheoryServiceReturnValues{
@@ -0,0 +1,251 @@
+package theory
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/edipermadi/music-db/internal/platform/api"
+	"github.com/edipermadi/music-db/pkg/midi"
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/progression"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+// maxProgressionSize is maximum size of chord progression request body in bytes
+const maxProgressionSize = 1 << 20
+
+// progressionChordBeats is length of each chord of illustrated progressions in beats
+const progressionChordBeats = 4
+
+type progressionHandlers interface {
+	AnalyzeProgression(writer http.ResponseWriter, request *http.Request)
+	GenerateKeyProgression(writer http.ResponseWriter, request *http.Request)
+	IllustrateKeyProgressionAsMidiFile(writer http.ResponseWriter, request *http.Request)
+	LeadProgressionVoices(writer http.ResponseWriter, request *http.Request)
+	IllustrateVoiceLeadingAsWavFile(writer http.ResponseWriter, request *http.Request)
+	IllustrateVoiceLeadingAsMidiFile(writer http.ResponseWriter, request *http.Request)
+}
+
+func (h theoryHandler) installProgressionEndpoints(router *mux.Router) {
+	router.HandleFunc("/progressions/analyze", h.AnalyzeProgression).Methods(http.MethodPost).Name("ANALYZE_PROGRESSION")
+	router.HandleFunc("/progressions/voice_leading", h.LeadProgressionVoices).Methods(http.MethodGet).Name("LEAD_PROGRESSION_VOICES")
+	router.HandleFunc("/progressions/voice_leading/illustrations/wav", h.IllustrateVoiceLeadingAsWavFile).Methods(http.MethodGet).Name("ILLUSTRATE_VOICE_LEADING_AS_WAVE_FILE")
+	router.HandleFunc("/progressions/voice_leading/illustrations/midi", h.IllustrateVoiceLeadingAsMidiFile).Methods(http.MethodGet).Name("ILLUSTRATE_VOICE_LEADING_AS_MIDI_FILE")
+	router.HandleFunc("/keys/{id:[0-9]+}/progressions", h.GenerateKeyProgression).Methods(http.MethodGet).Name("GENERATE_KEY_PROGRESSION")
+	router.HandleFunc("/keys/{id:[0-9]+}/progressions/illustrations/midi", h.IllustrateKeyProgressionAsMidiFile).Methods(http.MethodGet).Name("ILLUSTRATE_KEY_PROGRESSION_AS_MIDI_FILE")
+}
+
+func (h theoryHandler) AnalyzeProgression(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	var data ProgressionAnalysisRequest
+	if err := json.NewDecoder(http.MaxBytesReader(writer, request.Body, maxProgressionSize)).Decode(&data); err != nil {
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadRequestBody)
+		return
+	}
+
+	analysis, err := h.service.AnalyzeProgression(ctx, data)
+	switch {
+	case errors.Is(err, ErrEmptyProgression), errors.Is(err, ErrProgressionTooLong), errors.Is(err, ErrInvalidChordSymbol), errors.Is(err, ErrUnsupportedSlashChord):
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadRequestBody)
+	case errors.Is(err, ErrKeyNotFound), errors.Is(err, ErrChordNotFound):
+		h.ReplyJSON(writer, http.StatusNotFound, api.ErrResourceNotFound)
+	case err != nil:
+		h.Logger().With(zap.Error(err)).Error("failed to analyze progression")
+		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
+	default:
+		h.ReplyJSON(writer, http.StatusOK, analysis)
+	}
+}
+
+func (h theoryHandler) GenerateKeyProgression(writer http.ResponseWriter, request *http.Request) {
+	var data ProgressionFilter
+	if err := h.decoder.Decode(&data, request.URL.Query()); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to parse progression parameters")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	if generated, ok := h.generateProgression(writer, request, data); ok {
+		h.ReplyJSON(writer, http.StatusOK, generated)
+	}
+}
+
+func (h theoryHandler) IllustrateKeyProgressionAsMidiFile(writer http.ResponseWriter, request *http.Request) {
+	type params struct {
+		ProgressionFilter
+		TuningFilter
+		MidiFilter
+		Tempo int `form:"tempo"`
+	}
+
+	var data params
+	if err := h.decoder.Decode(&data, request.URL.Query()); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to parse progression midi parameters")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	sequenceFilter := SequenceFilter{Tempo: data.Tempo}
+	sequenceFilter.Sanitize()
+	data.MidiFilter.Sanitize()
+
+	generated, ok := h.generateProgression(writer, request, data.ProgressionFilter)
+	if !ok {
+		return
+	}
+
+	// tune relative to the key tonic unless requested otherwise
+	if data.TuningFilter.TonicID == 0 {
+		data.TuningFilter.TonicID = generated.Tonic.ID
+	}
+
+	t, err := data.TuningFilter.Resolve()
+	if err != nil {
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	// voice each chord in close position with the root at the fourth octave
+	notes := make([][]pitch.Note, 0)
+	for _, v := range generated.Chords {
+		c, _ := chord.FromName(v.Chord.Name)
+		notes = append(notes, c.Notes(4))
+	}
+
+	sequence := midi.NewChordSequence(notes, progressionChordBeats)
+	sequence.Tempo = sequenceFilter.Tempo
+	sequence.Tuning = t
+
+	h.replyMidi(writer, sequence, data.MidiFilter, fmt.Sprintf("%s%sProgression%d.mid", generated.Key.Name, progression.StyleFromString(generated.Style), generated.Seed))
+}
+
+// generateProgression generates progression within key given in request, replying error when not generated
+func (h theoryHandler) generateProgression(writer http.ResponseWriter, request *http.Request, filter ProgressionFilter) (*GeneratedProgression, bool) {
+	keyID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
+	generated, err := h.service.GenerateProgression(request.Context(), keyID, filter)
+	switch {
+	case errors.Is(err, ErrInvalidStyle):
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return nil, false
+	case errors.Is(err, ErrKeyNotFound), errors.Is(err, ErrProgressionNotFound):
+		h.ReplyJSON(writer, http.StatusNotFound, api.ErrResourceNotFound)
+		return nil, false
+	case err != nil:
+		h.Logger().With(zap.Error(err)).Error("failed to generate progression")
+		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
+		return nil, false
+	}
+
+	return generated, true
+}
+
+func (h theoryHandler) LeadProgressionVoices(writer http.ResponseWriter, request *http.Request) {
+	var data VoiceLeadingFilter
+	if err := h.decoder.Decode(&data, request.URL.Query()); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to parse voice leading parameters")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	if leading, ok := h.leadVoices(writer, request, data); ok {
+		h.ReplyJSON(writer, http.StatusOK, leading)
+	}
+}
+
+func (h theoryHandler) IllustrateVoiceLeadingAsWavFile(writer http.ResponseWriter, request *http.Request) {
+	type params struct {
+		VoiceLeadingFilter
+		TuningFilter
+		Tempo int `form:"tempo"`
+	}
+
+	var data params
+	if err := h.decoder.Decode(&data, request.URL.Query()); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to parse voice leading wav parameters")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	sequence, ok := h.voiceLeadingSequence(writer, request, data.VoiceLeadingFilter, data.TuningFilter, data.Tempo)
+	if !ok {
+		return
+	}
+
+	h.replyRenderedWav(writer, sequence, "VoiceLeading.wav")
+}
+
+func (h theoryHandler) IllustrateVoiceLeadingAsMidiFile(writer http.ResponseWriter, request *http.Request) {
+	type params struct {
+		VoiceLeadingFilter
+		TuningFilter
+		MidiFilter
+		Tempo int `form:"tempo"`
+	}
+
+	var data params
+	if err := h.decoder.Decode(&data, request.URL.Query()); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to parse voice leading midi parameters")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	data.MidiFilter.Sanitize()
+	sequence, ok := h.voiceLeadingSequence(writer, request, data.VoiceLeadingFilter, data.TuningFilter, data.Tempo)
+	if !ok {
+		return
+	}
+
+	h.replyMidi(writer, sequence, data.MidiFilter, "VoiceLeading.mid")
+}
+
+// voiceLeadingSequence returns voiced chords played one after another, replying error when not voiced
+func (h theoryHandler) voiceLeadingSequence(writer http.ResponseWriter, request *http.Request, filter VoiceLeadingFilter, tuningFilter TuningFilter, tempo int) (midi.Sequence, bool) {
+	sequenceFilter := SequenceFilter{Tempo: tempo}
+	sequenceFilter.Sanitize()
+	t, err := tuningFilter.Resolve()
+	if err != nil {
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return midi.Sequence{}, false
+	}
+
+	leading, ok := h.leadVoices(writer, request, filter)
+	if !ok {
+		return midi.Sequence{}, false
+	}
+
+	notes := make([][]pitch.Note, 0)
+	for _, v := range leading.Chords {
+		notes = append(notes, v.Notes)
+	}
+
+	sequence := midi.NewChordSequence(notes, progressionChordBeats)
+	sequence.Tempo = sequenceFilter.Tempo
+	sequence.Velocity = 100
+	sequence.Tuning = t
+	return sequence, true
+}
+
+// leadVoices voices chords given in request, replying error when not voiced
+func (h theoryHandler) leadVoices(writer http.ResponseWriter, request *http.Request, filter VoiceLeadingFilter) (*VoiceLeading, bool) {
+	leading, err := h.service.LeadVoices(request.Context(), filter)
+	switch {
+	case errors.Is(err, ErrEmptyProgression), errors.Is(err, ErrProgressionTooLong), errors.Is(err, ErrInvalidVoiceRange), errors.Is(err, ErrInvalidChordSymbol), errors.Is(err, ErrUnsupportedSlashChord):
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return nil, false
+	case errors.Is(err, ErrChordNotFound), errors.Is(err, ErrVoiceLeadingNotFound):
+		h.ReplyJSON(writer, http.StatusNotFound, api.ErrResourceNotFound)
+		return nil, false
+	case err != nil:
+		h.Logger().With(zap.Error(err)).Error("failed to lead voices")
+		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
+		return nil, false
+	}
+
+	return leading, true
+}
@@ -0,0 +1,283 @@
+package theory_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/edipermadi/music-db/internal/theory"
+	"github.com/edipermadi/music-db/mock"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTheoryHandler_AnalyzeProgression(t *testing.T) {
+	type testCase struct {
+		handlerTestCase
+		Body string
+	}
+
+	body := `{"key_id": 1, "chords": [{"symbol": "D7"}, {"chord_id": 2}]}`
+	testCases := []testCase{
+		{
+			handlerTestCase: handlerTestCase{
+				Title: "Returns200WhenSucceeded",
+				ServiceReturnValues: mock.TheoryServiceReturnValues{
+					AnalyzeProgression: []interface{}{&theory.ProgressionAnalysis{Key: theory.SimplifiedKey{ID: 1, Name: "CNaturalIonian"}}, nil},
+				},
+				ExpectedStatus: http.StatusOK,
+			},
+			Body: body,
+		},
+		{
+			handlerTestCase: handlerTestCase{
+				Title:          "Returns400WhenBodyIsInvalid",
+				ExpectedStatus: http.StatusBadRequest,
+			},
+			Body: `{"key_id": "one"}`,
+		},
+		{
+			handlerTestCase: handlerTestCase{
+				Title: "Returns400WhenProgressionIsEmpty",
+				ServiceReturnValues: mock.TheoryServiceReturnValues{
+					AnalyzeProgression: []interface{}{nil, theory.ErrEmptyProgression},
+				},
+				ExpectedStatus: http.StatusBadRequest,
+			},
+			Body: `{"key_id": 1}`,
+		},
+		{
+			handlerTestCase: handlerTestCase{
+				Title: "Returns400WhenProgressionIsTooLong",
+				ServiceReturnValues: mock.TheoryServiceReturnValues{
+					AnalyzeProgression: []interface{}{nil, theory.ErrProgressionTooLong},
+				},
+				ExpectedStatus: http.StatusBadRequest,
+			},
+			Body: body,
+		},
+		{
+			handlerTestCase: handlerTestCase{
+				Title: "Returns400WhenSymbolIsInvalid",
+				ServiceReturnValues: mock.TheoryServiceReturnValues{
+					AnalyzeProgression: []interface{}{nil, theory.ErrInvalidChordSymbol},
+				},
+				ExpectedStatus: http.StatusBadRequest,
+			},
+			Body: body,
+		},
+		{
+			handlerTestCase: handlerTestCase{
+				Title: "Returns404WhenKeyNotFound",
+				ServiceReturnValues: mock.TheoryServiceReturnValues{
+					AnalyzeProgression: []interface{}{nil, theory.ErrKeyNotFound},
+				},
+				ExpectedStatus: http.StatusNotFound,
+			},
+			Body: body,
+		},
+		{
+			handlerTestCase: handlerTestCase{
+				Title: "Returns404WhenChordNotFound",
+				ServiceReturnValues: mock.TheoryServiceReturnValues{
+					AnalyzeProgression: []interface{}{nil, theory.ErrChordNotFound},
+				},
+				ExpectedStatus: http.StatusNotFound,
+			},
+			Body: body,
+		},
+		{
+			handlerTestCase: handlerTestCase{
+				Title: "Returns500WhenFailed",
+				ServiceReturnValues: mock.TheoryServiceReturnValues{
+					AnalyzeProgression: []interface{}{nil, errors.New("error")},
+				},
+				ExpectedStatus: http.StatusInternalServerError,
+			},
+			Body: body,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			server := tc.mockServer()
+			defer server.Close()
+
+			resp, err := tc.httpPost("/progressions/analyze", "application/json", strings.NewReader(tc.Body))
+			require.NoError(t, err)
+
+			defer func() { _ = resp.Body.Close() }()
+
+			require.Equal(t, tc.ExpectedStatus, resp.StatusCode)
+			if tc.ExpectedStatus == http.StatusOK {
+				var decoded theory.ProgressionAnalysis
+				require.NoError(t, json.NewDecoder(resp.Body).Decode(&decoded))
+				require.NotEmpty(t, decoded)
+			}
+		})
+	}
+}
+
+func TestTheoryHandler_GenerateKeyProgression(t *testing.T) {
+	generated := &theory.GeneratedProgression{
+		Key:   theory.SimplifiedKey{ID: 1, Name: "CNaturalIonian"},
+		Style: "pop",
+		Seed:  1,
+		Chords: []theory.AnalyzedChord{
+			{Chord: theory.SimplifiedChord{ID: 1, Name: "CNaturalMajor"}, Symbol: "C", RomanNumeral: "I"},
+			{Chord: theory.SimplifiedChord{ID: 2, Name: "GNaturalMajor"}, Symbol: "G", RomanNumeral: "V"},
+		},
+	}
+
+	testCases := []handlerTestCase{
+		{
+			Title: "Returns200WhenSucceeded",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GenerateProgression: []interface{}{generated, nil},
+			},
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Title: "Returns400WhenStyleIsInvalid",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GenerateProgression: []interface{}{nil, theory.ErrInvalidStyle},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns404WhenKeyNotFound",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GenerateProgression: []interface{}{nil, theory.ErrKeyNotFound},
+			},
+			ExpectedStatus: http.StatusNotFound,
+		},
+		{
+			Title: "Returns404WhenProgressionNotFound",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GenerateProgression: []interface{}{nil, theory.ErrProgressionNotFound},
+			},
+			ExpectedStatus: http.StatusNotFound,
+		},
+		{
+			Title: "Returns500WhenFailed",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GenerateProgression: []interface{}{nil, errors.New("error")},
+			},
+			ExpectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			server := tc.mockServer()
+			defer server.Close()
+
+			for _, path := range []string{"/keys/1/progressions", "/keys/1/progressions/illustrations/midi"} {
+				resp, err := tc.httpGet(path)
+				require.NoError(t, err)
+
+				require.Equal(t, tc.ExpectedStatus, resp.StatusCode)
+				_ = resp.Body.Close()
+			}
+		})
+	}
+}
+
+func TestTheoryHandler_LeadProgressionVoices(t *testing.T) {
+	leading := &theory.VoiceLeading{
+		Voices:   2,
+		Movement: 3,
+		Chords: []theory.VoicedChord{
+			{
+				Chord:  theory.SimplifiedChord{ID: 1, Name: "CNaturalMajor"},
+				Symbol: "C",
+				Notes:  []pitch.Note{pitch.NewNote(pitch.CNatural, 3), pitch.NewNote(pitch.ENatural, 4)},
+			},
+			{
+				Chord:    theory.SimplifiedChord{ID: 2, Name: "GNaturalMajor"},
+				Symbol:   "G",
+				Notes:    []pitch.Note{pitch.NewNote(pitch.BNatural, 2), pitch.NewNote(pitch.DNatural, 4)},
+				Movement: 3,
+			},
+		},
+	}
+
+	testCases := []handlerTestCase{
+		{
+			Title:             "Returns200WhenSucceeded",
+			GivenQueryStrings: url.Values{"chord": []string{"C", "G/B"}, "voices": []string{"2"}},
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				LeadVoices: []interface{}{leading, nil},
+			},
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Title:             "Returns400WhenParameterIsInvalid",
+			GivenQueryStrings: url.Values{"voices": []string{"four"}},
+			ExpectedStatus:    http.StatusBadRequest,
+		},
+		{
+			Title: "Returns400WhenProgressionIsEmpty",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				LeadVoices: []interface{}{nil, theory.ErrEmptyProgression},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns400WhenRangeIsInvalid",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				LeadVoices: []interface{}{nil, theory.ErrInvalidVoiceRange},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns404WhenChordNotFound",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				LeadVoices: []interface{}{nil, theory.ErrChordNotFound},
+			},
+			ExpectedStatus: http.StatusNotFound,
+		},
+		{
+			Title: "Returns404WhenVoiceLeadingNotFound",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				LeadVoices: []interface{}{nil, theory.ErrVoiceLeadingNotFound},
+			},
+			ExpectedStatus: http.StatusNotFound,
+		},
+		{
+			Title: "Returns500WhenFailed",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				LeadVoices: []interface{}{nil, errors.New("error")},
+			},
+			ExpectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			server := tc.mockServer()
+			defer server.Close()
+
+			paths := []string{"/progressions/voice_leading", "/progressions/voice_leading/illustrations/midi"}
+			if tc.ExpectedStatus != http.StatusOK {
+				paths = append(paths, "/progressions/voice_leading/illustrations/wav")
+			}
+
+			for _, path := range paths {
+				resp, err := tc.httpGet(path)
+				require.NoError(t, err)
+
+				require.Equal(t, tc.ExpectedStatus, resp.StatusCode)
+				if tc.ExpectedStatus == http.StatusOK && path == "/progressions/voice_leading" {
+					var decoded theory.VoiceLeading
+					require.NoError(t, json.NewDecoder(resp.Body).Decode(&decoded))
+					require.Equal(t, *leading, decoded)
+				}
+				_ = resp.Body.Close()
+			}
+		})
+	}
+}
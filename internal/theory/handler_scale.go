@@ -3,13 +3,13 @@ package theory
 import (
 	"errors"
 	"fmt"
-	"image/png"
 	"net/http"
 	"strconv"
 
 	"github.com/edipermadi/music-db/internal/platform/api"
 	"github.com/edipermadi/music-db/pkg/illustations"
 	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/spelling"
 	"github.com/gorilla/mux"
 	"go.uber.org/zap"
 )
@@ -31,6 +31,10 @@ func (h theoryHandler) installScaleEndpoints(router *mux.Router) {
 	router.HandleFunc("/scales/{id:[0-9]+}/illustrations/pitch_class_bracelet", h.IllustrateScaleAsPitchClassBraceletDiagram).Methods(http.MethodGet).Name("ILLUSTRATE_SCALE_AS_PITCH_CLASS_BRACELET")
 	router.HandleFunc("/scales/{id:[0-9]+}/illustrations/circle_of_fifth_bracelet", h.IllustrateScaleAsCircleOfFifthBraceletDiagram).Methods(http.MethodGet).Name("ILLUSTRATE_SCALE_AS_CIRCLE_OF_FIFTH_BRACELET")
 	router.HandleFunc("/scales/{id:[0-9]+}/illustrations/keyboard", h.IllustrateScaleWithKeyboard).Methods(http.MethodGet).Name("ILLUSTRATE_SCALE_WITH_KEYBOARD")
+	router.HandleFunc("/scales/{id:[0-9]+}/illustrations/fretboard", h.IllustrateScaleWithFretboard).Methods(http.MethodGet).Name("ILLUSTRATE_SCALE_WITH_FRETBOARD")
+	router.HandleFunc("/scales/{id:[0-9]+}/illustrations/staff", h.IllustrateScaleWithStaff).Methods(http.MethodGet).Name("ILLUSTRATE_SCALE_WITH_STAFF")
+	router.HandleFunc("/scales/{id:[0-9]+}/illustrations/wav", h.IllustrateScaleAsWavFile).Methods(http.MethodGet).Name("ILLUSTRATE_SCALE_AS_WAVE_FILE")
+	router.HandleFunc("/scales/{id:[0-9]+}/illustrations/midi", h.IllustrateScaleAsMidiFile).Methods(http.MethodGet).Name("ILLUSTRATE_SCALE_AS_MIDI_FILE")
 }
 
 func (h theoryHandler) ListScales(writer http.ResponseWriter, request *http.Request) {
@@ -48,6 +52,12 @@ func (h theoryHandler) ListScales(writer http.ResponseWriter, request *http.Requ
 		return
 	}
 
+	if err := data.ScaleFilter.Validate(); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to list scales")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
 	scales, paginationOut, err := h.service.ListScales(ctx, data.ScaleFilter, data.Pagination)
 	if err != nil {
 		h.Logger().With(zap.Error(err)).Error("failed to list scales")
@@ -99,6 +109,12 @@ func (h theoryHandler) ListScaleChords(writer http.ResponseWriter, request *http
 		return
 	}
 
+	if err := data.ChordFilter.Validate(); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to list scale chords")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
 	scaleID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
 	keys, pagination, err := h.service.ListScaleChords(ctx, scaleID, data.ChordFilter, data.Pagination)
 	if err != nil {
@@ -129,6 +145,11 @@ func (h theoryHandler) GetScale(writer http.ResponseWriter, request *http.Reques
 func (h theoryHandler) IllustrateScaleAsPitchClassBraceletDiagram(writer http.ResponseWriter, request *http.Request) {
 	ctx := request.Context()
 
+	options, ok := h.illustrationOptions(writer, request)
+	if !ok {
+		return
+	}
+
 	scaleID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
 
 	scale, err := h.service.GetScale(ctx, scaleID)
@@ -147,23 +168,28 @@ func (h theoryHandler) IllustrateScaleAsPitchClassBraceletDiagram(writer http.Re
 		pitches = append(pitches, pitch.FromInt(v+1))
 	}
 
+	// spell pitches with tonic of C
+	names := spelling.Pitches(pitches)
+
 	// draw bracelet illustration
-	img, err := illustations.PitchClassBracelet(pitches)
+	img, err := illustations.PitchClassBracelet(names, options)
 	if err != nil {
 		h.Logger().With(zap.Error(err)).Error("failed to draw bracelet diagram illustration for scale")
 		writer.WriteHeader(http.StatusInternalServerError)
 		return
 	}
 
-	writer.WriteHeader(http.StatusOK)
-	writer.Header().Set("Content-Type", "image/png")
-	writer.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", fmt.Sprintf("%sCircleOfFifthBracelet.png", scale.Name)))
-	_ = png.Encode(writer, img)
+	h.replyIllustration(writer, img, fmt.Sprintf("%sPitchClassBracelet", scale.Name))
 }
 
 func (h theoryHandler) IllustrateScaleAsCircleOfFifthBraceletDiagram(writer http.ResponseWriter, request *http.Request) {
 	ctx := request.Context()
 
+	options, ok := h.illustrationOptions(writer, request)
+	if !ok {
+		return
+	}
+
 	scaleID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
 
 	scale, err := h.service.GetScale(ctx, scaleID)
@@ -182,23 +208,33 @@ func (h theoryHandler) IllustrateScaleAsCircleOfFifthBraceletDiagram(writer http
 		pitches = append(pitches, pitch.FromInt(v+1))
 	}
 
+	// spell pitches with tonic of C
+	names := spelling.Pitches(pitches)
+
 	// draw bracelet illustration
-	img, err := illustations.CircleOfFifthBracelet(pitches)
+	img, err := illustations.CircleOfFifthBracelet(names, options)
 	if err != nil {
 		h.Logger().With(zap.Error(err)).Error("failed to draw bracelet diagram illustration for scale")
 		writer.WriteHeader(http.StatusInternalServerError)
 		return
 	}
 
-	writer.WriteHeader(http.StatusOK)
-	writer.Header().Set("Content-Type", "image/png")
-	writer.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", fmt.Sprintf("%sCircleOfFifthBracelet.png", scale.Name)))
-	_ = png.Encode(writer, img)
+	h.replyIllustration(writer, img, fmt.Sprintf("%sCircleOfFifthBracelet", scale.Name))
 }
 
 func (h theoryHandler) IllustrateScaleWithKeyboard(writer http.ResponseWriter, request *http.Request) {
 	ctx := request.Context()
 
+	options, ok := h.illustrationOptions(writer, request)
+	if !ok {
+		return
+	}
+
+	keys, ok := h.keyboardRange(writer, request)
+	if !ok {
+		return
+	}
+
 	scaleID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
 
 	scale, err := h.service.GetScale(ctx, scaleID)
@@ -217,16 +253,199 @@ func (h theoryHandler) IllustrateScaleWithKeyboard(writer http.ResponseWriter, r
 		pitches = append(pitches, pitch.FromInt(v+1))
 	}
 
+	// spell pitches with tonic of C
+	names := spelling.Pitches(pitches)
+
 	// draw keyboard illustration
-	img, err := illustations.Keyboard(pitches)
+	img, err := illustations.Keyboard(names, keys, options)
 	if err != nil {
 		h.Logger().With(zap.Error(err)).Error("failed to draw keyboard illustration for scale")
 		writer.WriteHeader(http.StatusInternalServerError)
 		return
 	}
 
-	writer.WriteHeader(http.StatusOK)
-	writer.Header().Set("Content-Type", "image/png")
-	writer.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", fmt.Sprintf("%sKeyboard.png", scale.Name)))
-	_ = png.Encode(writer, img)
+	h.replyIllustration(writer, img, fmt.Sprintf("%sKeyboard", scale.Name))
+}
+
+func (h theoryHandler) IllustrateScaleWithStaff(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	options, ok := h.illustrationOptions(writer, request)
+	if !ok {
+		return
+	}
+
+	staff, ok := h.staffType(writer, request)
+	if !ok {
+		return
+	}
+
+	scaleID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
+
+	scale, err := h.service.GetScale(ctx, scaleID)
+	switch {
+	case errors.Is(err, ErrScaleNotFound):
+		h.ReplyJSON(writer, http.StatusNotFound, api.ErrResourceNotFound)
+		return
+	case err != nil:
+		h.Logger().With(zap.Error(err)).Error("failed to get scale")
+		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
+		return
+	}
+
+	pitches := make(pitch.Slice, 0)
+	for _, v := range scale.PitchClass {
+		pitches = append(pitches, pitch.FromInt(v+1))
+	}
+
+	// spell pitches with tonic of C, notes ascend in key signature of the spelled pitches
+	names := spelling.Pitches(pitches)
+	img, err := illustations.Staff(staffMelody(pitches.Notes(staffOctave(staff))), names, staff, spelling.KeySignature(names), options)
+	if err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to draw staff illustration for scale")
+		writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	h.replyIllustration(writer, img, fmt.Sprintf("%sStaff", scale.Name))
+}
+
+func (h theoryHandler) IllustrateScaleWithFretboard(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	var data FretboardFilter
+	if err := h.decoder.Decode(&data, request.URL.Query()); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to parse fretboard parameters")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	data.Sanitize()
+	t, err := data.Resolve()
+	if err != nil {
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	options, ok := h.illustrationOptions(writer, request)
+	if !ok {
+		return
+	}
+
+	scaleID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
+
+	scale, err := h.service.GetScale(ctx, scaleID)
+	switch {
+	case errors.Is(err, ErrScaleNotFound):
+		h.ReplyJSON(writer, http.StatusNotFound, api.ErrResourceNotFound)
+		return
+	case err != nil:
+		h.Logger().With(zap.Error(err)).Error("failed to get scale")
+		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
+		return
+	}
+
+	pitches := make([]pitch.Type, 0)
+	for _, v := range scale.PitchClass {
+		pitches = append(pitches, pitch.FromInt(v+1))
+	}
+
+	// spell pitches with tonic of C
+	h.replyFretboard(writer, t, spelling.Pitches(pitches), data.Frets, options, fmt.Sprintf("%sFretboard", scale.Name))
+}
+
+func (h theoryHandler) IllustrateScaleAsWavFile(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	type params struct {
+		SequenceFilter
+		TuningFilter
+	}
+
+	var data params
+	if err := h.decoder.Decode(&data, request.URL.Query()); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to parse scale audio parameters")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	data.SequenceFilter.Sanitize()
+	t, err := data.TuningFilter.Resolve()
+	if err != nil {
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	scaleID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
+
+	scale, err := h.service.GetScale(ctx, scaleID)
+	switch {
+	case errors.Is(err, ErrScaleNotFound):
+		h.ReplyJSON(writer, http.StatusNotFound, api.ErrResourceNotFound)
+		return
+	case err != nil:
+		h.Logger().With(zap.Error(err)).Error("failed to get scale")
+		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
+		return
+	}
+
+	pitches := make(pitch.Slice, 0)
+	for _, v := range scale.PitchClass {
+		pitches = append(pitches, pitch.FromInt(v+1))
+	}
+
+	// play scale with tonic of C starting at the fourth octave
+	h.replySequenceWav(writer, pitches.Notes(4), data.SequenceFilter, t, fmt.Sprintf("%sPiano.wav", scale.Name))
+}
+
+func (h theoryHandler) IllustrateScaleAsMidiFile(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	type params struct {
+		SequenceFilter
+		TuningFilter
+		MidiFilter
+	}
+
+	var data params
+	if err := h.decoder.Decode(&data, request.URL.Query()); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to parse scale midi parameters")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	data.SequenceFilter.Sanitize()
+	data.MidiFilter.Sanitize()
+	t, err := data.TuningFilter.Resolve()
+	if err != nil {
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	scaleID, _ := strconv.ParseInt(mux.Vars(request)["id"], 10, 64)
+
+	scale, err := h.service.GetScale(ctx, scaleID)
+	switch {
+	case errors.Is(err, ErrScaleNotFound):
+		h.ReplyJSON(writer, http.StatusNotFound, api.ErrResourceNotFound)
+		return
+	case err != nil:
+		h.Logger().With(zap.Error(err)).Error("failed to get scale")
+		h.ReplyJSON(writer, http.StatusInternalServerError, api.ErrInternalServer)
+		return
+	}
+
+	pitches := make(pitch.Slice, 0)
+	for _, v := range scale.PitchClass {
+		pitches = append(pitches, pitch.FromInt(v+1))
+	}
+
+	// play scale with tonic of C starting at the fourth octave
+	sequence, err := data.SequenceFilter.Sequence(pitches.Notes(4), t)
+	if err != nil {
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return
+	}
+
+	h.replyMidi(writer, sequence, data.MidiFilter, fmt.Sprintf("%s.mid", scale.Name))
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"net/url"
 	"testing"
 
 	"github.com/edipermadi/music-db/internal/platform/api"
@@ -142,6 +143,13 @@ func TestTheoryHandler_ListScales(t *testing.T) {
 			},
 			ExpectedStatus: http.StatusOK,
 		},
+		{
+			Title: "Returns400WhenIntervalVectorIsInvalid",
+			GivenQueryStrings: url.Values{
+				"interval_vector": []string{"2", "5", "4", "3", "6"},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
 		{
 			Title: "Returns500WhenFailed",
 			ServiceReturnValues: mock.TheoryServiceReturnValues{
@@ -170,3 +178,108 @@ func TestTheoryHandler_ListScales(t *testing.T) {
 		})
 	}
 }
+
+func TestTheoryHandler_IllustrateScaleWithStaff(t *testing.T) {
+	testCases := []handlerTestCase{
+		{
+			Title: "Returns400WhenStaffIsInvalid",
+			GivenQueryStrings: url.Values{
+				"staff": []string{"alto"},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns400WhenFormatIsInvalid",
+			GivenQueryStrings: url.Values{
+				"format": []string{"gif"},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns200WhenStaffIsGrand",
+			GivenQueryStrings: url.Values{
+				"staff":  []string{"grand"},
+				"format": []string{"svg"},
+			},
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GetScale: []interface{}{&theory.DetailedScale{ID: 1, Name: "Ionian", PitchClass: theory.SliceInt{0, 2, 4, 5, 7, 9, 11}}, nil},
+			},
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Title: "Returns404WhenNotFound",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GetScale: []interface{}{nil, theory.ErrScaleNotFound},
+			},
+			ExpectedStatus: http.StatusNotFound,
+		},
+		{
+			Title: "Returns500WhenFailed",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GetScale: []interface{}{nil, errors.New("error")},
+			},
+			ExpectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			server := tc.mockServer()
+			defer server.Close()
+
+			resp, err := tc.httpGet("/scales/1/illustrations/staff")
+			require.NoError(t, err)
+
+			defer func() { _ = resp.Body.Close() }()
+
+			require.Equal(t, tc.ExpectedStatus, resp.StatusCode)
+		})
+	}
+}
+
+func TestTheoryHandler_IllustrateScaleWithFretboard(t *testing.T) {
+	testCases := []handlerTestCase{
+		{
+			Title: "Returns400WhenTuningIsInvalid",
+			GivenQueryStrings: url.Values{
+				"tuning": []string{"banjo"},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns400WhenFormatIsInvalid",
+			GivenQueryStrings: url.Values{
+				"format": []string{"gif"},
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Title: "Returns404WhenNotFound",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GetScale: []interface{}{nil, theory.ErrScaleNotFound},
+			},
+			ExpectedStatus: http.StatusNotFound,
+		},
+		{
+			Title: "Returns500WhenFailed",
+			ServiceReturnValues: mock.TheoryServiceReturnValues{
+				GetScale: []interface{}{nil, errors.New("error")},
+			},
+			ExpectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			server := tc.mockServer()
+			defer server.Close()
+
+			resp, err := tc.httpGet("/scales/1/illustrations/fretboard")
+			require.NoError(t, err)
+
+			defer func() { _ = resp.Body.Close() }()
+
+			require.Equal(t, tc.ExpectedStatus, resp.StatusCode)
+		})
+	}
+}
@@ -2,9 +2,11 @@ package theory_test
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/edipermadi/music-db/internal/theory"
@@ -24,6 +26,7 @@ func TestNewHandler(t *testing.T) {
 type handlerTestCase struct {
 	Title               string
 	GivenQueryStrings   url.Values
+	GivenHeaders        http.Header
 	ServiceReturnValues mock.TheoryServiceReturnValues
 	ExpectedStatus      int
 	baseURL             string
@@ -54,6 +57,22 @@ func (h *handlerTestCase) httpGet(path string) (*http.Response, error) {
 		return nil, err
 	}
 
+	for k, v := range h.GivenHeaders {
+		req.Header[k] = v
+	}
+
+	req.URL.RawQuery = h.rawQuery()
+	return http.DefaultClient.Do(req)
+}
+
+func (h *handlerTestCase) httpPost(path string, contentType string, body io.Reader) (*http.Response, error) {
+	// avoid redirection of cleaned path, which turns the request into GET
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/%s", h.baseURL, strings.TrimPrefix(path, "/")), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", contentType)
 	req.URL.RawQuery = h.rawQuery()
 	return http.DefaultClient.Do(req)
 }
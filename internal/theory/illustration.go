@@ -0,0 +1,96 @@
+package theory
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/edipermadi/music-db/internal/platform/api"
+	"github.com/edipermadi/music-db/pkg/illustations"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"go.uber.org/zap"
+)
+
+// illustrationOptions returns illustration options requested by query parameters, where format is negotiated from
+// Accept header when not given, replying error when the options are invalid
+func (h theoryHandler) illustrationOptions(writer http.ResponseWriter, request *http.Request) (illustations.Options, bool) {
+	var data IllustrationFilter
+	if err := h.decoder.Decode(&data, request.URL.Query()); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to parse illustration options")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return illustations.Options{}, false
+	}
+
+	data.Sanitize()
+	options, err := data.Resolve(request.Header.Get("Accept"))
+	if err != nil {
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return illustations.Options{}, false
+	}
+
+	return options, true
+}
+
+// keyboardRange returns range of keyboard keys requested by query parameters, replying error when the range is invalid
+func (h theoryHandler) keyboardRange(writer http.ResponseWriter, request *http.Request) (illustations.KeyboardRange, bool) {
+	var data KeyboardFilter
+	if err := h.decoder.Decode(&data, request.URL.Query()); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to parse keyboard range")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return illustations.KeyboardRange{}, false
+	}
+
+	keys, err := data.Resolve()
+	if err != nil {
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return illustations.KeyboardRange{}, false
+	}
+
+	return keys, true
+}
+
+// staffType returns staff of staff notation requested by query parameters, replying error when the staff is invalid
+func (h theoryHandler) staffType(writer http.ResponseWriter, request *http.Request) (illustations.StaffType, bool) {
+	var data StaffFilter
+	if err := h.decoder.Decode(&data, request.URL.Query()); err != nil {
+		h.Logger().With(zap.Error(err)).Error("failed to parse staff")
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return illustations.InvalidStaffType, false
+	}
+
+	data.Sanitize()
+	staff, err := data.Resolve()
+	if err != nil {
+		h.ReplyJSON(writer, http.StatusBadRequest, api.ErrBadQueryParameter)
+		return illustations.InvalidStaffType, false
+	}
+
+	return staff, true
+}
+
+// staffOctave returns octave where notes written on given staff start, notes start below middle C on bass and grand
+// staves
+func staffOctave(staff illustations.StaffType) int {
+	if staff == illustations.TrebleStaff {
+		return 4
+	}
+
+	return 3
+}
+
+// staffMelody returns notes written one after another on staff
+func staffMelody(notes []pitch.Note) [][]pitch.Note {
+	columns := make([][]pitch.Note, 0)
+	for _, v := range notes {
+		columns = append(columns, []pitch.Note{v})
+	}
+
+	return columns
+}
+
+// replyIllustration writes illustration in its format, given file name is suffixed with extension of the format
+func (h theoryHandler) replyIllustration(writer http.ResponseWriter, img illustations.Illustration, name string) {
+	writer.Header().Set("Content-Type", img.Format().ContentType())
+	writer.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", fmt.Sprintf("%s.%s", name, img.Format())))
+	writer.WriteHeader(http.StatusOK)
+	_ = img.Encode(writer)
+}
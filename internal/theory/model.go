@@ -1,8 +1,24 @@
 package theory
 
 import (
+	"database/sql/driver"
 	"encoding/json"
 	"errors"
+	"mime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/edipermadi/music-db/pkg/illustations"
+	"github.com/edipermadi/music-db/pkg/midi"
+	"github.com/edipermadi/music-db/pkg/theory/analysis"
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/fretboard"
+	"github.com/edipermadi/music-db/pkg/theory/pcset"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/progression"
+	"github.com/edipermadi/music-db/pkg/theory/tuning"
+	"github.com/edipermadi/music-db/pkg/theory/voicing"
 )
 
 // SimplifiedPitch is simplified pitch object
@@ -13,11 +29,13 @@ type SimplifiedPitch struct {
 
 // DetailedPitch is detailed pitch object
 type DetailedPitch struct {
-	ID            int64   `json:"id" db:"id"`
-	Name          string  `json:"name" db:"name"`
-	ZeitlerNumber int     `json:"zeitler_number" db:"zeitler_number"`
-	RingNumber    int     `json:"ring_number" db:"ring_number"`
-	Frequency     float64 `json:"frequency" db:"frequency"`
+	ID             int64   `json:"id" db:"id"`
+	Name           string  `json:"name" db:"name"`
+	ZeitlerNumber  int     `json:"zeitler_number" db:"zeitler_number"`
+	RingNumber     int     `json:"ring_number" db:"ring_number"`
+	Frequency      float64 `json:"frequency" db:"frequency"`
+	Tuning         string  `json:"tuning" db:"-"`
+	CentsDeviation float64 `json:"cents_deviation" db:"-"`
 }
 
 // SimplifiedChordQuality is simplified chord quality object
@@ -35,6 +53,10 @@ type DetailedChordQuality struct {
 	Cardinality     int      `json:"cardinality" db:"cardinality"`
 	PitchClass      SliceInt `json:"pitch_class" db:"pitch_class"`
 	IntervalPattern SliceInt `json:"interval_pattern" db:"interval_pattern"`
+	Intervals       SliceInt `json:"intervals" db:"intervals"`
+	ForteNumber     string   `json:"forte_number" db:"forte_number"`
+	PrimeForm       SliceInt `json:"prime_form" db:"prime_form"`
+	IntervalVector  SliceInt `json:"interval_vector" db:"interval_vector"`
 }
 
 // SimplifiedChord is simplified chord object
@@ -48,18 +70,32 @@ type DetailedChord struct {
 	ID            int64                  `json:"id" db:"id"`
 	Quality       SimplifiedChordQuality `json:"quality" db:"quality"`
 	Root          SimplifiedPitch        `json:"root" db:"root"`
+	Bass          SimplifiedPitch        `json:"bass" db:"bass"`
+	Inversion     int                    `json:"inversion" db:"inversion"`
 	Name          string                 `json:"name" db:"name"`
+	Symbol        string                 `json:"symbol" db:"-"`
 	ZeitlerNumber int                    `json:"zeitler_number" db:"zeitler_number"`
 	RingNumber    int                    `json:"ring_number" db:"ring_number"`
+	Spelling      []string               `json:"spelling" db:"-"`
 }
 
 // ChordFilter represents chord filter
 type ChordFilter struct {
-	ChordQualityID int64 `form:"chord_quality_id"`
-	RootID         int64 `form:"root_id"`
-	ZeitlerNumber  int   `form:"zeitler_number"`
-	RingNumber     int   `form:"ring_number"`
-	Cardinality    int   `form:"cardinality"`
+	ChordQualityID int64  `form:"chord_quality_id"`
+	RootID         int64  `form:"root_id"`
+	BassID         int64  `form:"bass_id"`
+	Inversion      *int   `form:"inversion"`
+	ZeitlerNumber  int    `form:"zeitler_number"`
+	RingNumber     int    `form:"ring_number"`
+	Cardinality    int    `form:"cardinality"`
+	ForteNumber    string `form:"forte_number"`
+	PrimeForm      []int  `form:"prime_form"`
+	IntervalVector []int  `form:"interval_vector"`
+}
+
+// Validate validates chord filter, interval vector counts six interval classes when given
+func (f ChordFilter) Validate() error {
+	return validateIntervalVector(f.IntervalVector)
 }
 
 // DetailedScale is detailed scale object
@@ -80,6 +116,9 @@ type DetailedScale struct {
 	ReflectionalSymmetryAxes SliceInt `json:"reflectional_symmetry_axes" db:"reflectional_symmetry_axes"`
 	Balanced                 bool     `json:"balanced" db:"balanced"`
 	FifthGeneratorRootDegree int      `json:"fifth_generator_root_degree" db:"fifth_generator_root_degree"`
+	ForteNumber              string   `json:"forte_number" db:"forte_number"`
+	PrimeForm                SliceInt `json:"prime_form" db:"prime_form"`
+	IntervalVector           SliceInt `json:"interval_vector" db:"interval_vector"`
 }
 
 // SimplifiedScale is simplified scale object
@@ -90,17 +129,25 @@ type SimplifiedScale struct {
 
 // ScaleFilter is scale filter
 type ScaleFilter struct {
-	TonicID                 int64 `form:"tonic_id"`
-	ZeitlerNumber           int   `form:"zeitler_number"`
-	RingNumber              int   `form:"ring_number"`
-	Perfection              *int  `form:"perfection"`
-	Imperfection            *int  `form:"imperfection"`
-	Balanced                *bool `form:"balanced"`
-	RotationalSymmetric     *bool `form:"rotational_symmetric"`
-	RotationalSymmetryLevel int   `form:"rotational_symmetry_level"`
-	ReflectionalSymmetric   *bool `form:"reflectional_symmetric"`
-	Palindromic             *bool `form:"palindromic"`
-	Cardinality             int   `form:"cardinality"`
+	TonicID                 int64  `form:"tonic_id"`
+	ZeitlerNumber           int    `form:"zeitler_number"`
+	RingNumber              int    `form:"ring_number"`
+	Perfection              *int   `form:"perfection"`
+	Imperfection            *int   `form:"imperfection"`
+	Balanced                *bool  `form:"balanced"`
+	RotationalSymmetric     *bool  `form:"rotational_symmetric"`
+	RotationalSymmetryLevel int    `form:"rotational_symmetry_level"`
+	ReflectionalSymmetric   *bool  `form:"reflectional_symmetric"`
+	Palindromic             *bool  `form:"palindromic"`
+	Cardinality             int    `form:"cardinality"`
+	ForteNumber             string `form:"forte_number"`
+	PrimeForm               []int  `form:"prime_form"`
+	IntervalVector          []int  `form:"interval_vector"`
+}
+
+// Validate validates scale filter, interval vector counts six interval classes when given
+func (f ScaleFilter) Validate() error {
+	return validateIntervalVector(f.IntervalVector)
 }
 
 // DetailedKey is detailed key object
@@ -114,6 +161,7 @@ type DetailedKey struct {
 	Balanced      bool            `json:"balanced" db:"balanced"`
 	CenterX       float64         `json:"center_x" db:"center_x"`
 	CenterY       float64         `json:"center_y" db:"center_y"`
+	Spelling      []string        `json:"spelling" db:"-"`
 }
 
 // SimplifiedKey is simplified key object
@@ -138,6 +186,770 @@ type KeyFilter struct {
 	Cardinality             int   `form:"cardinality"`
 }
 
+// IdentificationFilter represents pitch identification parameters
+type IdentificationFilter struct {
+	PitchIDs    []int64 `form:"pitch_id"`
+	MaxDistance *int    `form:"max_distance"`
+	Limit       int     `form:"limit"`
+}
+
+// Sanitize sanitizes identification filter, max distance defaults to one pitch and zero leaves out near misses
+func (f *IdentificationFilter) Sanitize() {
+	if f.MaxDistance == nil {
+		maxDistance := 1
+		f.MaxDistance = &maxDistance
+	}
+
+	if *f.MaxDistance < 0 {
+		*f.MaxDistance = 0
+	}
+
+	if *f.MaxDistance > 3 {
+		*f.MaxDistance = 3
+	}
+
+	if f.Limit < 1 {
+		f.Limit = 50
+	}
+
+	if f.Limit > 100 {
+		f.Limit = 100
+	}
+}
+
+// TuningFilter represents tuning parameters
+type TuningFilter struct {
+	System    string  `form:"tuning"`
+	Reference float64 `form:"reference"`
+	TonicID   int64   `form:"tuning_tonic_id"`
+}
+
+// Resolve returns tuning of the filter, defaults to equal temperament with A4 tuned to 440Hz built from C
+func (f TuningFilter) Resolve() (tuning.Tuning, error) {
+	system := tuning.EqualTemperament
+	if f.System != "" {
+		system = tuning.FromString(f.System)
+	}
+
+	if system == tuning.Invalid || f.Reference < 0 || f.Reference > 20000 {
+		return tuning.Tuning{}, ErrInvalidTuning
+	}
+
+	tonic := pitch.CNatural
+	if f.TonicID != 0 {
+		tonic = pitch.FromInt(int(f.TonicID))
+	}
+
+	if tonic == pitch.Invalid {
+		return tuning.Tuning{}, ErrInvalidTuning
+	}
+
+	return tuning.New(system, f.Reference, tonic), nil
+}
+
+// Sequence directions
+const (
+	DirectionAscending  = "ascending"
+	DirectionDescending = "descending"
+	DirectionBoth       = "both"
+)
+
+// SequenceFilter represents parameters of notes played one after another
+type SequenceFilter struct {
+	Direction  string  `form:"direction"`
+	Tempo      int     `form:"tempo"`
+	NoteLength float64 `form:"note_length"`
+}
+
+// Sanitize sanitizes sequence filter
+func (f *SequenceFilter) Sanitize() {
+	if f.Direction == "" {
+		f.Direction = DirectionAscending
+	}
+
+	if f.Tempo < 1 {
+		f.Tempo = 120
+	}
+
+	if f.Tempo < 20 {
+		f.Tempo = 20
+	}
+
+	if f.Tempo > 400 {
+		f.Tempo = 400
+	}
+
+	if f.NoteLength <= 0 {
+		f.NoteLength = 1
+	}
+
+	if f.NoteLength < 0.1 {
+		f.NoteLength = 0.1
+	}
+
+	if f.NoteLength > 4 {
+		f.NoteLength = 4
+	}
+}
+
+// Arrange returns ascending notes closed with the first note an octave above, arranged according to direction
+func (f SequenceFilter) Arrange(notes []pitch.Note) ([]pitch.Note, error) {
+	ascending := make([]pitch.Note, 0)
+	ascending = append(ascending, notes...)
+	if len(notes) > 0 {
+		ascending = append(ascending, notes[0].Transpose(12))
+	}
+
+	descending := make([]pitch.Note, 0)
+	for i := len(ascending) - 1; i >= 0; i-- {
+		descending = append(descending, ascending[i])
+	}
+
+	switch f.Direction {
+	case DirectionAscending:
+		return ascending, nil
+	case DirectionDescending:
+		return descending, nil
+	case DirectionBoth:
+		if len(descending) > 0 {
+			descending = descending[1:]
+		}
+		return append(ascending, descending...), nil
+	default:
+		return nil, ErrInvalidDirection
+	}
+}
+
+// Chord playback styles
+const (
+	StyleBlock       = "block"
+	StyleArpeggiated = "arpeggiated"
+)
+
+// MidiFilter represents standard MIDI file parameters
+type MidiFilter struct {
+	Format   int   `form:"smf_format"`
+	Program  int32 `form:"program"`
+	Velocity int32 `form:"velocity"`
+}
+
+// Sanitize sanitizes MIDI filter
+func (f *MidiFilter) Sanitize() {
+	if f.Program < 0 {
+		f.Program = 0
+	}
+
+	if f.Program > 127 {
+		f.Program = 127
+	}
+
+	if f.Velocity < 1 {
+		f.Velocity = 100
+	}
+
+	if f.Velocity > 127 {
+		f.Velocity = 127
+	}
+}
+
+// Sequence returns notes arranged according to direction as sequence with default velocity
+func (f SequenceFilter) Sequence(notes []pitch.Note, t tuning.Tuning) (midi.Sequence, error) {
+	arranged, err := f.Arrange(notes)
+	if err != nil {
+		return midi.Sequence{}, err
+	}
+
+	return midi.Sequence{
+		Notes:      arranged,
+		Tempo:      f.Tempo,
+		NoteLength: f.NoteLength,
+		Velocity:   100,
+		Tuning:     t,
+	}, nil
+}
+
+// VoicingFilter represents chord voicing parameters, such as "drop_2" or "rootless_a"
+type VoicingFilter struct {
+	Voicing string `form:"voicing"`
+}
+
+// Notes returns notes of a chord in voicing of the filter with the bass at given octave. Without voicing, chord tones
+// are voiced in root position order with tensions above the octave.
+func (f VoicingFilter) Notes(c chord.Chord, octave int) ([]pitch.Note, error) {
+	if f.Voicing == "" {
+		return c.Notes(octave), nil
+	}
+
+	v := voicing.FromString(f.Voicing)
+	if v == voicing.Invalid {
+		return nil, ErrInvalidVoicing
+	}
+
+	notes, found := v.Voice(c, octave)
+	if !found {
+		return nil, ErrVoicingNotApplicable
+	}
+
+	return notes, nil
+}
+
+// IdentifiedKey is key matching identified pitches
+type IdentifiedKey struct {
+	ID       int64             `json:"id"`
+	Name     string            `json:"name"`
+	Distance int               `json:"distance"`
+	Missing  []SimplifiedPitch `json:"missing"`
+	Extra    []SimplifiedPitch `json:"extra"`
+}
+
+// IdentifiedChord is chord matching identified pitches
+type IdentifiedChord struct {
+	ID       int64             `json:"id"`
+	Name     string            `json:"name"`
+	Distance int               `json:"distance"`
+	Missing  []SimplifiedPitch `json:"missing"`
+	Extra    []SimplifiedPitch `json:"extra"`
+}
+
+// Identification is pitch identification result
+type Identification struct {
+	ZeitlerNumber  int               `json:"zeitler_number"`
+	RingNumber     int               `json:"ring_number"`
+	Pitches        []SimplifiedPitch `json:"pitches"`
+	ExactKeys      []IdentifiedKey   `json:"exact_keys"`
+	SupersetKeys   []IdentifiedKey   `json:"superset_keys"`
+	NearMissKeys   []IdentifiedKey   `json:"near_miss_keys"`
+	ExactChords    []IdentifiedChord `json:"exact_chords"`
+	SupersetChords []IdentifiedChord `json:"superset_chords"`
+	NearMissChords []IdentifiedChord `json:"near_miss_chords"`
+}
+
+// AnalysisFilter represents analysis parameters
+type AnalysisFilter struct {
+	Limit int `form:"limit"`
+}
+
+// Sanitize sanitizes analysis filter
+func (f *AnalysisFilter) Sanitize() {
+	if f.Limit < 1 {
+		f.Limit = 10
+	}
+
+	if f.Limit > 100 {
+		f.Limit = 100
+	}
+}
+
+// KeyEstimationFilter represents key estimation parameters, weights are given for each pitch from C to B
+type KeyEstimationFilter struct {
+	Weights []float64 `form:"weight"`
+	Profile string    `form:"profile"`
+	Limit   int       `form:"limit"`
+}
+
+// Sanitize sanitizes key estimation filter
+func (f *KeyEstimationFilter) Sanitize() {
+	if f.Profile == "" {
+		f.Profile = analysis.KrumhanslKessler.Identifier()
+	}
+
+	if f.Limit < 1 {
+		f.Limit = 24
+	}
+
+	if f.Limit > 24 {
+		f.Limit = 24
+	}
+}
+
+// EstimatedKey is key estimated by correlating its profile with pitch distribution
+type EstimatedKey struct {
+	ID          int64   `json:"id"`
+	Name        string  `json:"name"`
+	Correlation float64 `json:"correlation"`
+}
+
+// WeightedPitch is pitch with its share of total note duration
+type WeightedPitch struct {
+	ID     int64   `json:"id"`
+	Name   string  `json:"name"`
+	Weight float64 `json:"weight"`
+}
+
+// AnalyzedKey is key estimated from weighted pitches
+type AnalyzedKey struct {
+	ID       int64   `json:"id"`
+	Name     string  `json:"name"`
+	Coverage float64 `json:"coverage"`
+}
+
+// AnalyzedBeat is chord sounding at a beat, chord is null when nothing sounds
+type AnalyzedBeat struct {
+	Beat  int              `json:"beat"`
+	Chord *SimplifiedChord `json:"chord"`
+}
+
+// MidiAnalysis is analysis result of a MIDI file
+type MidiAnalysis struct {
+	Format    int             `json:"format"`
+	Tracks    int             `json:"tracks"`
+	Division  int             `json:"division"`
+	Notes     int             `json:"notes"`
+	Histogram []WeightedPitch `json:"histogram"`
+	Keys      []AnalyzedKey   `json:"keys"`
+	Beats     []AnalyzedBeat  `json:"beats"`
+}
+
+// ChordTransformation is a neo-Riemannian transformation applied to a triad, with the resulting triad
+type ChordTransformation struct {
+	Transformation string          `json:"transformation"`
+	Name           string          `json:"name"`
+	Chord          SimplifiedChord `json:"chord"`
+}
+
+// ChordPath is the shortest sequence of neo-Riemannian transformations leading from a triad to another
+type ChordPath struct {
+	From            SimplifiedChord       `json:"from"`
+	To              SimplifiedChord       `json:"to"`
+	Transformations string                `json:"transformations"`
+	Steps           []ChordTransformation `json:"steps"`
+}
+
+// TransformationFilter represents transformations allowed when searching chord path, given as letter or name
+type TransformationFilter struct {
+	Transformations []string `form:"transformation"`
+}
+
+// Resolve returns transformations of the filter, defaults to P, L and R
+func (f TransformationFilter) Resolve() ([]chord.Transformation, error) {
+	if len(f.Transformations) == 0 {
+		return chord.PrimaryTransformations(), nil
+	}
+
+	transformations := make([]chord.Transformation, 0)
+	for _, v := range f.Transformations {
+		transformation := chord.TransformationFromString(v)
+		if transformation == chord.InvalidTransformation {
+			return nil, ErrInvalidTransformation
+		}
+
+		transformations = append(transformations, transformation)
+	}
+
+	return transformations, nil
+}
+
+// KeyHarmony is stacked thirds harmony built on a key degree, chords are null when stacked pitches do not make up
+// a known chord
+type KeyHarmony struct {
+	Degree  int             `json:"degree"`
+	Root    SimplifiedPitch `json:"root"`
+	Triad   *HarmonyChord   `json:"triad"`
+	Seventh *HarmonyChord   `json:"seventh"`
+	Ninth   *HarmonyChord   `json:"ninth"`
+}
+
+// HarmonyChord is a chord built on a key degree with its Roman numeral and symbol
+type HarmonyChord struct {
+	RomanNumeral string          `json:"roman_numeral"`
+	Symbol       string          `json:"symbol"`
+	Chord        SimplifiedChord `json:"chord"`
+}
+
+// ProgressionAnalysisRequest represents chord progression to analyze within a key
+type ProgressionAnalysisRequest struct {
+	KeyID  int64              `json:"key_id"`
+	Chords []ProgressionChord `json:"chords"`
+}
+
+// ProgressionChord refers to a chord of a progression, either by identifier or by symbol such as "G7/B"
+type ProgressionChord struct {
+	ChordID int64  `json:"chord_id"`
+	Symbol  string `json:"symbol"`
+}
+
+// ProgressionAnalysis is Roman numeral analysis of a chord progression
+type ProgressionAnalysis struct {
+	Key    SimplifiedKey   `json:"key"`
+	Chords []AnalyzedChord `json:"chords"`
+}
+
+// AnalyzedChord is a chord of a progression with its Roman numeral and harmonic function
+type AnalyzedChord struct {
+	Chord        SimplifiedChord `json:"chord"`
+	Symbol       string          `json:"symbol"`
+	RomanNumeral string          `json:"roman_numeral"`
+	Degree       string          `json:"degree"`
+	Function     string          `json:"function"`
+	Diatonic     bool            `json:"diatonic"`
+}
+
+// ProgressionFilter represents chord progression generation parameters, the same seed generates the same progression
+type ProgressionFilter struct {
+	Style  string `form:"style"`
+	Length int    `form:"length"`
+	Seed   *int64 `form:"seed"`
+}
+
+// Sanitize sanitizes progression filter, length defaults to a bar per chord of twelve bar blues or four otherwise
+func (f *ProgressionFilter) Sanitize() {
+	if f.Style == "" {
+		f.Style = progression.Pop.Identifier()
+	}
+
+	if f.Length < 1 {
+		f.Length = 4
+		if progression.StyleFromString(f.Style) == progression.Blues {
+			f.Length = 12
+		}
+	}
+
+	if f.Length > 64 {
+		f.Length = 64
+	}
+
+	if f.Seed == nil {
+		seed := time.Now().UnixNano()
+		f.Seed = &seed
+	}
+}
+
+// Resolve returns progression style of the filter
+func (f ProgressionFilter) Resolve() (progression.Style, error) {
+	style := progression.StyleFromString(f.Style)
+	if style == progression.InvalidStyle {
+		return progression.InvalidStyle, ErrInvalidStyle
+	}
+
+	return style, nil
+}
+
+// GeneratedProgression is chord progression generated within a key with the seed generating it
+type GeneratedProgression struct {
+	Key    SimplifiedKey   `json:"key"`
+	Tonic  SimplifiedPitch `json:"tonic"`
+	Style  string          `json:"style"`
+	Seed   int64           `json:"seed"`
+	Chords []AnalyzedChord `json:"chords"`
+}
+
+// VoiceLeadingFilter represents chords voiced one after another, given by symbol such as "G7/B", with voice count and
+// range of voices in scientific pitch notation
+type VoiceLeadingFilter struct {
+	Chords []string `form:"chord"`
+	Voices int      `form:"voices"`
+	Low    string   `form:"low"`
+	High   string   `form:"high"`
+}
+
+// Sanitize sanitizes voice leading filter, voices default to four from E2 to G5
+func (f *VoiceLeadingFilter) Sanitize() {
+	if f.Voices < 1 {
+		f.Voices = 4
+	}
+
+	if f.Voices > 6 {
+		f.Voices = 6
+	}
+
+	if f.Low == "" {
+		f.Low = voicing.DefaultRange.Low.String()
+	}
+
+	if f.High == "" {
+		f.High = voicing.DefaultRange.High.String()
+	}
+}
+
+// Resolve returns range of voices of the filter
+func (f VoiceLeadingFilter) Resolve() (voicing.Range, error) {
+	low, err := pitch.ParseNote(f.Low)
+	if err != nil {
+		return voicing.Range{}, ErrInvalidVoiceRange
+	}
+
+	high, err := pitch.ParseNote(f.High)
+	if err != nil {
+		return voicing.Range{}, ErrInvalidVoiceRange
+	}
+
+	r := voicing.Range{Low: low, High: high}
+	if !r.Valid() {
+		return voicing.Range{}, ErrInvalidVoiceRange
+	}
+
+	return r, nil
+}
+
+// VoiceLeading is voicing of each chord of a progression with total semitone movement of voices
+type VoiceLeading struct {
+	Voices   int           `json:"voices"`
+	Movement int           `json:"movement"`
+	Chords   []VoicedChord `json:"chords"`
+}
+
+// VoicedChord is a chord with its notes from the lowest voice and semitone movement of voices from previous chord
+type VoicedChord struct {
+	Chord    SimplifiedChord `json:"chord"`
+	Symbol   string          `json:"symbol"`
+	Notes    []pitch.Note    `json:"notes"`
+	Movement int             `json:"movement"`
+}
+
+// FingeringFilter represents fretted instrument tuning, such as "drop_d" or "ukulele", with the highest fret reached,
+// the number of frets fretting fingers may spread over and the number of fingerings returned
+type FingeringFilter struct {
+	Tuning  string `form:"tuning"`
+	MaxFret int    `form:"max_fret"`
+	MaxSpan int    `form:"max_span"`
+	Limit   int    `form:"limit"`
+}
+
+// Sanitize sanitizes fingering filter, fingerings default to ten in standard tuning up to the twelfth fret spread
+// over four frets
+func (f *FingeringFilter) Sanitize() {
+	if f.Tuning == "" {
+		f.Tuning = fretboard.Standard.Identifier()
+	}
+
+	if f.MaxFret < 1 {
+		f.MaxFret = 12
+	}
+
+	if f.MaxFret > 24 {
+		f.MaxFret = 24
+	}
+
+	if f.MaxSpan < 2 {
+		f.MaxSpan = 4
+	}
+
+	if f.MaxSpan > 6 {
+		f.MaxSpan = 6
+	}
+
+	if f.Limit < 1 {
+		f.Limit = 10
+	}
+
+	if f.Limit > 100 {
+		f.Limit = 100
+	}
+}
+
+// Resolve returns instrument tuning of the filter
+func (f FingeringFilter) Resolve() (fretboard.Tuning, error) {
+	t := fretboard.FromString(f.Tuning)
+	if t == fretboard.Invalid {
+		return fretboard.Invalid, ErrInvalidInstrument
+	}
+
+	return t, nil
+}
+
+// FretboardFilter represents fretted instrument tuning, such as "drop_d" or "ukulele", with the number of frets shown
+type FretboardFilter struct {
+	Tuning string `form:"tuning"`
+	Frets  int    `form:"frets"`
+}
+
+// Sanitize sanitizes fretboard filter, fretboard defaults to twelve frets in standard tuning
+func (f *FretboardFilter) Sanitize() {
+	if f.Tuning == "" {
+		f.Tuning = fretboard.Standard.Identifier()
+	}
+
+	if f.Frets < 1 {
+		f.Frets = 12
+	}
+
+	if f.Frets > 24 {
+		f.Frets = 24
+	}
+}
+
+// Resolve returns instrument tuning of the filter
+func (f FretboardFilter) Resolve() (fretboard.Tuning, error) {
+	t := fretboard.FromString(f.Tuning)
+	if t == fretboard.Invalid {
+		return fretboard.Invalid, ErrInvalidInstrument
+	}
+
+	return t, nil
+}
+
+// KeyboardFilter represents range of keyboard keys, given either by number of keys of a common keyboard such as 61 or
+// 88, or by the lowest and the highest note in scientific pitch notation. Keyboard fits the illustrated notes when no
+// range is given.
+type KeyboardFilter struct {
+	Keys int    `form:"keys"`
+	From string `form:"from"`
+	To   string `form:"to"`
+}
+
+// Resolve returns keyboard range of the filter
+func (f KeyboardFilter) Resolve() (illustations.KeyboardRange, error) {
+	switch {
+	case f.Keys != 0 && (f.From != "" || f.To != ""):
+		return illustations.KeyboardRange{}, ErrInvalidKeyboardRange
+	case f.Keys != 0:
+		r, ok := illustations.KeyboardSize(f.Keys)
+		if !ok {
+			return illustations.KeyboardRange{}, ErrInvalidKeyboardRange
+		}
+
+		return r, nil
+	case f.From == "" && f.To == "":
+		return illustations.KeyboardRange{}, nil
+	}
+
+	low, err := pitch.ParseNote(f.From)
+	if err != nil {
+		return illustations.KeyboardRange{}, ErrInvalidKeyboardRange
+	}
+
+	high, err := pitch.ParseNote(f.To)
+	if err != nil {
+		return illustations.KeyboardRange{}, ErrInvalidKeyboardRange
+	}
+
+	r := illustations.KeyboardRange{Low: low, High: high}
+	if !r.Valid() {
+		return illustations.KeyboardRange{}, ErrInvalidKeyboardRange
+	}
+
+	return r, nil
+}
+
+// StaffFilter represents staff of staff notation, either "treble", "bass" or "grand"
+type StaffFilter struct {
+	Staff string `form:"staff"`
+}
+
+// Sanitize sanitizes staff filter, staff defaults to treble
+func (f *StaffFilter) Sanitize() {
+	if f.Staff == "" {
+		f.Staff = illustations.TrebleStaff.Identifier()
+	}
+}
+
+// Resolve returns staff type of the filter
+func (f StaffFilter) Resolve() (illustations.StaffType, error) {
+	staff := illustations.StaffTypeFromString(f.Staff)
+	if staff == illustations.InvalidStaffType {
+		return illustations.InvalidStaffType, ErrInvalidStaff
+	}
+
+	return staff, nil
+}
+
+// IllustrationFilter represents illustration options, where format such as "png" or "svg" is negotiated from Accept
+// header when not given. Palette is either a named palette or four comma separated hex triplets and labels is a label
+// style such as "flats" or "degrees".
+type IllustrationFilter struct {
+	Format  string `form:"format"`
+	Width   int    `form:"width"`
+	Height  int    `form:"height"`
+	Palette string `form:"palette"`
+	Dark    bool   `form:"dark"`
+	Labels  string `form:"labels"`
+	Legend  bool   `form:"legend"`
+}
+
+// Sanitize sanitizes illustration filter, illustrations default to their natural size with material palette and
+// spelled labels
+func (f *IllustrationFilter) Sanitize() {
+	f.Width = min(max(f.Width, 0), 2048)
+	f.Height = min(max(f.Height, 0), 2048)
+
+	if f.Palette == "" {
+		f.Palette = "material"
+	}
+
+	if f.Labels == "" {
+		f.Labels = illustations.SpelledLabels.Identifier()
+	}
+}
+
+// Resolve returns illustration options of the filter, where format is negotiated from given Accept header
+func (f IllustrationFilter) Resolve(accept string) (illustations.Options, error) {
+	format, err := f.format(accept)
+	if err != nil {
+		return illustations.Options{}, err
+	}
+
+	palette, ok := illustations.PaletteFromString(f.Palette)
+	if !ok {
+		return illustations.Options{}, ErrInvalidPalette
+	}
+
+	labels := illustations.LabelStyleFromString(f.Labels)
+	if labels == illustations.InvalidLabelStyle {
+		return illustations.Options{}, ErrInvalidLabelStyle
+	}
+
+	return illustations.Options{
+		Format:  format,
+		Width:   f.Width,
+		Height:  f.Height,
+		Palette: palette,
+		Dark:    f.Dark,
+		Labels:  labels,
+		Legend:  f.Legend,
+	}, nil
+}
+
+// format returns illustration format of the filter, which is negotiated from given Accept header when not given.
+// Accepted formats of higher quality are preferred and illustrations default to png.
+func (f IllustrationFilter) format(accept string) (illustations.Format, error) {
+	if f.Format != "" {
+		format := illustations.FormatFromString(strings.ToLower(f.Format))
+		if format == illustations.InvalidFormat {
+			return illustations.InvalidFormat, ErrInvalidFormat
+		}
+
+		return format, nil
+	}
+
+	format := illustations.PNG
+	quality := 0.0
+	for _, v := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(v)
+		if err != nil {
+			continue
+		}
+
+		candidate := illustations.FormatFromString(mediaType)
+		if mediaType == "*/*" || mediaType == "image/*" {
+			candidate = illustations.PNG
+		}
+
+		q := 1.0
+		if value, found := params["q"]; found {
+			q, _ = strconv.ParseFloat(value, 64)
+		}
+
+		if candidate != illustations.InvalidFormat && q > quality {
+			format = candidate
+			quality = q
+		}
+	}
+
+	return format, nil
+}
+
+// ChordFingering is chord fingering on a fretted instrument, with a fret for each string from the lowest one where -1
+// is a muted string, its chord chart such as "x32010" and sounding notes in the order of strings
+type ChordFingering struct {
+	Tuning     string       `json:"tuning"`
+	Strings    []pitch.Note `json:"strings"`
+	Frets      []int        `json:"frets"`
+	Chart      string       `json:"chart"`
+	Notes      []pitch.Note `json:"notes"`
+	Position   int          `json:"position"`
+	Difficulty int          `json:"difficulty"`
+}
+
 // SliceInt implements array of int jsonb
 type SliceInt []int
 
@@ -150,3 +962,28 @@ func (s *SliceInt) Scan(value interface{}) error {
 
 	return json.Unmarshal(b, s)
 }
+
+// Value encodes array of int as JSONB
+func (s SliceInt) Value() (driver.Value, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(b), nil
+}
+
+// primeForm returns Forte prime form of given pitch classes, matching prime forms stored along chord qualities and
+// scales
+func primeForm(pitchClasses []int) SliceInt {
+	return SliceInt(pcset.New(pitchClasses...).PrimeForm(pcset.Forte))
+}
+
+// validateIntervalVector fails when given interval vector does not count interval classes 1 to 6
+func validateIntervalVector(intervalVector []int) error {
+	if len(intervalVector) > 0 && len(intervalVector) != 6 {
+		return ErrInvalidIntervalVector
+	}
+
+	return nil
+}
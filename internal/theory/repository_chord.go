@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/edipermadi/music-db/internal/platform/api"
+	"github.com/jmoiron/sqlx"
 )
 
 type chordRepository interface {
@@ -18,6 +19,8 @@ type chordRepository interface {
 	ListChordPitches(ctx context.Context, chordID int64) ([]SimplifiedPitch, error)
 	ListChordScales(ctx context.Context, chordID int64, filter ScaleFilter, pagination api.Pagination) ([]SimplifiedScale, *api.Pagination, error)
 	ListChords(ctx context.Context, filter ChordFilter, pagination api.Pagination) ([]SimplifiedChord, *api.Pagination, error)
+	ListChordsByID(ctx context.Context, chordIDs []int64) ([]DetailedChord, error)
+	ListChordsByName(ctx context.Context, names []string) ([]SimplifiedChord, error)
 }
 
 func (r theoryRepository) ListChords(ctx context.Context, filter ChordFilter, pagination api.Pagination) ([]SimplifiedChord, *api.Pagination, error) {
@@ -36,6 +39,16 @@ func (r theoryRepository) ListChords(ctx context.Context, filter ChordFilter, pa
 		clauses = append(clauses, "c.root_id = ?")
 	}
 
+	if filter.BassID > 0 {
+		args = append(args, filter.BassID)
+		clauses = append(clauses, "c.bass_id = ?")
+	}
+
+	if filter.Inversion != nil && (*filter.Inversion) >= 0 {
+		args = append(args, *filter.Inversion)
+		clauses = append(clauses, "c.inversion = ?")
+	}
+
 	if filter.ZeitlerNumber > 0 {
 		args = append(args, filter.ZeitlerNumber)
 		clauses = append(clauses, "c.zeitler_number = ?")
@@ -51,6 +64,21 @@ func (r theoryRepository) ListChords(ctx context.Context, filter ChordFilter, pa
 		clauses = append(clauses, "cq.cardinality = ?")
 	}
 
+	if filter.ForteNumber != "" {
+		args = append(args, filter.ForteNumber)
+		clauses = append(clauses, "cq.forte_number = ?")
+	}
+
+	if len(filter.PrimeForm) > 0 {
+		args = append(args, primeForm(filter.PrimeForm))
+		clauses = append(clauses, "cq.prime_form = ?")
+	}
+
+	if len(filter.IntervalVector) > 0 {
+		args = append(args, SliceInt(filter.IntervalVector))
+		clauses = append(clauses, "cq.interval_vector = ?")
+	}
+
 	condition := "TRUE"
 	if len(clauses) > 0 {
 		condition = strings.Join(clauses, " AND ")
@@ -295,6 +323,21 @@ func (r theoryRepository) ListChordScales(ctx context.Context, chordID int64, fi
 		clauses = append(clauses, "s.cardinality = ?")
 	}
 
+	if filter.ForteNumber != "" {
+		args = append(args, filter.ForteNumber)
+		clauses = append(clauses, "s.forte_number = ?")
+	}
+
+	if len(filter.PrimeForm) > 0 {
+		args = append(args, primeForm(filter.PrimeForm))
+		clauses = append(clauses, "s.prime_form = ?")
+	}
+
+	if len(filter.IntervalVector) > 0 {
+		args = append(args, SliceInt(filter.IntervalVector))
+		clauses = append(clauses, "s.interval_vector = ?")
+	}
+
 	queryCount := fmt.Sprintf(`
 		SELECT
 			COUNT(s.id)
@@ -350,12 +393,16 @@ func (r theoryRepository) GetChord(ctx context.Context, chordID int64) (*Detaile
 			cq.name AS "quality.name",
 			p.id    AS "root.id",
 			p.name  AS "root.name",
+			b.id    AS "bass.id",
+			b.name  AS "bass.name",
+			c.inversion,
 			c.name,
 			c.zeitler_number,
 			c.ring_number
 		FROM chords c 
 			JOIN chord_qualities cq ON c.chord_quality_id = cq.id
 			JOIN pitches p ON c.root_id = p.id
+			JOIN pitches b ON c.bass_id = b.id
 		WHERE
 			c.id = $1;`
 
@@ -379,7 +426,11 @@ func (r theoryRepository) GetChordQuality(ctx context.Context, chordID int64) (*
 			cq.zeitler_number,
 			cq.ring_number,
 			cq.pitch_class,
-			cq.interval_pattern
+			cq.interval_pattern,
+			cq.intervals,
+			cq.forte_number,
+			cq.prime_form,
+			cq.interval_vector
 		FROM chords c 
 			JOIN chord_qualities cq ON c.chord_quality_id = cq.id
 			JOIN pitches p ON c.root_id = p.id
@@ -396,3 +447,67 @@ func (r theoryRepository) GetChordQuality(ctx context.Context, chordID int64) (*
 
 	return &quality, nil
 }
+
+func (r theoryRepository) ListChordsByID(ctx context.Context, chordIDs []int64) ([]DetailedChord, error) {
+	entries := make([]DetailedChord, 0)
+	if len(chordIDs) == 0 {
+		return entries, nil
+	}
+
+	query, args, err := sqlx.In(`
+		SELECT
+			c.id,
+			cq.id   AS "quality.id",
+			cq.name AS "quality.name",
+			p.id    AS "root.id",
+			p.name  AS "root.name",
+			b.id    AS "bass.id",
+			b.name  AS "bass.name",
+			c.inversion,
+			c.name,
+			c.zeitler_number,
+			c.ring_number
+		FROM chords c
+			JOIN chord_qualities cq ON c.chord_quality_id = cq.id
+			JOIN pitches p ON c.root_id = p.id
+			JOIN pitches b ON c.bass_id = b.id
+		WHERE
+			c.id IN (?)
+		ORDER BY
+			c.id;`, chordIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.db.SelectContext(ctx, &entries, r.db.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (r theoryRepository) ListChordsByName(ctx context.Context, names []string) ([]SimplifiedChord, error) {
+	entries := make([]SimplifiedChord, 0)
+	if len(names) == 0 {
+		return entries, nil
+	}
+
+	query, args, err := sqlx.In(`
+		SELECT
+			c.id,
+			c.name
+		FROM chords c
+		WHERE
+			c.name IN (?)
+		ORDER BY
+			c.id;`, names)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.db.SelectContext(ctx, &entries, r.db.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
@@ -179,6 +179,32 @@ func TestTheoryRepository_ListChords(t *testing.T) {
 			ExpectedCountArgs: []driver.Value{sqlmock.AnyArg()},
 			ExpectedListArgs:  []driver.Value{sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()},
 		},
+		{
+			Title:       "ReturnsChordsWhenSucceededWithPrimeFormFilter",
+			GivenFilter: theory.ChordFilter{PrimeForm: []int{7, 0, 4}},
+			ExpectedCountQuery: `
+				SELECT
+					COUNT(1)
+				FROM chords c
+					JOIN chord_qualities cq ON c.chord_quality_id = cq.id
+				WHERE
+					cq.prime_form = $1;`,
+			ExpectedListQuery: `
+				SELECT
+					c.id,
+					c.name
+				FROM chords c 
+					JOIN chord_qualities cq ON c.chord_quality_id = cq.id
+					JOIN pitches p ON c.root_id = p.id
+				WHERE
+					cq.prime_form = $1
+				ORDER BY
+					c.id
+				OFFSET $2
+				LIMIT  $3;`,
+			ExpectedCountArgs: []driver.Value{"[0,3,7]"},
+			ExpectedListArgs:  []driver.Value{"[0,3,7]", sqlmock.AnyArg(), sqlmock.AnyArg()},
+		},
 		{
 			Title: "ReturnsErrorWhenCountChordsFailed",
 			ExpectedCountQuery: `
@@ -302,12 +328,16 @@ func TestTheoryRepository_GetChord(t *testing.T) {
 			cq.name AS "quality.name",
 			p.id    AS "root.id",
 			p.name  AS "root.name",
+			b.id    AS "bass.id",
+			b.name  AS "bass.name",
+			c.inversion,
 			c.name,
 			c.zeitler_number,
 			c.ring_number
 		FROM chords c 
 			JOIN chord_qualities cq ON c.chord_quality_id = cq.id
 			JOIN pitches p ON c.root_id = p.id
+			JOIN pitches b ON c.bass_id = b.id
 		WHERE
 			c.id = $1;`
 
@@ -317,6 +347,9 @@ func TestTheoryRepository_GetChord(t *testing.T) {
 		"quality.name",
 		"root.id",
 		"root.name",
+		"bass.id",
+		"bass.name",
+		"inversion",
 		"name",
 		"zeitler_number",
 		"ring_number",
@@ -337,7 +370,7 @@ func TestTheoryRepository_GetChord(t *testing.T) {
 				sqlMock.ExpectQuery(getChordQuery).
 					WithArgs(sqlmock.AnyArg()).
 					WillReturnRows(sqlmock.NewRows(getChordColumns).
-						AddRow(1, 2, "name1", 3, "name2", "name3", 4, 5))
+						AddRow(1, 2, "name1", 3, "name2", 4, "name3", 1, "name4", 6, 7))
 			}
 
 			repository := theory.NewRepository(logger, db)
@@ -377,7 +410,11 @@ func TestTheoryRepository_GetChordQuality(t *testing.T) {
 			cq.zeitler_number,
 			cq.ring_number,
 			cq.pitch_class,
-			cq.interval_pattern
+			cq.interval_pattern,
+			cq.intervals,
+			cq.forte_number,
+			cq.prime_form,
+			cq.interval_vector
 		FROM chords c 
 			JOIN chord_qualities cq ON c.chord_quality_id = cq.id
 			JOIN pitches p ON c.root_id = p.id
@@ -392,6 +429,10 @@ func TestTheoryRepository_GetChordQuality(t *testing.T) {
 		"ring_number",
 		"pitch_class",
 		"interval_pattern",
+		"intervals",
+		"forte_number",
+		"prime_form",
+		"interval_vector",
 	}
 
 	for _, tc := range testCases {
@@ -409,7 +450,7 @@ func TestTheoryRepository_GetChordQuality(t *testing.T) {
 				sqlMock.ExpectQuery(getChordQualityQuery).
 					WithArgs(sqlmock.AnyArg()).
 					WillReturnRows(sqlmock.NewRows(getChordQualityColumns).
-						AddRow(1, "name", 2, 3, 4, []byte("[1,2,3]"), []byte("[1,2]")))
+						AddRow(1, "name", 2, 3, 4, []byte("[1,2,3]"), []byte("[1,2]"), []byte("[1,2,3]"), "3-11", []byte("[0,3,7]"), []byte("[0,0,1,1,1,0]")))
 			}
 
 			repository := theory.NewRepository(logger, db)
@@ -1471,3 +1512,174 @@ func TestTheoryRepository_ListChordPitches(t *testing.T) {
 		})
 	}
 }
+
+func TestTheoryRepository_ListChordsByID(t *testing.T) {
+	type testCase struct {
+		Title      string
+		GivenIDs   []int64
+		GivenError error
+	}
+
+	testCases := []testCase{
+		{
+			Title:    "ReturnsChordsWhenSucceeded",
+			GivenIDs: []int64{1, 2},
+		},
+		{
+			Title: "ReturnsNothingWhenIDsAreEmpty",
+		},
+		{
+			Title:      "ReturnsErrorWhenFailed",
+			GivenIDs:   []int64{1, 2},
+			GivenError: sql.ErrConnDone,
+		},
+	}
+
+	listChordsByIDQuery := `
+		SELECT
+			c.id,
+			cq.id   AS "quality.id",
+			cq.name AS "quality.name",
+			p.id    AS "root.id",
+			p.name  AS "root.name",
+			b.id    AS "bass.id",
+			b.name  AS "bass.name",
+			c.inversion,
+			c.name,
+			c.zeitler_number,
+			c.ring_number
+		FROM chords c
+			JOIN chord_qualities cq ON c.chord_quality_id = cq.id
+			JOIN pitches p ON c.root_id = p.id
+			JOIN pitches b ON c.bass_id = b.id
+		WHERE
+			c.id IN ($1, $2)
+		ORDER BY
+			c.id;`
+
+	listChordsByIDColumns := []string{
+		"id",
+		"quality.id",
+		"quality.name",
+		"root.id",
+		"root.name",
+		"bass.id",
+		"bass.name",
+		"inversion",
+		"name",
+		"zeitler_number",
+		"ring_number",
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			logger := mock.Logger()
+
+			db, sqlMock, err := mockDatabase()
+			require.NoError(t, err)
+
+			if tc.GivenError != nil {
+				sqlMock.ExpectQuery(listChordsByIDQuery).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnError(tc.GivenError)
+			} else if len(tc.GivenIDs) > 0 {
+				sqlMock.ExpectQuery(listChordsByIDQuery).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows(listChordsByIDColumns).
+						AddRow(1, 2, "name1", 3, "name2", 4, "name3", 0, "name4", 6, 7).
+						AddRow(2, 2, "name1", 3, "name2", 4, "name3", 0, "name5", 8, 9))
+			}
+
+			repository := theory.NewRepository(logger, db)
+			entries, err := repository.ListChordsByID(context.Background(), tc.GivenIDs)
+			switch {
+			case strings.HasPrefix(tc.Title, "ReturnsError"):
+				require.Error(t, err)
+				require.Empty(t, entries)
+			case strings.HasPrefix(tc.Title, "ReturnsNothing"):
+				require.NoError(t, err)
+				require.Empty(t, entries)
+			default:
+				require.NoError(t, err)
+				require.Len(t, entries, 2)
+			}
+
+			require.NoError(t, sqlMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTheoryRepository_ListChordsByName(t *testing.T) {
+	type testCase struct {
+		Title      string
+		GivenNames []string
+		GivenError error
+	}
+
+	testCases := []testCase{
+		{
+			Title:      "ReturnsChordsWhenSucceeded",
+			GivenNames: []string{"CNaturalMajor", "DNaturalMinor"},
+		},
+		{
+			Title: "ReturnsNothingWhenNamesAreEmpty",
+		},
+		{
+			Title:      "ReturnsErrorWhenFailed",
+			GivenNames: []string{"CNaturalMajor", "DNaturalMinor"},
+			GivenError: sql.ErrConnDone,
+		},
+	}
+
+	listChordsByNameQuery := `
+		SELECT
+			c.id,
+			c.name
+		FROM chords c
+		WHERE
+			c.name IN ($1, $2)
+		ORDER BY
+			c.id;`
+
+	listChordsByNameColumns := []string{
+		"id",
+		"name",
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			logger := mock.Logger()
+
+			db, sqlMock, err := mockDatabase()
+			require.NoError(t, err)
+
+			if tc.GivenError != nil {
+				sqlMock.ExpectQuery(listChordsByNameQuery).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnError(tc.GivenError)
+			} else if len(tc.GivenNames) > 0 {
+				sqlMock.ExpectQuery(listChordsByNameQuery).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows(listChordsByNameColumns).
+						AddRow(1, "CNaturalMajor").
+						AddRow(2, "DNaturalMinor"))
+			}
+
+			repository := theory.NewRepository(logger, db)
+			entries, err := repository.ListChordsByName(context.Background(), tc.GivenNames)
+			switch {
+			case strings.HasPrefix(tc.Title, "ReturnsError"):
+				require.Error(t, err)
+				require.Empty(t, entries)
+			case strings.HasPrefix(tc.Title, "ReturnsNothing"):
+				require.NoError(t, err)
+				require.Empty(t, entries)
+			default:
+				require.NoError(t, err)
+				require.Len(t, entries, 2)
+			}
+
+			require.NoError(t, sqlMock.ExpectationsWereMet())
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/edipermadi/music-db/internal/platform/api"
+	"github.com/jmoiron/sqlx"
 )
 
 type keyRepository interface {
@@ -17,6 +18,7 @@ type keyRepository interface {
 	ListKeyModes(ctx context.Context, keyID int64, filter KeyFilter) ([]SimplifiedKey, error)
 	ListKeyPitches(ctx context.Context, keyID int64) ([]SimplifiedPitch, error)
 	ListKeys(ctx context.Context, filter KeyFilter, pagination api.Pagination) ([]SimplifiedKey, *api.Pagination, error)
+	ListKeysByName(ctx context.Context, names []string) ([]SimplifiedKey, error)
 }
 
 func (r theoryRepository) ListKeys(ctx context.Context, filter KeyFilter, pagination api.Pagination) ([]SimplifiedKey, *api.Pagination, error) {
@@ -241,6 +243,16 @@ func (r theoryRepository) ListKeyChords(ctx context.Context, keyID int64, filter
 		clauses = append(clauses, "c.root_id = ?")
 	}
 
+	if filter.BassID > 0 {
+		args = append(args, filter.BassID)
+		clauses = append(clauses, "c.bass_id = ?")
+	}
+
+	if filter.Inversion != nil && (*filter.Inversion) >= 0 {
+		args = append(args, *filter.Inversion)
+		clauses = append(clauses, "c.inversion = ?")
+	}
+
 	if filter.ZeitlerNumber > 0 {
 		args = append(args, filter.ZeitlerNumber)
 		clauses = append(clauses, "c.zeitler_number = ?")
@@ -256,6 +268,21 @@ func (r theoryRepository) ListKeyChords(ctx context.Context, keyID int64, filter
 		clauses = append(clauses, "cq.cardinality = ?")
 	}
 
+	if filter.ForteNumber != "" {
+		args = append(args, filter.ForteNumber)
+		clauses = append(clauses, "cq.forte_number = ?")
+	}
+
+	if len(filter.PrimeForm) > 0 {
+		args = append(args, primeForm(filter.PrimeForm))
+		clauses = append(clauses, "cq.prime_form = ?")
+	}
+
+	if len(filter.IntervalVector) > 0 {
+		args = append(args, SliceInt(filter.IntervalVector))
+		clauses = append(clauses, "cq.interval_vector = ?")
+	}
+
 	queryCount := fmt.Sprintf(`
 		SELECT
 			COUNT(DISTINCT c.id)
@@ -353,3 +380,29 @@ func (r theoryRepository) GetKey(ctx context.Context, keyID int64) (*DetailedKey
 
 	return &entry, nil
 }
+
+func (r theoryRepository) ListKeysByName(ctx context.Context, names []string) ([]SimplifiedKey, error) {
+	entries := make([]SimplifiedKey, 0)
+	if len(names) == 0 {
+		return entries, nil
+	}
+
+	query, args, err := sqlx.In(`
+		SELECT
+			k.id,
+			k.name
+		FROM keys k
+		WHERE
+			k.name IN (?)
+		ORDER BY
+			k.id;`, names)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.db.SelectContext(ctx, &entries, r.db.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
@@ -1272,3 +1272,78 @@ func TestTheoryRepository_ListKeyPitches(t *testing.T) {
 		})
 	}
 }
+
+func TestTheoryRepository_ListKeysByName(t *testing.T) {
+	type testCase struct {
+		Title      string
+		GivenNames []string
+		GivenError error
+	}
+
+	testCases := []testCase{
+		{
+			Title:      "ReturnsKeysWhenSucceeded",
+			GivenNames: []string{"CNaturalIonian", "DNaturalDorian"},
+		},
+		{
+			Title: "ReturnsNothingWhenNamesAreEmpty",
+		},
+		{
+			Title:      "ReturnsErrorWhenFailed",
+			GivenNames: []string{"CNaturalIonian", "DNaturalDorian"},
+			GivenError: sql.ErrConnDone,
+		},
+	}
+
+	listKeysByNameQuery := `
+		SELECT
+			k.id,
+			k.name
+		FROM keys k
+		WHERE
+			k.name IN ($1, $2)
+		ORDER BY
+			k.id;`
+
+	listKeysByNameColumns := []string{
+		"id",
+		"name",
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			logger := mock.Logger()
+
+			db, sqlMock, err := mockDatabase()
+			require.NoError(t, err)
+
+			if tc.GivenError != nil {
+				sqlMock.ExpectQuery(listKeysByNameQuery).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnError(tc.GivenError)
+			} else if len(tc.GivenNames) > 0 {
+				sqlMock.ExpectQuery(listKeysByNameQuery).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows(listKeysByNameColumns).
+						AddRow(1, "CNaturalIonian").
+						AddRow(2, "DNaturalDorian"))
+			}
+
+			repository := theory.NewRepository(logger, db)
+			entries, err := repository.ListKeysByName(context.Background(), tc.GivenNames)
+			switch {
+			case strings.HasPrefix(tc.Title, "ReturnsError"):
+				require.Error(t, err)
+				require.Empty(t, entries)
+			case strings.HasPrefix(tc.Title, "ReturnsNothing"):
+				require.NoError(t, err)
+				require.Empty(t, entries)
+			default:
+				require.NoError(t, err)
+				require.Len(t, entries, 2)
+			}
+
+			require.NoError(t, sqlMock.ExpectationsWereMet())
+		})
+	}
+}
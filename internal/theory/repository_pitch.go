@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/edipermadi/music-db/internal/platform/api"
+	"github.com/edipermadi/music-db/pkg/theory/chord"
 )
 
 type pitchRepository interface {
@@ -57,6 +58,22 @@ func (r theoryRepository) ListPitchChords(ctx context.Context, pitchID int64, fi
 		args = append(args, filter.RootID)
 	}
 
+	if filter.BassID > 0 {
+		clauses = append(clauses, "c.bass_id = ?")
+		args = append(args, filter.BassID)
+	}
+
+	// chords are listed in root position unless another inversion is given, a negative inversion lists every inversion
+	inversion := int(chord.RootPosition)
+	if filter.Inversion != nil {
+		inversion = *filter.Inversion
+	}
+
+	if inversion >= 0 {
+		clauses = append(clauses, "c.inversion = ?")
+		args = append(args, inversion)
+	}
+
 	if filter.ZeitlerNumber > 0 {
 		clauses = append(clauses, "c.zeitler_number = ?")
 		args = append(args, filter.ZeitlerNumber)
@@ -72,6 +89,21 @@ func (r theoryRepository) ListPitchChords(ctx context.Context, pitchID int64, fi
 		args = append(args, filter.Cardinality)
 	}
 
+	if filter.ForteNumber != "" {
+		clauses = append(clauses, "cq.forte_number = ?")
+		args = append(args, filter.ForteNumber)
+	}
+
+	if len(filter.PrimeForm) > 0 {
+		clauses = append(clauses, "cq.prime_form = ?")
+		args = append(args, primeForm(filter.PrimeForm))
+	}
+
+	if len(filter.IntervalVector) > 0 {
+		clauses = append(clauses, "cq.interval_vector = ?")
+		args = append(args, SliceInt(filter.IntervalVector))
+	}
+
 	queryCount := fmt.Sprintf(`
 		SELECT 
 			COUNT(DISTINCT c.id)
@@ -293,6 +325,21 @@ func (r theoryRepository) ListPitchScales(ctx context.Context, pitchID int64, fi
 		clauses = append(clauses, "s.cardinality = ?")
 	}
 
+	if filter.ForteNumber != "" {
+		args = append(args, filter.ForteNumber)
+		clauses = append(clauses, "s.forte_number = ?")
+	}
+
+	if len(filter.PrimeForm) > 0 {
+		args = append(args, primeForm(filter.PrimeForm))
+		clauses = append(clauses, "s.prime_form = ?")
+	}
+
+	if len(filter.IntervalVector) > 0 {
+		args = append(args, SliceInt(filter.IntervalVector))
+		clauses = append(clauses, "s.interval_vector = ?")
+	}
+
 	queryCount := fmt.Sprintf(`
 		SELECT 
 			COUNT(DISTINCT s.id)
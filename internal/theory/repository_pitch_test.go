@@ -3,6 +3,7 @@ package theory_test
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"strings"
 	"testing"
 
@@ -73,44 +74,130 @@ func TestTheoryRepository_ListPitches(t *testing.T) {
 func TestTheoryRepository_ListPitchChords(t *testing.T) {
 	type testCase struct {
 		Title                 string
+		GivenFilter           theory.ChordFilter
+		ExpectedCountQuery    string
+		ExpectedListQuery     string
+		ExpectedCountArgs     []driver.Value
+		ExpectedListArgs      []driver.Value
 		CountPitchChordsError error
 		ListPitchChordsError  error
 	}
 
+	rootPosition, firstInversion, everyInversion := 0, 1, -1
 	testCases := []testCase{
 		{
-			Title: "ReturnsChordsWhenSucceeded",
+			Title: "ReturnsChordsInRootPositionWhenSucceededWithoutFilter",
+			ExpectedCountQuery: `
+				SELECT 
+					COUNT(DISTINCT c.id)
+				FROM chord_pitches cp
+					JOIN chords c ON cp.chord_id = c.id
+					JOIN chord_qualities cq ON c.chord_quality_id = cq.id
+				WHERE cp.pitch_id = $1 AND c.inversion = $2
+				GROUP BY
+				    cp.pitch_id;`,
+			ExpectedListQuery: `
+				SELECT DISTINCT
+					c.id, 
+					c.name
+				FROM chord_pitches cp
+					JOIN chords c ON cp.chord_id = c.id
+					JOIN chord_qualities cq ON c.chord_quality_id = cq.id
+				WHERE
+				    cp.pitch_id = $1 AND c.inversion = $2
+				ORDER
+					BY c.id
+				OFFSET $3
+				LIMIT $4;`,
+			ExpectedCountArgs: []driver.Value{int64(1), int64(rootPosition)},
+			ExpectedListArgs:  []driver.Value{int64(1), int64(rootPosition), sqlmock.AnyArg(), sqlmock.AnyArg()},
+		},
+		{
+			Title:       "ReturnsChordsWhenSucceededWithInversionFilter",
+			GivenFilter: theory.ChordFilter{Inversion: &firstInversion},
+			ExpectedCountQuery: `
+				SELECT 
+					COUNT(DISTINCT c.id)
+				FROM chord_pitches cp
+					JOIN chords c ON cp.chord_id = c.id
+					JOIN chord_qualities cq ON c.chord_quality_id = cq.id
+				WHERE cp.pitch_id = $1 AND c.inversion = $2
+				GROUP BY
+				    cp.pitch_id;`,
+			ExpectedListQuery: `
+				SELECT DISTINCT
+					c.id, 
+					c.name
+				FROM chord_pitches cp
+					JOIN chords c ON cp.chord_id = c.id
+					JOIN chord_qualities cq ON c.chord_quality_id = cq.id
+				WHERE
+				    cp.pitch_id = $1 AND c.inversion = $2
+				ORDER
+					BY c.id
+				OFFSET $3
+				LIMIT $4;`,
+			ExpectedCountArgs: []driver.Value{int64(1), int64(firstInversion)},
+			ExpectedListArgs:  []driver.Value{int64(1), int64(firstInversion), sqlmock.AnyArg(), sqlmock.AnyArg()},
+		},
+		{
+			Title:       "ReturnsEveryInversionWhenSucceededWithNegativeInversionFilter",
+			GivenFilter: theory.ChordFilter{Inversion: &everyInversion},
+			ExpectedCountQuery: `
+				SELECT 
+					COUNT(DISTINCT c.id)
+				FROM chord_pitches cp
+					JOIN chords c ON cp.chord_id = c.id
+					JOIN chord_qualities cq ON c.chord_quality_id = cq.id
+				WHERE cp.pitch_id = $1
+				GROUP BY
+				    cp.pitch_id;`,
+			ExpectedListQuery: `
+				SELECT DISTINCT
+					c.id, 
+					c.name
+				FROM chord_pitches cp
+					JOIN chords c ON cp.chord_id = c.id
+					JOIN chord_qualities cq ON c.chord_quality_id = cq.id
+				WHERE
+				    cp.pitch_id = $1
+				ORDER
+					BY c.id
+				OFFSET $2
+				LIMIT $3;`,
+			ExpectedCountArgs: []driver.Value{int64(1)},
+			ExpectedListArgs:  []driver.Value{int64(1), sqlmock.AnyArg(), sqlmock.AnyArg()},
 		},
 		{
-			Title:                "ReturnsErrorWhenFailed",
+			Title: "ReturnsErrorWhenFailed",
+			ExpectedCountQuery: `
+				SELECT 
+					COUNT(DISTINCT c.id)
+				FROM chord_pitches cp
+					JOIN chords c ON cp.chord_id = c.id
+					JOIN chord_qualities cq ON c.chord_quality_id = cq.id
+				WHERE cp.pitch_id = $1 AND c.inversion = $2
+				GROUP BY
+				    cp.pitch_id;`,
+			ExpectedListQuery: `
+				SELECT DISTINCT
+					c.id, 
+					c.name
+				FROM chord_pitches cp
+					JOIN chords c ON cp.chord_id = c.id
+					JOIN chord_qualities cq ON c.chord_quality_id = cq.id
+				WHERE
+				    cp.pitch_id = $1 AND c.inversion = $2
+				ORDER
+					BY c.id
+				OFFSET $3
+				LIMIT $4;`,
+			ExpectedCountArgs:    []driver.Value{sqlmock.AnyArg(), sqlmock.AnyArg()},
+			ExpectedListArgs:     []driver.Value{sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()},
 			ListPitchChordsError: sql.ErrConnDone,
 		},
 	}
 
-	countPitchChordsQuery := `
-		SELECT 
-			COUNT(DISTINCT c.id)
-		FROM chord_pitches cp
-			JOIN chords c ON cp.chord_id = c.id
-			JOIN chord_qualities cq ON c.chord_quality_id = cq.id
-		WHERE cp.pitch_id = $1
-		GROUP BY
-		    cp.pitch_id;`
-
-	listPitchChordsQuery := `
-		SELECT DISTINCT
-			c.id, 
-			c.name
-		FROM chord_pitches cp
-			JOIN chords c ON cp.chord_id = c.id
-			JOIN chord_qualities cq ON c.chord_quality_id = cq.id
-		WHERE
-		    cp.pitch_id = $1
-		ORDER
-			BY c.id
-		OFFSET $2
-		LIMIT $3;`
-
 	listPitchChordsColumns := []string{
 		"id",
 		"name",
@@ -124,28 +211,28 @@ func TestTheoryRepository_ListPitchChords(t *testing.T) {
 			require.NoError(t, err)
 
 			if tc.CountPitchChordsError != nil {
-				sqlMock.ExpectQuery(countPitchChordsQuery).
-					WithArgs(sqlmock.AnyArg()).
-					WillReturnError(tc.ListPitchChordsError)
+				sqlMock.ExpectQuery(tc.ExpectedCountQuery).
+					WithArgs(tc.ExpectedCountArgs...).
+					WillReturnError(tc.CountPitchChordsError)
 			} else {
-				sqlMock.ExpectQuery(countPitchChordsQuery).
-					WithArgs(sqlmock.AnyArg()).
+				sqlMock.ExpectQuery(tc.ExpectedCountQuery).
+					WithArgs(tc.ExpectedCountArgs...).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 
 				if tc.ListPitchChordsError != nil {
-					sqlMock.ExpectQuery(listPitchChordsQuery).
-						WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+					sqlMock.ExpectQuery(tc.ExpectedListQuery).
+						WithArgs(tc.ExpectedListArgs...).
 						WillReturnError(tc.ListPitchChordsError)
 				} else {
-					sqlMock.ExpectQuery(listPitchChordsQuery).
-						WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+					sqlMock.ExpectQuery(tc.ExpectedListQuery).
+						WithArgs(tc.ExpectedListArgs...).
 						WillReturnRows(sqlmock.NewRows(listPitchChordsColumns).
 							AddRow(1, "name"))
 				}
 			}
 
 			repository := theory.NewRepository(logger, db)
-			chords, _, err := repository.ListPitchChords(context.Background(), 1, theory.ChordFilter{}, api.Pagination{})
+			chords, _, err := repository.ListPitchChords(context.Background(), 1, tc.GivenFilter, api.Pagination{})
 			if strings.HasPrefix(tc.Title, "ReturnsError") {
 				require.Error(t, err)
 				require.Empty(t, chords)
@@ -80,6 +80,21 @@ func (r theoryRepository) ListScales(ctx context.Context, filter ScaleFilter, pa
 		clauses = append(clauses, "s.cardinality = ?")
 	}
 
+	if filter.ForteNumber != "" {
+		args = append(args, filter.ForteNumber)
+		clauses = append(clauses, "s.forte_number = ?")
+	}
+
+	if len(filter.PrimeForm) > 0 {
+		args = append(args, primeForm(filter.PrimeForm))
+		clauses = append(clauses, "s.prime_form = ?")
+	}
+
+	if len(filter.IntervalVector) > 0 {
+		args = append(args, SliceInt(filter.IntervalVector))
+		clauses = append(clauses, "s.interval_vector = ?")
+	}
+
 	condition := "TRUE"
 	if len(clauses) > 0 {
 		condition = strings.Join(clauses, " AND ")
@@ -187,6 +202,16 @@ func (r theoryRepository) ListScaleChords(ctx context.Context, scaleID int64, fi
 		clauses = append(clauses, "c.root_id = ?")
 	}
 
+	if filter.BassID > 0 {
+		args = append(args, filter.BassID)
+		clauses = append(clauses, "c.bass_id = ?")
+	}
+
+	if filter.Inversion != nil && (*filter.Inversion) >= 0 {
+		args = append(args, *filter.Inversion)
+		clauses = append(clauses, "c.inversion = ?")
+	}
+
 	if filter.ZeitlerNumber > 0 {
 		args = append(args, filter.ZeitlerNumber)
 		clauses = append(clauses, "c.zeitler_number = ?")
@@ -202,6 +227,21 @@ func (r theoryRepository) ListScaleChords(ctx context.Context, scaleID int64, fi
 		clauses = append(clauses, "cq.cardinality = ?")
 	}
 
+	if filter.ForteNumber != "" {
+		args = append(args, filter.ForteNumber)
+		clauses = append(clauses, "cq.forte_number = ?")
+	}
+
+	if len(filter.PrimeForm) > 0 {
+		args = append(args, primeForm(filter.PrimeForm))
+		clauses = append(clauses, "cq.prime_form = ?")
+	}
+
+	if len(filter.IntervalVector) > 0 {
+		args = append(args, SliceInt(filter.IntervalVector))
+		clauses = append(clauses, "cq.interval_vector = ?")
+	}
+
 	queryCount := fmt.Sprintf(`
 		SELECT
 			COUNT(c.id)
@@ -270,7 +310,10 @@ func (r theoryRepository) GetScale(ctx context.Context, scaleID int64) (*Detaile
 			reflectional_symmetric,
 			reflectional_symmetry_axes,
 			balanced,
-			fifth_generator_root_degree
+			fifth_generator_root_degree,
+			forte_number,
+			prime_form,
+			interval_vector
 		FROM scales
 		WHERE
 			id = $1;`
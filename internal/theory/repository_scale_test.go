@@ -140,7 +140,10 @@ func TestTheoryRepository_GetScale(t *testing.T) {
 			reflectional_symmetric,
 			reflectional_symmetry_axes,
 			balanced,
-			fifth_generator_root_degree
+			fifth_generator_root_degree,
+			forte_number,
+			prime_form,
+			interval_vector
 		FROM scales
 		WHERE
 			id = $1;`
@@ -162,6 +165,9 @@ func TestTheoryRepository_GetScale(t *testing.T) {
 		"reflectional_symmetry_axes",
 		"balanced",
 		"fifth_generator_root_degree",
+		"forte_number",
+		"prime_form",
+		"interval_vector",
 	}
 
 	for _, tc := range testCases {
@@ -179,7 +185,7 @@ func TestTheoryRepository_GetScale(t *testing.T) {
 				sqlMock.ExpectQuery(getScaleQuery).
 					WithArgs(sqlmock.AnyArg()).
 					WillReturnRows(sqlmock.NewRows(getScaleColumns).
-						AddRow(1, "name", 2, 3, 4, 5, 6, []byte("[7,8]"), []byte("[9,10]"), true, 11, true, true, []byte("[12,13]"), true, 1))
+						AddRow(1, "name", 2, 3, 4, 5, 6, []byte("[7,8]"), []byte("[9,10]"), true, 11, true, true, []byte("[12,13]"), true, 1, "7-35", []byte("[0,1,3,5,6,8,10]"), []byte("[2,5,4,3,6,1]")))
 			}
 
 			repository := theory.NewRepository(logger, db)
@@ -6,9 +6,12 @@ import (
 
 // Service is music theory service
 type Service interface {
+	analysisService
 	chordService
+	identificationService
 	keyService
 	pitchService
+	progressionService
 	scaleService
 }
 
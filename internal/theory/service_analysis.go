@@ -0,0 +1,203 @@
+package theory
+
+import (
+	"bytes"
+	"context"
+	"math"
+
+	"github.com/edipermadi/music-db/pkg/midi"
+	"github.com/edipermadi/music-db/pkg/theory/analysis"
+	"github.com/edipermadi/music-db/pkg/theory/identify"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+)
+
+// percussionChannel is general MIDI percussion channel, its notes are not pitched
+const percussionChannel = 9
+
+// maxAnalyzedBeats is maximum count of beats analyzed, which is more than half an hour at 120 beats per minute
+const maxAnalyzedBeats = 4096
+
+type analysisService interface {
+	AnalyzeMidi(ctx context.Context, data []byte, filter AnalysisFilter) (*MidiAnalysis, error)
+	EstimateKeys(ctx context.Context, filter KeyEstimationFilter) ([]EstimatedKey, error)
+}
+
+func (s theoryService) AnalyzeMidi(ctx context.Context, data []byte, filter AnalysisFilter) (*MidiAnalysis, error) {
+	filter.Sanitize()
+
+	file, err := midi.Parse(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrInvalidMidiFile
+	}
+
+	// files spanning beyond analyzed beats are rejected, a single long delta would otherwise allocate every beat
+	notes := make([]midi.TimedNote, 0)
+	for _, v := range file.Notes() {
+		if v.Channel != percussionChannel && v.End > v.Start {
+			if v.End > maxAnalyzedBeats*file.Division {
+				return nil, ErrInvalidMidiFile
+			}
+
+			notes = append(notes, v)
+		}
+	}
+
+	// accumulate note durations of the whole file and of every beat
+	var histogram pitch.Distribution
+	beats := make([]pitch.Distribution, 0)
+	for _, v := range notes {
+		p := pitch.NoteFromMidi(int(v.Key)).Pitch
+		histogram.Add(p, float64(v.End-v.Start))
+
+		for beat := v.Start / file.Division; beat*file.Division < v.End; beat++ {
+			for len(beats) <= beat {
+				beats = append(beats, pitch.Distribution{})
+			}
+
+			start := max(v.Start, beat*file.Division)
+			end := min(v.End, (beat+1)*file.Division)
+			beats[beat].Add(p, float64(end-start))
+		}
+	}
+
+	// label each beat with the best explaining chord
+	beatChords := make([]*identify.ChordMatch, len(beats))
+	chordNames := make([]string, 0)
+	for i, v := range beats {
+		if match, ok := identify.Chord(v); ok {
+			beatChords[i] = &match
+			chordNames = append(chordNames, chordMatchName(match))
+		}
+	}
+
+	chordIDs := make(map[string]int64)
+	if len(chordNames) > 0 {
+		chords, err := s.repository.ListChordsByName(ctx, chordNames)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range chords {
+			chordIDs[v.Name] = v.ID
+		}
+	}
+
+	// resolve key identifiers
+	keyMatches := identify.Keys(histogram, filter.Limit)
+	keyNames := make([]string, 0)
+	for _, v := range keyMatches {
+		keyNames = append(keyNames, weightedKeyMatchName(v))
+	}
+
+	keyIDs := make(map[string]int64)
+	if len(keyNames) > 0 {
+		keys, err := s.repository.ListKeysByName(ctx, keyNames)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range keys {
+			keyIDs[v.Name] = v.ID
+		}
+	}
+
+	analyzedKeys := make([]AnalyzedKey, 0)
+	for _, v := range keyMatches {
+		name := weightedKeyMatchName(v)
+		analyzedKeys = append(analyzedKeys, AnalyzedKey{ID: keyIDs[name], Name: name, Coverage: v.Coverage})
+	}
+
+	analyzedBeats := make([]AnalyzedBeat, 0)
+	for i, v := range beatChords {
+		entry := AnalyzedBeat{Beat: i}
+		if v != nil {
+			name := chordMatchName(*v)
+			entry.Chord = &SimplifiedChord{ID: chordIDs[name], Name: name}
+		}
+
+		analyzedBeats = append(analyzedBeats, entry)
+	}
+
+	return &MidiAnalysis{
+		Format:    int(file.Format),
+		Tracks:    len(file.Tracks),
+		Division:  file.Division,
+		Notes:     len(notes),
+		Histogram: weightedPitches(histogram),
+		Keys:      analyzedKeys,
+		Beats:     analyzedBeats,
+	}, nil
+}
+
+func (s theoryService) EstimateKeys(ctx context.Context, filter KeyEstimationFilter) ([]EstimatedKey, error) {
+	filter.Sanitize()
+
+	profile := analysis.FromString(filter.Profile)
+	if profile == analysis.Invalid {
+		return nil, ErrInvalidProfile
+	}
+
+	if len(filter.Weights) != 12 {
+		return nil, ErrInvalidDistribution
+	}
+
+	var d pitch.Distribution
+	for i, v := range filter.Weights {
+		if v < 0 || math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, ErrInvalidDistribution
+		}
+
+		d[i] = v
+	}
+
+	estimates := analysis.Keys(d, profile)
+	if len(estimates) == 0 {
+		return nil, ErrInvalidDistribution
+	}
+
+	if len(estimates) > filter.Limit {
+		estimates = estimates[:filter.Limit]
+	}
+
+	// resolve key identifiers
+	keyNames := make([]string, 0)
+	for _, v := range estimates {
+		keyNames = append(keyNames, estimateName(v))
+	}
+
+	keys, err := s.repository.ListKeysByName(ctx, keyNames)
+	if err != nil {
+		return nil, err
+	}
+
+	keyIDs := make(map[string]int64)
+	for _, v := range keys {
+		keyIDs[v.Name] = v.ID
+	}
+
+	entries := make([]EstimatedKey, 0)
+	for _, v := range estimates {
+		name := estimateName(v)
+		entries = append(entries, EstimatedKey{ID: keyIDs[name], Name: name, Correlation: v.Correlation})
+	}
+
+	return entries, nil
+}
+
+func estimateName(estimate analysis.Estimate) string {
+	return keyMatchName(identify.KeyMatch{Scale: estimate.Scale, Tonic: estimate.Tonic})
+}
+
+func weightedKeyMatchName(match identify.WeightedKeyMatch) string {
+	return keyMatchName(identify.KeyMatch{Scale: match.Scale, Tonic: match.Tonic})
+}
+
+func weightedPitches(d pitch.Distribution) []WeightedPitch {
+	normalized := d.Normalized()
+	entries := make([]WeightedPitch, 0)
+	for _, v := range pitch.AllPitches() {
+		entries = append(entries, WeightedPitch{ID: int64(v), Name: v.String(), Weight: normalized.Weight(v)})
+	}
+
+	return entries
+}
@@ -0,0 +1,184 @@
+package theory_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/edipermadi/music-db/internal/theory"
+	"github.com/edipermadi/music-db/mock"
+	"github.com/edipermadi/music-db/pkg/midi"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/tuning"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTheoryService_AnalyzeMidi(t *testing.T) {
+	// c major triad held for two beats
+	file, err := midi.Sequence{
+		Notes:      []pitch.Note{pitch.NewNote(pitch.CNatural, 4), pitch.NewNote(pitch.ENatural, 4), pitch.NewNote(pitch.GNatural, 4)},
+		Tempo:      120,
+		NoteLength: 2,
+		Velocity:   100,
+		Tuning:     tuning.Default(),
+		Block:      true,
+	}.File(midi.Format1)
+	require.NoError(t, err)
+
+	encoded, err := file.Bytes()
+	require.NoError(t, err)
+
+	// a single note held for the longest delta of a standard midi file
+	var track midi.Track
+	track.Add(midi.NoteOnEvent(0, 0, 60, 100), midi.NoteOffEvent(0x0FFFFFFF, 0, 60))
+	oversized, err := midi.File{Format: midi.Format0, Division: midi.DefaultDivision, Tracks: []midi.Track{track}}.Bytes()
+	require.NoError(t, err)
+
+	type testCase struct {
+		serviceTestCase
+		GivenData []byte
+	}
+
+	testCases := []testCase{
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsAnalysisWhenSucceeded",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					ListKeysByName:   []interface{}{[]theory.SimplifiedKey{{ID: 1, Name: "CNaturalIonian"}}, nil},
+					ListChordsByName: []interface{}{[]theory.SimplifiedChord{{ID: 1, Name: "CNaturalMajor"}}, nil},
+				},
+			},
+			GivenData: encoded,
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenFileIsInvalid",
+			},
+			GivenData: []byte("RIFF"),
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenFileIsTooLong",
+			},
+			GivenData: oversized,
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenListChordsFailed",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					ListChordsByName: []interface{}{nil, errors.New("error")},
+				},
+			},
+			GivenData: encoded,
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenListKeysFailed",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					ListChordsByName: []interface{}{[]theory.SimplifiedChord{{ID: 1, Name: "CNaturalMajor"}}, nil},
+					ListKeysByName:   []interface{}{nil, errors.New("error")},
+				},
+			},
+			GivenData: encoded,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			service := tc.mockedService()
+			entry, err := service.AnalyzeMidi(context.Background(), tc.GivenData, theory.AnalysisFilter{Limit: 5})
+			if strings.HasPrefix(tc.Title, "ReturnsError") {
+				require.Error(t, err)
+				require.Empty(t, entry)
+				if strings.HasSuffix(tc.Title, "FileIsTooLong") {
+					require.ErrorIs(t, err, theory.ErrInvalidMidiFile)
+				}
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, 1, entry.Format)
+				require.Equal(t, 2, entry.Tracks)
+				require.Equal(t, 3, entry.Notes)
+				require.Len(t, entry.Histogram, 12)
+				require.InDelta(t, 1.0/3, entry.Histogram[0].Weight, 1e-9)
+				require.Len(t, entry.Keys, 5)
+				require.Len(t, entry.Beats, 2)
+				for _, v := range entry.Beats {
+					require.NotNil(t, v.Chord)
+					require.Equal(t, theory.SimplifiedChord{ID: 1, Name: "CNaturalMajor"}, *v.Chord)
+				}
+			}
+		})
+	}
+}
+
+func TestTheoryService_EstimateKeys(t *testing.T) {
+	// c major scale with emphasized tonic triad
+	cMajor := []float64{4, 0, 2, 0, 3, 2, 0, 4, 0, 2, 0, 1}
+
+	type testCase struct {
+		serviceTestCase
+		GivenFilter theory.KeyEstimationFilter
+	}
+
+	testCases := []testCase{
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsKeysWhenSucceeded",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					ListKeysByName: []interface{}{[]theory.SimplifiedKey{{ID: 1, Name: "CNaturalIonian"}}, nil},
+				},
+			},
+			GivenFilter: theory.KeyEstimationFilter{Weights: cMajor, Profile: "temperley", Limit: 3},
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenProfileIsInvalid",
+			},
+			GivenFilter: theory.KeyEstimationFilter{Weights: cMajor, Profile: "unknown"},
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenWeightsAreIncomplete",
+			},
+			GivenFilter: theory.KeyEstimationFilter{Weights: cMajor[:7]},
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenWeightIsNegative",
+			},
+			GivenFilter: theory.KeyEstimationFilter{Weights: []float64{4, 0, 2, 0, 3, 2, 0, 4, 0, 2, 0, -1}},
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenWeightsAreUniform",
+			},
+			GivenFilter: theory.KeyEstimationFilter{Weights: make([]float64, 12)},
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenListKeysFailed",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					ListKeysByName: []interface{}{nil, errors.New("error")},
+				},
+			},
+			GivenFilter: theory.KeyEstimationFilter{Weights: cMajor},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			service := tc.mockedService()
+			entries, err := service.EstimateKeys(context.Background(), tc.GivenFilter)
+			if strings.HasPrefix(tc.Title, "ReturnsError") {
+				require.Error(t, err)
+				require.Empty(t, entries)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, entries, 3)
+				require.Equal(t, theory.EstimatedKey{ID: 1, Name: "CNaturalIonian", Correlation: entries[0].Correlation}, entries[0])
+				require.Greater(t, entries[0].Correlation, entries[1].Correlation)
+			}
+		})
+	}
+}
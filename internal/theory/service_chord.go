@@ -2,8 +2,13 @@ package theory
 
 import (
 	"context"
+	"errors"
+	"strings"
 
 	"github.com/edipermadi/music-db/internal/platform/api"
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/fretboard"
+	"github.com/edipermadi/music-db/pkg/theory/spelling"
 )
 
 type chordService interface {
@@ -13,6 +18,10 @@ type chordService interface {
 	ListChordPitches(ctx context.Context, chordID int64) ([]SimplifiedPitch, error)
 	ListChordScales(ctx context.Context, chordID int64, filter ScaleFilter, pagination api.Pagination) ([]SimplifiedScale, *api.Pagination, error)
 	ListChords(ctx context.Context, filter ChordFilter, pagination api.Pagination) ([]SimplifiedChord, *api.Pagination, error)
+	LookupChord(ctx context.Context, symbol string) (*DetailedChord, error)
+	ListChordTransformations(ctx context.Context, chordID int64) ([]ChordTransformation, error)
+	FindChordPath(ctx context.Context, fromChordID, toChordID int64, transformations []chord.Transformation) (*ChordPath, error)
+	ListChordFingerings(ctx context.Context, chordID int64, filter FingeringFilter) ([]ChordFingering, error)
 }
 
 func (s theoryService) ListChords(ctx context.Context, filter ChordFilter, pagination api.Pagination) ([]SimplifiedChord, *api.Pagination, error) {
@@ -32,9 +41,182 @@ func (s theoryService) ListChordScales(ctx context.Context, chordID int64, filte
 }
 
 func (s theoryService) GetChord(ctx context.Context, chordID int64) (*DetailedChord, error) {
-	return s.repository.GetChord(ctx, chordID)
+	chord, err := s.repository.GetChord(ctx, chordID)
+	if err != nil {
+		return nil, err
+	}
+
+	chord.Spelling = spelledNames(chordSpelling(*chord))
+	chord.Symbol = chordSymbol(*chord)
+	return chord, nil
+}
+
+func (s theoryService) LookupChord(ctx context.Context, symbol string) (*DetailedChord, error) {
+	parsed, err := parseChordSymbol(symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	chords, err := s.repository.ListChordsByName(ctx, []string{parsed.Name()})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(chords) == 0 {
+		return nil, ErrChordNotFound
+	}
+
+	detailed, err := s.GetChord(ctx, chords[0].ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// keep root spelling written by the client, such as Db rather than C#
+	if root, found := spelling.SymbolRoot(symbol); found {
+		detailed.Spelling = spelledNames(rootedChordSpelling(*detailed, root))
+		detailed.Symbol = spelling.SymbolFromRoot(detailedChord(*detailed), root)
+	}
+
+	return detailed, nil
 }
 
 func (s theoryService) GetChordQuality(ctx context.Context, chordID int64) (*DetailedChordQuality, error) {
 	return s.repository.GetChordQuality(ctx, chordID)
 }
+
+func (s theoryService) ListChordTransformations(ctx context.Context, chordID int64) ([]ChordTransformation, error) {
+	detailed, err := s.repository.GetChord(ctx, chordID)
+	if err != nil {
+		return nil, err
+	}
+
+	triad := detailedChord(*detailed)
+	if !triad.IsTriad() {
+		return nil, ErrChordNotTriad
+	}
+
+	steps := make([]chord.Step, 0)
+	for _, v := range chord.AllTransformations() {
+		transformed, _ := triad.Transform(v)
+		steps = append(steps, chord.Step{Transformation: v, Chord: transformed})
+	}
+
+	return s.chordTransformations(ctx, steps)
+}
+
+func (s theoryService) FindChordPath(ctx context.Context, fromChordID, toChordID int64, transformations []chord.Transformation) (*ChordPath, error) {
+	from, err := s.repository.GetChord(ctx, fromChordID)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := s.repository.GetChord(ctx, toChordID)
+	if err != nil {
+		return nil, err
+	}
+
+	fromTriad, toTriad := detailedChord(*from), detailedChord(*to)
+	if !fromTriad.IsTriad() || !toTriad.IsTriad() {
+		return nil, ErrChordNotTriad
+	}
+
+	steps, found := chord.ShortestPath(fromTriad, toTriad, transformations...)
+	if !found {
+		return nil, ErrChordPathNotFound
+	}
+
+	resolved, err := s.chordTransformations(ctx, steps)
+	if err != nil {
+		return nil, err
+	}
+
+	var symbols strings.Builder
+	for _, v := range steps {
+		symbols.WriteString(v.Transformation.Symbol())
+	}
+
+	return &ChordPath{
+		From:            SimplifiedChord{ID: from.ID, Name: from.Name},
+		To:              SimplifiedChord{ID: to.ID, Name: to.Name},
+		Transformations: symbols.String(),
+		Steps:           resolved,
+	}, nil
+}
+
+// chordTransformations resolves chords resulting from transformation steps
+func (s theoryService) chordTransformations(ctx context.Context, steps []chord.Step) ([]ChordTransformation, error) {
+	names := make([]string, 0)
+	for _, v := range steps {
+		names = append(names, v.Chord.Name())
+	}
+
+	chords, err := s.repository.ListChordsByName(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	chordsByName := make(map[string]SimplifiedChord)
+	for _, v := range chords {
+		chordsByName[v.Name] = v
+	}
+
+	transformations := make([]ChordTransformation, 0)
+	for _, v := range steps {
+		resolved, found := chordsByName[v.Chord.Name()]
+		if !found {
+			return nil, ErrChordNotFound
+		}
+
+		transformations = append(transformations, ChordTransformation{
+			Transformation: v.Transformation.Symbol(),
+			Name:           v.Transformation.String(),
+			Chord:          resolved,
+		})
+	}
+
+	return transformations, nil
+}
+
+func (s theoryService) ListChordFingerings(ctx context.Context, chordID int64, filter FingeringFilter) ([]ChordFingering, error) {
+	filter.Sanitize()
+	t, err := filter.Resolve()
+	if err != nil {
+		return nil, err
+	}
+
+	detailed, err := s.repository.GetChord(ctx, chordID)
+	if err != nil {
+		return nil, err
+	}
+
+	fingerings := fretboard.Fingerings(detailedChord(*detailed), t, filter.MaxFret, filter.MaxSpan)
+	entries := make([]ChordFingering, 0)
+	for _, v := range fingerings[:min(filter.Limit, len(fingerings))] {
+		entries = append(entries, ChordFingering{
+			Tuning:     t.Identifier(),
+			Strings:    t.Strings(),
+			Frets:      v,
+			Chart:      v.String(),
+			Notes:      v.Notes(t),
+			Position:   v.Position(),
+			Difficulty: v.Difficulty(),
+		})
+	}
+
+	return entries, nil
+}
+
+// parseChordSymbol parses symbol of a stored chord, slash chords whose bass is not a chord pitch are rejected
+func parseChordSymbol(symbol string) (chord.Chord, error) {
+	parsed, err := chord.Parse(symbol)
+	switch {
+	case errors.Is(err, chord.ErrUnsupportedSymbol):
+		return chord.Chord{}, ErrChordNotFound
+	case errors.Is(err, chord.ErrNonChordBass):
+		return chord.Chord{}, ErrUnsupportedSlashChord
+	case err != nil:
+		return chord.Chord{}, ErrInvalidChordSymbol
+	}
+
+	return parsed, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/edipermadi/music-db/internal/platform/api"
 	"github.com/edipermadi/music-db/internal/theory"
 	"github.com/edipermadi/music-db/mock"
+	"github.com/edipermadi/music-db/pkg/theory/chord"
 	"github.com/stretchr/testify/require"
 )
 
@@ -167,6 +168,124 @@ func TestTheoryService_GetChord(t *testing.T) {
 	}
 }
 
+func TestTheoryService_GetChordSpelledFromBass(t *testing.T) {
+	tc := serviceTestCase{
+		RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+			GetChord: []interface{}{&theory.DetailedChord{
+				ID:        1,
+				Quality:   theory.SimplifiedChordQuality{ID: 1, Name: "Major"},
+				Root:      theory.SimplifiedPitch{ID: 1, Name: "CNatural"},
+				Bass:      theory.SimplifiedPitch{ID: 5, Name: "ENatural"},
+				Inversion: 1,
+				Name:      "CNaturalMajorOverENatural",
+			}, nil},
+		},
+	}
+
+	entry, err := tc.mockedService().GetChord(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"E", "G", "C"}, entry.Spelling)
+}
+
+func TestTheoryService_LookupChord(t *testing.T) {
+	type testCase struct {
+		serviceTestCase
+		Symbol           string
+		ExpectedSymbol   string
+		ExpectedSpelling []string
+		ExpectedError    error
+	}
+
+	testCases := []testCase{
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsChordWhenSucceeded",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					ListChordsByName: []interface{}{[]theory.SimplifiedChord{{ID: 2, Name: "ANaturalMinorSeventhOverGNatural"}}, nil},
+					GetChord: []interface{}{&theory.DetailedChord{
+						ID:      2,
+						Quality: theory.SimplifiedChordQuality{ID: 26, Name: "MinorSeventh"},
+						Root:    theory.SimplifiedPitch{ID: 10, Name: "ANatural"},
+						Bass:    theory.SimplifiedPitch{ID: 8, Name: "GNatural"},
+					}, nil},
+				},
+			},
+			Symbol:           "Am7/G",
+			ExpectedSymbol:   "Am7/G",
+			ExpectedSpelling: []string{"G", "A", "C", "E"},
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsChordWithSuppliedRootSpelling",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					ListChordsByName: []interface{}{[]theory.SimplifiedChord{{ID: 3, Name: "FSharpDominantSeventh"}}, nil},
+					GetChord: []interface{}{&theory.DetailedChord{
+						ID:      3,
+						Quality: theory.SimplifiedChordQuality{ID: 25, Name: "DominantSeventh"},
+						Root:    theory.SimplifiedPitch{ID: 7, Name: "FSharp"},
+						Bass:    theory.SimplifiedPitch{ID: 7, Name: "FSharp"},
+					}, nil},
+				},
+			},
+			Symbol:           "Gb7",
+			ExpectedSymbol:   "Gb7",
+			ExpectedSpelling: []string{"G♭", "B♭", "D♭", "F♭"},
+		},
+		{
+			serviceTestCase: serviceTestCase{Title: "ReturnsErrorWhenSymbolIsInvalid"},
+			Symbol:          "Hm7",
+			ExpectedError:   theory.ErrInvalidChordSymbol,
+		},
+		{
+			serviceTestCase: serviceTestCase{Title: "ReturnsErrorWhenSymbolIsUnsupported"},
+			Symbol:          "Cmaj7b9#9",
+			ExpectedError:   theory.ErrChordNotFound,
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenChordIsNotFound",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					ListChordsByName: []interface{}{[]theory.SimplifiedChord{}, nil},
+				},
+			},
+			Symbol:        "C",
+			ExpectedError: theory.ErrChordNotFound,
+		},
+		{
+			serviceTestCase: serviceTestCase{Title: "ReturnsErrorWhenSlashChordBassIsNotChordPitch"},
+			Symbol:          "C/D",
+			ExpectedError:   theory.ErrUnsupportedSlashChord,
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenListChordsByNameFailed",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					ListChordsByName: []interface{}{nil, errors.New("error")},
+				},
+			},
+			Symbol: "C",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			service := tc.mockedService()
+			entry, err := service.LookupChord(context.Background(), tc.Symbol)
+			if strings.HasPrefix(tc.Title, "ReturnsError") {
+				require.Error(t, err)
+				require.Empty(t, entry)
+				if tc.ExpectedError != nil {
+					require.ErrorIs(t, err, tc.ExpectedError)
+				}
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.ExpectedSymbol, entry.Symbol)
+				require.Equal(t, tc.ExpectedSpelling, entry.Spelling)
+			}
+		})
+	}
+}
+
 func TestTheoryService_GetChordQuality(t *testing.T) {
 	testCases := []serviceTestCase{
 		{
@@ -197,3 +316,251 @@ func TestTheoryService_GetChordQuality(t *testing.T) {
 		})
 	}
 }
+
+func TestTheoryService_GetChord_Spelling(t *testing.T) {
+	tc := serviceTestCase{
+		RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+			GetChord: []interface{}{&theory.DetailedChord{
+				ID:      1,
+				Name:    "CNaturalDiminishedSeventh",
+				Quality: theory.SimplifiedChordQuality{ID: 29, Name: "DiminishedSeventh"},
+				Root:    theory.SimplifiedPitch{ID: 1, Name: "CNatural"},
+			}, nil},
+		},
+	}
+
+	entry, err := tc.mockedService().GetChord(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"C", "E♭", "G♭", "B𝄫"}, entry.Spelling)
+}
+
+func TestTheoryService_ListChordTransformations(t *testing.T) {
+	type testCase struct {
+		serviceTestCase
+		ExpectedError error
+	}
+
+	cMajor := &theory.DetailedChord{
+		ID:      1,
+		Quality: theory.SimplifiedChordQuality{ID: 1, Name: "Major"},
+		Root:    theory.SimplifiedPitch{ID: 1, Name: "CNatural"},
+		Bass:    theory.SimplifiedPitch{ID: 1, Name: "CNatural"},
+		Name:    "CNaturalMajor",
+	}
+
+	transformed := []theory.SimplifiedChord{
+		{ID: 13, Name: "CNaturalMinor"},
+		{ID: 14, Name: "CSharpMinor"},
+		{ID: 21, Name: "GSharpMinor"},
+		{ID: 17, Name: "ENaturalMinor"},
+		{ID: 18, Name: "FNaturalMinor"},
+		{ID: 22, Name: "ANaturalMinor"},
+	}
+
+	testCases := []testCase{
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsTransformationsWhenSucceeded",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					GetChord:         []interface{}{cMajor, nil},
+					ListChordsByName: []interface{}{transformed, nil},
+				},
+			},
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenChordIsNotTriad",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					GetChord: []interface{}{&theory.DetailedChord{
+						ID:      2,
+						Quality: theory.SimplifiedChordQuality{ID: 25, Name: "DominantSeventh"},
+						Root:    theory.SimplifiedPitch{ID: 1, Name: "CNatural"},
+					}, nil},
+				},
+			},
+			ExpectedError: theory.ErrChordNotTriad,
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenChordIsNotSeeded",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					GetChord:         []interface{}{cMajor, nil},
+					ListChordsByName: []interface{}{[]theory.SimplifiedChord{}, nil},
+				},
+			},
+			ExpectedError: theory.ErrChordNotFound,
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenGetChordFailed",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					GetChord: []interface{}{nil, theory.ErrChordNotFound},
+				},
+			},
+			ExpectedError: theory.ErrChordNotFound,
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenListChordsByNameFailed",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					GetChord:         []interface{}{cMajor, nil},
+					ListChordsByName: []interface{}{nil, errors.New("error")},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			service := tc.mockedService()
+			entries, err := service.ListChordTransformations(context.Background(), 1)
+			if strings.HasPrefix(tc.Title, "ReturnsError") {
+				require.Error(t, err)
+				require.Empty(t, entries)
+				if tc.ExpectedError != nil {
+					require.ErrorIs(t, err, tc.ExpectedError)
+				}
+			} else {
+				require.NoError(t, err)
+				require.Len(t, entries, 6)
+				require.Equal(t, theory.ChordTransformation{
+					Transformation: "R",
+					Name:           "Relative",
+					Chord:          theory.SimplifiedChord{ID: 22, Name: "ANaturalMinor"},
+				}, entries[2])
+			}
+		})
+	}
+}
+
+func TestTheoryService_ListChordFingerings(t *testing.T) {
+	type testCase struct {
+		serviceTestCase
+		Filter        theory.FingeringFilter
+		ExpectedError error
+	}
+
+	cMajor := &theory.DetailedChord{
+		ID:      1,
+		Quality: theory.SimplifiedChordQuality{ID: 1, Name: "Major"},
+		Root:    theory.SimplifiedPitch{ID: 1, Name: "CNatural"},
+		Bass:    theory.SimplifiedPitch{ID: 1, Name: "CNatural"},
+		Name:    "CNaturalMajor",
+	}
+
+	testCases := []testCase{
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsFingeringsWhenSucceeded",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					GetChord: []interface{}{cMajor, nil},
+				},
+			},
+			Filter: theory.FingeringFilter{Limit: 3},
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenTuningIsInvalid",
+			},
+			Filter:        theory.FingeringFilter{Tuning: "banjo"},
+			ExpectedError: theory.ErrInvalidInstrument,
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenGetChordFailed",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					GetChord: []interface{}{nil, theory.ErrChordNotFound},
+				},
+			},
+			ExpectedError: theory.ErrChordNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			service := tc.mockedService()
+			entries, err := service.ListChordFingerings(context.Background(), 1, tc.Filter)
+			if strings.HasPrefix(tc.Title, "ReturnsError") {
+				require.Error(t, err)
+				require.Empty(t, entries)
+				if tc.ExpectedError != nil {
+					require.ErrorIs(t, err, tc.ExpectedError)
+				}
+			} else {
+				require.NoError(t, err)
+				require.Len(t, entries, 3)
+				require.Equal(t, "standard", entries[0].Tuning)
+				require.Equal(t, "x32010", entries[0].Chart)
+				require.Equal(t, []int{-1, 3, 2, 0, 1, 0}, entries[0].Frets)
+				require.Equal(t, 1, entries[0].Position)
+				require.Len(t, entries[0].Notes, 5)
+			}
+		})
+	}
+}
+
+func TestTheoryService_FindChordPath(t *testing.T) {
+	type testCase struct {
+		serviceTestCase
+		ExpectedError error
+	}
+
+	cMajor := &theory.DetailedChord{
+		ID:      1,
+		Quality: theory.SimplifiedChordQuality{ID: 1, Name: "Major"},
+		Root:    theory.SimplifiedPitch{ID: 1, Name: "CNatural"},
+		Bass:    theory.SimplifiedPitch{ID: 1, Name: "CNatural"},
+		Name:    "CNaturalMajor",
+	}
+
+	testCases := []testCase{
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsPathWhenSucceeded",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					GetChord:         []interface{}{cMajor, nil},
+					ListChordsByName: []interface{}{[]theory.SimplifiedChord{}, nil},
+				},
+			},
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenChordIsNotTriad",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					GetChord: []interface{}{&theory.DetailedChord{
+						ID:      2,
+						Quality: theory.SimplifiedChordQuality{ID: 4, Name: "Diminished"},
+						Root:    theory.SimplifiedPitch{ID: 12, Name: "BNatural"},
+					}, nil},
+				},
+			},
+			ExpectedError: theory.ErrChordNotTriad,
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenGetChordFailed",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					GetChord: []interface{}{nil, errors.New("error")},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			service := tc.mockedService()
+			entry, err := service.FindChordPath(context.Background(), 1, 1, chord.PrimaryTransformations())
+			if strings.HasPrefix(tc.Title, "ReturnsError") {
+				require.Error(t, err)
+				require.Empty(t, entry)
+				if tc.ExpectedError != nil {
+					require.ErrorIs(t, err, tc.ExpectedError)
+				}
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, theory.SimplifiedChord{ID: 1, Name: "CNaturalMajor"}, entry.From)
+				require.Empty(t, entry.Steps)
+			}
+		})
+	}
+}
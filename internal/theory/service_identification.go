@@ -0,0 +1,155 @@
+package theory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/edipermadi/music-db/pkg/theory/identify"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+)
+
+type identificationService interface {
+	Identify(ctx context.Context, filter IdentificationFilter) (*Identification, error)
+}
+
+func (s theoryService) Identify(ctx context.Context, filter IdentificationFilter) (*Identification, error) {
+	filter.Sanitize()
+
+	pitches := make(pitch.Slice, 0)
+	for _, v := range filter.PitchIDs {
+		p := pitch.FromInt(int(v))
+		if p == pitch.Invalid {
+			return nil, ErrInvalidPitch
+		}
+
+		pitches = append(pitches, p)
+	}
+
+	if len(pitches) == 0 {
+		return nil, ErrInvalidPitch
+	}
+
+	pitches = pitches.Unique()
+	result := identify.Pitches(pitches, *filter.MaxDistance)
+
+	exactKeys := limitKeyMatches(result.ExactKeys, filter.Limit)
+	supersetKeys := limitKeyMatches(result.SupersetKeys, filter.Limit)
+	nearMissKeys := limitKeyMatches(result.NearMissKeys, filter.Limit)
+
+	// resolve key identifiers
+	keyNames := make([]string, 0)
+	for _, matches := range [][]identify.KeyMatch{exactKeys, supersetKeys, nearMissKeys} {
+		for _, v := range matches {
+			keyNames = append(keyNames, keyMatchName(v))
+		}
+	}
+
+	keys, err := s.repository.ListKeysByName(ctx, keyNames)
+	if err != nil {
+		return nil, err
+	}
+
+	keyIDs := make(map[string]int64)
+	for _, v := range keys {
+		keyIDs[v.Name] = v.ID
+	}
+
+	exactChords := limitChordMatches(result.ExactChords, filter.Limit)
+	supersetChords := limitChordMatches(result.SupersetChords, filter.Limit)
+	nearMissChords := limitChordMatches(result.NearMissChords, filter.Limit)
+
+	// resolve chord identifiers
+	chordNames := make([]string, 0)
+	for _, matches := range [][]identify.ChordMatch{exactChords, supersetChords, nearMissChords} {
+		for _, v := range matches {
+			chordNames = append(chordNames, chordMatchName(v))
+		}
+	}
+
+	chords, err := s.repository.ListChordsByName(ctx, chordNames)
+	if err != nil {
+		return nil, err
+	}
+
+	chordIDs := make(map[string]int64)
+	for _, v := range chords {
+		chordIDs[v.Name] = v.ID
+	}
+
+	return &Identification{
+		ZeitlerNumber:  result.Signature,
+		RingNumber:     pitches.RingSignature(),
+		Pitches:        simplifiedPitches(pitches),
+		ExactKeys:      identifiedKeys(exactKeys, keyIDs),
+		SupersetKeys:   identifiedKeys(supersetKeys, keyIDs),
+		NearMissKeys:   identifiedKeys(nearMissKeys, keyIDs),
+		ExactChords:    identifiedChords(exactChords, chordIDs),
+		SupersetChords: identifiedChords(supersetChords, chordIDs),
+		NearMissChords: identifiedChords(nearMissChords, chordIDs),
+	}, nil
+}
+
+func keyMatchName(match identify.KeyMatch) string {
+	return fmt.Sprintf("%s%s", match.Tonic.String(), match.Scale.String())
+}
+
+func chordMatchName(match identify.ChordMatch) string {
+	return fmt.Sprintf("%s%s", match.Root.String(), match.Quality.String())
+}
+
+func limitKeyMatches(matches []identify.KeyMatch, limit int) []identify.KeyMatch {
+	if len(matches) > limit {
+		return matches[:limit]
+	}
+
+	return matches
+}
+
+func limitChordMatches(matches []identify.ChordMatch, limit int) []identify.ChordMatch {
+	if len(matches) > limit {
+		return matches[:limit]
+	}
+
+	return matches
+}
+
+func identifiedKeys(matches []identify.KeyMatch, keyIDs map[string]int64) []IdentifiedKey {
+	entries := make([]IdentifiedKey, 0)
+	for _, v := range matches {
+		name := keyMatchName(v)
+		entries = append(entries, IdentifiedKey{
+			ID:       keyIDs[name],
+			Name:     name,
+			Distance: v.Distance(),
+			Missing:  simplifiedPitches(v.Missing),
+			Extra:    simplifiedPitches(v.Extra),
+		})
+	}
+
+	return entries
+}
+
+func identifiedChords(matches []identify.ChordMatch, chordIDs map[string]int64) []IdentifiedChord {
+	entries := make([]IdentifiedChord, 0)
+	for _, v := range matches {
+		name := chordMatchName(v)
+		entries = append(entries, IdentifiedChord{
+			ID:       chordIDs[name],
+			Name:     name,
+			Distance: v.Distance(),
+			Missing:  simplifiedPitches(v.Missing),
+			Extra:    simplifiedPitches(v.Extra),
+		})
+	}
+
+	return entries
+}
+
+func simplifiedPitches(pitches pitch.Slice) []SimplifiedPitch {
+	entries := make([]SimplifiedPitch, 0)
+	for _, v := range pitches {
+		entries = append(entries, SimplifiedPitch{ID: int64(v), Name: v.String()})
+	}
+
+	return entries
+}
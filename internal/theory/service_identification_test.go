@@ -0,0 +1,98 @@
+package theory_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/edipermadi/music-db/internal/theory"
+	"github.com/edipermadi/music-db/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTheoryService_Identify(t *testing.T) {
+	type testCase struct {
+		serviceTestCase
+		GivenFilter      theory.IdentificationFilter
+		ExactMatchesOnly bool
+	}
+
+	exact := 0
+
+	testCases := []testCase{
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsIdentificationWhenSucceeded",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					ListKeysByName:   []interface{}{[]theory.SimplifiedKey{{ID: 1, Name: "CNaturalIonian"}}, nil},
+					ListChordsByName: []interface{}{[]theory.SimplifiedChord{{ID: 1, Name: "CNaturalMajor"}}, nil},
+				},
+			},
+			GivenFilter: theory.IdentificationFilter{PitchIDs: []int64{1, 5, 8}},
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsExactMatchesOnlyWhenMaxDistanceIsZero",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					ListKeysByName:   []interface{}{[]theory.SimplifiedKey{{ID: 1, Name: "CNaturalIonian"}}, nil},
+					ListChordsByName: []interface{}{[]theory.SimplifiedChord{{ID: 1, Name: "CNaturalMajor"}}, nil},
+				},
+			},
+			GivenFilter:      theory.IdentificationFilter{PitchIDs: []int64{1, 5, 8}, MaxDistance: &exact},
+			ExactMatchesOnly: true,
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenPitchesAreEmpty",
+			},
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenPitchIsInvalid",
+			},
+			GivenFilter: theory.IdentificationFilter{PitchIDs: []int64{1, 13}},
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenListKeysFailed",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					ListKeysByName: []interface{}{nil, errors.New("error")},
+				},
+			},
+			GivenFilter: theory.IdentificationFilter{PitchIDs: []int64{1, 5, 8}},
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenListChordsFailed",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					ListKeysByName:   []interface{}{[]theory.SimplifiedKey{{ID: 1, Name: "CNaturalIonian"}}, nil},
+					ListChordsByName: []interface{}{nil, errors.New("error")},
+				},
+			},
+			GivenFilter: theory.IdentificationFilter{PitchIDs: []int64{1, 5, 8}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			service := tc.mockedService()
+			entry, err := service.Identify(context.Background(), tc.GivenFilter)
+			if strings.HasPrefix(tc.Title, "ReturnsError") {
+				require.Error(t, err)
+				require.Empty(t, entry)
+			} else {
+				require.NoError(t, err)
+				require.NotEmpty(t, entry)
+				require.Equal(t, 2192, entry.ZeitlerNumber)
+				require.NotEmpty(t, entry.ExactChords)
+				if tc.ExactMatchesOnly {
+					require.Empty(t, entry.NearMissKeys)
+					require.Empty(t, entry.NearMissChords)
+				} else {
+					require.NotEmpty(t, entry.NearMissChords)
+				}
+			}
+		})
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 
 	"github.com/edipermadi/music-db/internal/platform/api"
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/scale"
 )
 
 type keyService interface {
@@ -12,6 +15,7 @@ type keyService interface {
 	ListKeyModes(ctx context.Context, keyID int64, filter KeyFilter) ([]SimplifiedKey, error)
 	ListKeyChords(ctx context.Context, keyID int64, filter ChordFilter, pagination api.Pagination) ([]SimplifiedChord, *api.Pagination, error)
 	ListKeyPitches(ctx context.Context, keyID int64) ([]SimplifiedPitch, error)
+	ListKeyHarmony(ctx context.Context, keyID int64) ([]KeyHarmony, error)
 }
 
 func (s theoryService) ListKeys(ctx context.Context, filter KeyFilter, pagination api.Pagination) ([]SimplifiedKey, *api.Pagination, error) {
@@ -31,5 +35,68 @@ func (s theoryService) ListKeyPitches(ctx context.Context, keyID int64) ([]Simpl
 }
 
 func (s theoryService) GetKey(ctx context.Context, keyID int64) (*DetailedKey, error) {
-	return s.repository.GetKey(ctx, keyID)
+	key, err := s.repository.GetKey(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	key.Spelling = spelledNames(keySpelling(*key))
+	return key, nil
+}
+
+func (s theoryService) ListKeyHarmony(ctx context.Context, keyID int64) ([]KeyHarmony, error) {
+	key, err := s.repository.GetKey(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	harmonies := scale.FromString(key.Scale.Name).Harmonize(pitch.FromInt(int(key.Tonic.ID)))
+
+	// resolve chords of all degrees at once
+	names := make([]string, 0)
+	for _, v := range harmonies {
+		for _, q := range []chord.Quality{v.Triad, v.Seventh, v.Ninth} {
+			if q != chord.Invalid {
+				names = append(names, chord.New(q, v.Root).Name())
+			}
+		}
+	}
+
+	chords, err := s.repository.ListChordsByName(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	chordsByName := make(map[string]SimplifiedChord)
+	for _, v := range chords {
+		chordsByName[v.Name] = v
+	}
+
+	spelled := keySpelling(*key)
+	harmonyChord := func(q chord.Quality, root pitch.Type, degree int) *HarmonyChord {
+		c := chord.New(q, root)
+		resolved, found := chordsByName[c.Name()]
+		if q == chord.Invalid || !found {
+			return nil
+		}
+
+		return &HarmonyChord{
+			RomanNumeral: q.RomanNumeral(degree),
+			Symbol:       progressionSymbol(c, "", spelled),
+			Chord:        resolved,
+		}
+	}
+
+	entries := make([]KeyHarmony, 0)
+	for _, v := range harmonies {
+		entries = append(entries, KeyHarmony{
+			Degree:  v.Degree,
+			Root:    SimplifiedPitch{ID: int64(v.Root), Name: v.Root.String()},
+			Triad:   harmonyChord(v.Triad, v.Root, v.Degree),
+			Seventh: harmonyChord(v.Seventh, v.Root, v.Degree),
+			Ninth:   harmonyChord(v.Ninth, v.Root, v.Degree),
+		})
+	}
+
+	return entries, nil
 }
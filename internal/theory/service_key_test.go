@@ -166,3 +166,72 @@ func TestTheoryService_GetKey(t *testing.T) {
 		})
 	}
 }
+
+func TestTheoryService_GetKey_Spelling(t *testing.T) {
+	tc := serviceTestCase{
+		RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+			GetKey: []interface{}{&theory.DetailedKey{
+				ID:    61,
+				Name:  "FNaturalIonian",
+				Scale: theory.SimplifiedScale{ID: 1, Name: "Ionian"},
+				Tonic: theory.SimplifiedPitch{ID: 6, Name: "FNatural"},
+			}, nil},
+		},
+	}
+
+	entry, err := tc.mockedService().GetKey(context.Background(), 61)
+	require.NoError(t, err)
+	require.Equal(t, []string{"F", "G", "A", "B♭", "C", "D", "E"}, entry.Spelling)
+}
+
+func TestTheoryService_ListKeyHarmony(t *testing.T) {
+	key := &theory.DetailedKey{
+		ID:    1,
+		Name:  "CNaturalIonian",
+		Scale: theory.SimplifiedScale{ID: 1, Name: "Ionian"},
+		Tonic: theory.SimplifiedPitch{ID: 1, Name: "CNatural"},
+	}
+
+	testCases := []serviceTestCase{
+		{
+			Title: "ReturnsHarmonyWhenSucceeded",
+			RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+				GetKey:           []interface{}{key, nil},
+				ListChordsByName: []interface{}{[]theory.SimplifiedChord{{ID: 1, Name: "CNaturalMajor"}}, nil},
+			},
+		},
+		{
+			Title: "ReturnsErrorWhenGetKeyFailed",
+			RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+				GetKey: []interface{}{nil, errors.New("error")},
+			},
+		},
+		{
+			Title: "ReturnsErrorWhenListChordsByNameFailed",
+			RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+				GetKey:           []interface{}{key, nil},
+				ListChordsByName: []interface{}{nil, errors.New("error")},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			service := tc.mockedService()
+			entries, err := service.ListKeyHarmony(context.Background(), 1)
+			if strings.HasPrefix(tc.Title, "ReturnsError") {
+				require.Error(t, err)
+				require.Empty(t, entries)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, entries, 7)
+				require.NotNil(t, entries[0].Triad)
+				require.Equal(t, "I", entries[0].Triad.RomanNumeral)
+				require.Equal(t, "C", entries[0].Triad.Symbol)
+				require.Equal(t, int64(1), entries[0].Triad.Chord.ID)
+				require.Nil(t, entries[0].Seventh)
+				require.Nil(t, entries[1].Triad)
+			}
+		})
+	}
+}
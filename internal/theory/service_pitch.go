@@ -4,18 +4,33 @@ import (
 	"context"
 
 	"github.com/edipermadi/music-db/internal/platform/api"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
 )
 
 type pitchService interface {
-	GetPitch(ctx context.Context, pitchID int64) (*DetailedPitch, error)
+	GetPitch(ctx context.Context, pitchID int64, filter TuningFilter) (*DetailedPitch, error)
 	ListPitchChords(ctx context.Context, pitchID int64, filter ChordFilter, pagination api.Pagination) ([]SimplifiedChord, *api.Pagination, error)
 	ListPitchKeys(ctx context.Context, pitchID int64, filter KeyFilter, pagination api.Pagination) ([]SimplifiedKey, *api.Pagination, error)
 	ListPitchScales(ctx context.Context, pitchID int64, filter ScaleFilter, pagination api.Pagination) ([]SimplifiedScale, *api.Pagination, error)
 	ListPitches(ctx context.Context) ([]SimplifiedPitch, error)
 }
 
-func (s theoryService) GetPitch(ctx context.Context, pitchID int64) (*DetailedPitch, error) {
-	return s.repository.GetPitch(ctx, pitchID)
+func (s theoryService) GetPitch(ctx context.Context, pitchID int64, filter TuningFilter) (*DetailedPitch, error) {
+	t, err := filter.Resolve()
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := s.repository.GetPitch(ctx, pitchID)
+	if err != nil {
+		return nil, err
+	}
+
+	p := pitch.FromInt(int(entry.ID))
+	entry.Frequency = t.PitchFrequency(p)
+	entry.Tuning = t.System.Identifier()
+	entry.CentsDeviation = t.Deviation(p)
+	return entry, nil
 }
 
 func (s theoryService) ListPitchChords(ctx context.Context, pitchID int64, filter ChordFilter, pagination api.Pagination) ([]SimplifiedChord, *api.Pagination, error) {
@@ -62,7 +62,7 @@ func TestTheoryService_GetPitch(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.Title, func(t *testing.T) {
 			service := tc.mockedService()
-			entry, err := service.GetPitch(context.Background(), 1)
+			entry, err := service.GetPitch(context.Background(), 1, theory.TuningFilter{})
 			if strings.HasPrefix(tc.Title, "ReturnsError") {
 				require.Error(t, err)
 				require.Empty(t, entry)
@@ -74,6 +74,52 @@ func TestTheoryService_GetPitch(t *testing.T) {
 	}
 }
 
+func TestTheoryService_GetPitch_Tuning(t *testing.T) {
+	type testCase struct {
+		Title             string
+		PitchID           int64
+		Filter            theory.TuningFilter
+		ExpectedError     error
+		ExpectedFrequency float64
+		ExpectedDeviation float64
+	}
+
+	testCases := []testCase{
+		{Title: "DefaultsToEqualTemperament", ExpectedFrequency: 440},
+		{Title: "UsesReference", Filter: theory.TuningFilter{Reference: 415}, ExpectedFrequency: 415},
+		{Title: "UsesTuningSystem", PitchID: 5, Filter: theory.TuningFilter{System: "just_intonation"}, ExpectedFrequency: 330, ExpectedDeviation: 1.955},
+		{Title: "UsesTuningTonic", PitchID: 3, Filter: theory.TuningFilter{System: "just_intonation", TonicID: 10}, ExpectedFrequency: 293.333, ExpectedDeviation: -1.955},
+		{Title: "KeepsReferenceOnA", Filter: theory.TuningFilter{System: "werckmeister_iii", Reference: 415}, ExpectedFrequency: 415},
+		{Title: "ReturnsErrorWhenSystemIsUnknown", Filter: theory.TuningFilter{System: "unknown"}, ExpectedError: theory.ErrInvalidTuning},
+		{Title: "ReturnsErrorWhenReferenceIsNegative", Filter: theory.TuningFilter{Reference: -1}, ExpectedError: theory.ErrInvalidTuning},
+		{Title: "ReturnsErrorWhenTonicIsInvalid", Filter: theory.TuningFilter{TonicID: 13}, ExpectedError: theory.ErrInvalidTuning},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			if tc.PitchID == 0 {
+				tc.PitchID = 10
+			}
+
+			service := serviceTestCase{
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					GetPitch: []interface{}{&theory.DetailedPitch{ID: tc.PitchID}, nil},
+				},
+			}.mockedService()
+
+			entry, err := service.GetPitch(context.Background(), tc.PitchID, tc.Filter)
+			if tc.ExpectedError != nil {
+				require.ErrorIs(t, err, tc.ExpectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.InDelta(t, tc.ExpectedFrequency, entry.Frequency, 0.01)
+			require.InDelta(t, tc.ExpectedDeviation, entry.CentsDeviation, 0.001)
+		})
+	}
+}
+
 func TestTheoryService_ListPitchChords(t *testing.T) {
 	testCases := []serviceTestCase{
 		{
@@ -0,0 +1,246 @@
+package theory
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+
+	"github.com/edipermadi/music-db/internal/platform/api"
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/progression"
+	"github.com/edipermadi/music-db/pkg/theory/scale"
+	"github.com/edipermadi/music-db/pkg/theory/voicing"
+)
+
+type progressionService interface {
+	AnalyzeProgression(ctx context.Context, request ProgressionAnalysisRequest) (*ProgressionAnalysis, error)
+	GenerateProgression(ctx context.Context, keyID int64, filter ProgressionFilter) (*GeneratedProgression, error)
+	LeadVoices(ctx context.Context, filter VoiceLeadingFilter) (*VoiceLeading, error)
+}
+
+// maxVoicedChords is maximum count of chords voiced at once
+const maxVoicedChords = 64
+
+func (s theoryService) AnalyzeProgression(ctx context.Context, request ProgressionAnalysisRequest) (*ProgressionAnalysis, error) {
+	switch {
+	case len(request.Chords) == 0:
+		return nil, ErrEmptyProgression
+	case len(request.Chords) > maxVoicedChords:
+		return nil, ErrProgressionTooLong
+	}
+
+	key, err := s.repository.GetKey(ctx, request.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	chords, resolved, err := s.progressionChords(ctx, request.Chords)
+	if err != nil {
+		return nil, err
+	}
+
+	numerals := progression.Analyze(scale.FromString(key.Scale.Name), pitch.FromInt(int(key.Tonic.ID)), chords)
+	names := keySpelling(*key)
+	entries := make([]AnalyzedChord, 0)
+	for i, v := range numerals {
+		entries = append(entries, AnalyzedChord{
+			Chord:        resolved[i],
+			Symbol:       progressionSymbol(chords[i], request.Chords[i].Symbol, names),
+			RomanNumeral: v.Numeral,
+			Degree:       v.Degree.String(),
+			Function:     v.Function.String(),
+			Diatonic:     v.Diatonic,
+		})
+	}
+
+	return &ProgressionAnalysis{
+		Key:    SimplifiedKey{ID: int64(key.ID), Name: key.Name},
+		Chords: entries,
+	}, nil
+}
+
+func (s theoryService) GenerateProgression(ctx context.Context, keyID int64, filter ProgressionFilter) (*GeneratedProgression, error) {
+	filter.Sanitize()
+	style, err := filter.Resolve()
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := s.repository.GetKey(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	// collect root position chords linked to the key
+	rootPosition := int(chord.RootPosition)
+	available := make([]chord.Chord, 0)
+	chordIDs := make(map[chord.Chord]int64)
+	for pagination := (api.Pagination{Page: 1, PerPage: 100}); pagination.Page > 0; {
+		entries, paginationOut, err := s.repository.ListKeyChords(ctx, keyID, ChordFilter{Inversion: &rootPosition}, pagination)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range entries {
+			if c, ok := chord.FromName(v.Name); ok {
+				available = append(available, c)
+				chordIDs[c] = v.ID
+			}
+		}
+
+		if paginationOut == nil {
+			break
+		}
+		pagination.Page = paginationOut.NextPage
+	}
+
+	keyScale, tonic := scale.FromString(key.Scale.Name), pitch.FromInt(int(key.Tonic.ID))
+	chords, found := progression.Generate(keyScale, tonic, style, filter.Length, available, rand.New(rand.NewSource(*filter.Seed)))
+	if !found {
+		return nil, ErrProgressionNotFound
+	}
+
+	names := keySpelling(*key)
+	entries := make([]AnalyzedChord, 0)
+	for i, v := range progression.Analyze(keyScale, tonic, chords) {
+		entries = append(entries, AnalyzedChord{
+			Chord:        SimplifiedChord{ID: chordIDs[chords[i]], Name: chords[i].Name()},
+			Symbol:       progressionSymbol(chords[i], "", names),
+			RomanNumeral: v.Numeral,
+			Degree:       v.Degree.String(),
+			Function:     v.Function.String(),
+			Diatonic:     v.Diatonic,
+		})
+	}
+
+	return &GeneratedProgression{
+		Key:    SimplifiedKey{ID: int64(key.ID), Name: key.Name},
+		Tonic:  key.Tonic,
+		Style:  style.Identifier(),
+		Seed:   *filter.Seed,
+		Chords: entries,
+	}, nil
+}
+
+func (s theoryService) LeadVoices(ctx context.Context, filter VoiceLeadingFilter) (*VoiceLeading, error) {
+	filter.Sanitize()
+	switch {
+	case len(filter.Chords) == 0:
+		return nil, ErrEmptyProgression
+	case len(filter.Chords) > maxVoicedChords:
+		return nil, ErrProgressionTooLong
+	}
+
+	r, err := filter.Resolve()
+	if err != nil {
+		return nil, err
+	}
+
+	given := make([]ProgressionChord, 0)
+	for _, v := range filter.Chords {
+		given = append(given, ProgressionChord{Symbol: v})
+	}
+
+	chords, resolved, err := s.progressionChords(ctx, given)
+	if err != nil {
+		return nil, err
+	}
+
+	voicings, err := voicing.Lead(chords, filter.Voices, r)
+	switch {
+	case errors.Is(err, voicing.ErrTooManyVoicings):
+		return nil, ErrInvalidVoiceRange
+	case err != nil:
+		return nil, ErrVoiceLeadingNotFound
+	}
+
+	leading := VoiceLeading{Voices: filter.Voices, Chords: make([]VoicedChord, 0)}
+	for i, v := range voicings {
+		movement := 0
+		if i > 0 {
+			movement = v.Movement(voicings[i-1])
+		}
+
+		leading.Movement += movement
+		leading.Chords = append(leading.Chords, VoicedChord{
+			Chord:    resolved[i],
+			Symbol:   progressionSymbol(chords[i], filter.Chords[i], nil),
+			Notes:    v,
+			Movement: movement,
+		})
+	}
+
+	return &leading, nil
+}
+
+// progressionChords resolves progression chords given by identifier or symbol
+func (s theoryService) progressionChords(ctx context.Context, given []ProgressionChord) ([]chord.Chord, []SimplifiedChord, error) {
+	// resolve chords given by identifier at once
+	ids := make([]int64, 0)
+	for _, v := range given {
+		if v.ChordID > 0 {
+			ids = append(ids, v.ChordID)
+		}
+	}
+
+	chordsByID := make(map[int64]DetailedChord)
+	if len(ids) > 0 {
+		detailed, err := s.repository.ListChordsByID(ctx, ids)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, v := range detailed {
+			chordsByID[v.ID] = v
+		}
+	}
+
+	chords := make([]chord.Chord, 0)
+	resolved := make([]SimplifiedChord, 0)
+	names := make([]string, 0)
+	for _, v := range given {
+		if v.ChordID > 0 {
+			entry, found := chordsByID[v.ChordID]
+			if !found {
+				return nil, nil, ErrChordNotFound
+			}
+
+			chords = append(chords, detailedChord(entry))
+			resolved = append(resolved, SimplifiedChord{ID: entry.ID, Name: entry.Name})
+			continue
+		}
+
+		parsed, err := parseChordSymbol(v.Symbol)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		chords = append(chords, parsed)
+		resolved = append(resolved, SimplifiedChord{Name: parsed.Name()})
+		names = append(names, parsed.Name())
+	}
+
+	// resolve identifiers of chords given by symbol
+	found, err := s.repository.ListChordsByName(ctx, names)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	chordIDs := make(map[string]int64)
+	for _, v := range found {
+		chordIDs[v.Name] = v.ID
+	}
+
+	for i, v := range resolved {
+		if v.ID == 0 {
+			id, found := chordIDs[v.Name]
+			if !found {
+				return nil, nil, ErrChordNotFound
+			}
+			resolved[i].ID = id
+		}
+	}
+
+	return chords, resolved, nil
+}
@@ -0,0 +1,420 @@
+package theory_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/edipermadi/music-db/internal/platform/api"
+	"github.com/edipermadi/music-db/internal/theory"
+	"github.com/edipermadi/music-db/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTheoryService_AnalyzeProgression(t *testing.T) {
+	type testCase struct {
+		serviceTestCase
+		Chords        []theory.ProgressionChord
+		ExpectedError error
+	}
+
+	key := &theory.DetailedKey{
+		ID:    1,
+		Name:  "CNaturalIonian",
+		Scale: theory.SimplifiedScale{ID: 1, Name: "Ionian"},
+		Tonic: theory.SimplifiedPitch{ID: 1, Name: "CNatural"},
+	}
+	dominant := theory.DetailedChord{
+		ID:      2,
+		Quality: theory.SimplifiedChordQuality{ID: 25, Name: "DominantSeventh"},
+		Root:    theory.SimplifiedPitch{ID: 8, Name: "GNatural"},
+		Bass:    theory.SimplifiedPitch{ID: 8, Name: "GNatural"},
+		Name:    "GNaturalDominantSeventh",
+	}
+	chords := []theory.ProgressionChord{{Symbol: "D7"}, {ChordID: 2}, {Symbol: "C"}}
+
+	testCases := []testCase{
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsAnalysisWhenSucceeded",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					GetKey:         []interface{}{key, nil},
+					ListChordsByID: []interface{}{[]theory.DetailedChord{dominant}, nil},
+					ListChordsByName: []interface{}{[]theory.SimplifiedChord{
+						{ID: 3, Name: "DNaturalDominantSeventh"},
+						{ID: 1, Name: "CNaturalMajor"},
+					}, nil},
+				},
+			},
+			Chords: chords,
+		},
+		{
+			serviceTestCase: serviceTestCase{Title: "ReturnsErrorWhenProgressionIsEmpty"},
+			ExpectedError:   theory.ErrEmptyProgression,
+		},
+		{
+			serviceTestCase: serviceTestCase{Title: "ReturnsErrorWhenProgressionIsTooLong"},
+			Chords:          make([]theory.ProgressionChord, 65),
+			ExpectedError:   theory.ErrProgressionTooLong,
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenGetKeyFailed",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					GetKey: []interface{}{nil, theory.ErrKeyNotFound},
+				},
+			},
+			Chords:        chords,
+			ExpectedError: theory.ErrKeyNotFound,
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenListChordsByIDFailed",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					GetKey:         []interface{}{key, nil},
+					ListChordsByID: []interface{}{nil, errors.New("error")},
+				},
+			},
+			Chords: chords,
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenChordIDIsMissing",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					GetKey:         []interface{}{key, nil},
+					ListChordsByID: []interface{}{[]theory.DetailedChord{}, nil},
+				},
+			},
+			Chords:        chords,
+			ExpectedError: theory.ErrChordNotFound,
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenSymbolIsInvalid",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					GetKey: []interface{}{key, nil},
+				},
+			},
+			Chords:        []theory.ProgressionChord{{Symbol: "H7"}},
+			ExpectedError: theory.ErrInvalidChordSymbol,
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenSlashChordIsUnsupported",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					GetKey: []interface{}{key, nil},
+				},
+			},
+			Chords:        []theory.ProgressionChord{{Symbol: "C/D"}},
+			ExpectedError: theory.ErrUnsupportedSlashChord,
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenChordIsMissing",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					GetKey:           []interface{}{key, nil},
+					ListChordsByID:   []interface{}{[]theory.DetailedChord{dominant}, nil},
+					ListChordsByName: []interface{}{[]theory.SimplifiedChord{}, nil},
+				},
+			},
+			Chords:        chords,
+			ExpectedError: theory.ErrChordNotFound,
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenListChordsByNameFailed",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					GetKey:           []interface{}{key, nil},
+					ListChordsByID:   []interface{}{[]theory.DetailedChord{dominant}, nil},
+					ListChordsByName: []interface{}{nil, errors.New("error")},
+				},
+			},
+			Chords: chords,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			service := tc.mockedService()
+			analysis, err := service.AnalyzeProgression(context.Background(), theory.ProgressionAnalysisRequest{KeyID: 1, Chords: tc.Chords})
+			if strings.HasPrefix(tc.Title, "ReturnsError") {
+				require.Error(t, err)
+				if tc.ExpectedError != nil {
+					require.ErrorIs(t, err, tc.ExpectedError)
+				}
+				require.Nil(t, analysis)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, theory.SimplifiedKey{ID: 1, Name: "CNaturalIonian"}, analysis.Key)
+			require.Equal(t, []theory.AnalyzedChord{
+				{
+					Chord:        theory.SimplifiedChord{ID: 3, Name: "DNaturalDominantSeventh"},
+					Symbol:       "D7",
+					RomanNumeral: "V7/V",
+					Degree:       "Dominant",
+					Function:     "SecondaryDominant",
+				},
+				{
+					Chord:        theory.SimplifiedChord{ID: 2, Name: "GNaturalDominantSeventh"},
+					Symbol:       "G7",
+					RomanNumeral: "V7",
+					Degree:       "Dominant",
+					Function:     "Diatonic",
+					Diatonic:     true,
+				},
+				{
+					Chord:        theory.SimplifiedChord{ID: 1, Name: "CNaturalMajor"},
+					Symbol:       "C",
+					RomanNumeral: "I",
+					Degree:       "Tonic",
+					Function:     "Diatonic",
+					Diatonic:     true,
+				},
+			}, analysis.Chords)
+		})
+	}
+}
+
+func TestTheoryService_AnalyzeProgression_Spelling(t *testing.T) {
+	service := serviceTestCase{
+		RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+			GetKey: []interface{}{&theory.DetailedKey{
+				ID:    2,
+				Name:  "BNaturalIonian",
+				Scale: theory.SimplifiedScale{ID: 1, Name: "Ionian"},
+				Tonic: theory.SimplifiedPitch{ID: 12, Name: "BNatural"},
+			}, nil},
+			ListChordsByID: []interface{}{[]theory.DetailedChord{{
+				ID:      4,
+				Quality: theory.SimplifiedChordQuality{ID: 2, Name: "Minor"},
+				Root:    theory.SimplifiedPitch{ID: 4, Name: "DSharp"},
+				Bass:    theory.SimplifiedPitch{ID: 4, Name: "DSharp"},
+				Name:    "DSharpMinor",
+			}}, nil},
+			ListChordsByName: []interface{}{[]theory.SimplifiedChord{{ID: 5, Name: "FSharpDominantSeventh"}}, nil},
+		},
+	}.mockedService()
+
+	// supplied symbols keep their root spelling, chords given by identifier are spelled as in the key
+	analysis, err := service.AnalyzeProgression(context.Background(), theory.ProgressionAnalysisRequest{
+		KeyID:  2,
+		Chords: []theory.ProgressionChord{{Symbol: "Gb7"}, {ChordID: 4}},
+	})
+	require.NoError(t, err)
+	require.Len(t, analysis.Chords, 2)
+	require.Equal(t, "Gb7", analysis.Chords[0].Symbol)
+	require.Equal(t, "D#m", analysis.Chords[1].Symbol)
+}
+
+func TestTheoryService_GenerateProgression(t *testing.T) {
+	type testCase struct {
+		serviceTestCase
+		Filter        theory.ProgressionFilter
+		ExpectedError error
+	}
+
+	key := &theory.DetailedKey{
+		ID:    1,
+		Name:  "CNaturalIonian",
+		Scale: theory.SimplifiedScale{ID: 1, Name: "Ionian"},
+		Tonic: theory.SimplifiedPitch{ID: 1, Name: "CNatural"},
+	}
+	chords := []theory.SimplifiedChord{
+		{ID: 1, Name: "CNaturalMajor"},
+		{ID: 2, Name: "DNaturalMinor"},
+		{ID: 3, Name: "FNaturalMajor"},
+		{ID: 4, Name: "GNaturalMajor"},
+		{ID: 5, Name: "ANaturalMinor"},
+	}
+	seed := int64(1)
+
+	testCases := []testCase{
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsProgressionWhenSucceeded",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					GetKey:        []interface{}{key, nil},
+					ListKeyChords: []interface{}{chords, &api.Pagination{Page: 1, PerPage: 100, TotalPages: 1, TotalItems: 5}, nil},
+				},
+			},
+			Filter: theory.ProgressionFilter{Style: "modal_vamp", Seed: &seed},
+		},
+		{
+			serviceTestCase: serviceTestCase{Title: "ReturnsErrorWhenStyleIsInvalid"},
+			Filter:          theory.ProgressionFilter{Style: "polka"},
+			ExpectedError:   theory.ErrInvalidStyle,
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenGetKeyFailed",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					GetKey: []interface{}{nil, theory.ErrKeyNotFound},
+				},
+			},
+			ExpectedError: theory.ErrKeyNotFound,
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenListKeyChordsFailed",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					GetKey:        []interface{}{key, nil},
+					ListKeyChords: []interface{}{nil, nil, errors.New("error")},
+				},
+			},
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenChordsAreMissing",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					GetKey:        []interface{}{key, nil},
+					ListKeyChords: []interface{}{[]theory.SimplifiedChord{}, nil, nil},
+				},
+			},
+			Filter:        theory.ProgressionFilter{Style: "jazz"},
+			ExpectedError: theory.ErrProgressionNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			service := tc.mockedService()
+			generated, err := service.GenerateProgression(context.Background(), 1, tc.Filter)
+			if strings.HasPrefix(tc.Title, "ReturnsError") {
+				require.Error(t, err)
+				if tc.ExpectedError != nil {
+					require.ErrorIs(t, err, tc.ExpectedError)
+				}
+				require.Nil(t, generated)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, theory.SimplifiedKey{ID: 1, Name: "CNaturalIonian"}, generated.Key)
+			require.Equal(t, theory.SimplifiedPitch{ID: 1, Name: "CNatural"}, generated.Tonic)
+			require.Equal(t, "modal_vamp", generated.Style)
+			require.Equal(t, seed, generated.Seed)
+			require.Len(t, generated.Chords, 4)
+			require.Equal(t, theory.AnalyzedChord{
+				Chord:        theory.SimplifiedChord{ID: 1, Name: "CNaturalMajor"},
+				Symbol:       "C",
+				RomanNumeral: "I",
+				Degree:       "Tonic",
+				Function:     "Diatonic",
+				Diatonic:     true,
+			}, generated.Chords[0])
+			require.Equal(t, generated.Chords[:2], generated.Chords[2:])
+
+			// same seed generates the same progression
+			again, err := service.GenerateProgression(context.Background(), 1, tc.Filter)
+			require.NoError(t, err)
+			require.Equal(t, generated, again)
+		})
+	}
+}
+
+func TestTheoryService_LeadVoices(t *testing.T) {
+	type testCase struct {
+		serviceTestCase
+		Filter        theory.VoiceLeadingFilter
+		ExpectedError error
+	}
+
+	chords := []theory.SimplifiedChord{
+		{ID: 1, Name: "DNaturalMinorSeventh"},
+		{ID: 2, Name: "GNaturalDominantSeventh"},
+		{ID: 3, Name: "CNaturalMajorSeventh"},
+	}
+
+	testCases := []testCase{
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsVoicingsWhenSucceeded",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					ListChordsByName: []interface{}{chords, nil},
+				},
+			},
+			Filter: theory.VoiceLeadingFilter{Chords: []string{"Dm7", "G7", "Cmaj7"}},
+		},
+		{
+			serviceTestCase: serviceTestCase{Title: "ReturnsErrorWhenProgressionIsEmpty"},
+			ExpectedError:   theory.ErrEmptyProgression,
+		},
+		{
+			serviceTestCase: serviceTestCase{Title: "ReturnsErrorWhenProgressionIsTooLong"},
+			Filter:          theory.VoiceLeadingFilter{Chords: make([]string, 65)},
+			ExpectedError:   theory.ErrProgressionTooLong,
+		},
+		{
+			serviceTestCase: serviceTestCase{Title: "ReturnsErrorWhenRangeIsInvalid"},
+			Filter:          theory.VoiceLeadingFilter{Chords: []string{"C"}, Low: "C5", High: "C4"},
+			ExpectedError:   theory.ErrInvalidVoiceRange,
+		},
+		{
+			serviceTestCase: serviceTestCase{Title: "ReturnsErrorWhenRangeIsTooWide"},
+			Filter:          theory.VoiceLeadingFilter{Chords: []string{"C"}, Low: "C-1", High: "G9"},
+			ExpectedError:   theory.ErrInvalidVoiceRange,
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenRangeHasTooManyVoicings",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					ListChordsByName: []interface{}{[]theory.SimplifiedChord{{ID: 4, Name: "CNaturalDiminishedSeventh"}}, nil},
+				},
+			},
+			Filter:        theory.VoiceLeadingFilter{Chords: []string{"Cdim7"}, Voices: 6, Low: "C2", High: "C6"},
+			ExpectedError: theory.ErrInvalidVoiceRange,
+		},
+		{
+			serviceTestCase: serviceTestCase{Title: "ReturnsErrorWhenSymbolIsInvalid"},
+			Filter:          theory.VoiceLeadingFilter{Chords: []string{"H7"}},
+			ExpectedError:   theory.ErrInvalidChordSymbol,
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenVoiceLeadingNotFound",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					ListChordsByName: []interface{}{chords[:1], nil},
+				},
+			},
+			Filter:        theory.VoiceLeadingFilter{Chords: []string{"Dm7"}, Low: "C4", High: "D4"},
+			ExpectedError: theory.ErrVoiceLeadingNotFound,
+		},
+		{
+			serviceTestCase: serviceTestCase{
+				Title: "ReturnsErrorWhenListChordsByNameFailed",
+				RepositoryReturnValues: mock.TheoryRepositoryReturnValues{
+					ListChordsByName: []interface{}{nil, errors.New("error")},
+				},
+			},
+			Filter: theory.VoiceLeadingFilter{Chords: []string{"Dm7"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			service := tc.mockedService()
+			leading, err := service.LeadVoices(context.Background(), tc.Filter)
+			if strings.HasPrefix(tc.Title, "ReturnsError") {
+				require.Error(t, err)
+				if tc.ExpectedError != nil {
+					require.ErrorIs(t, err, tc.ExpectedError)
+				}
+				require.Nil(t, leading)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, 4, leading.Voices)
+			require.Len(t, leading.Chords, 3)
+			require.Equal(t, theory.SimplifiedChord{ID: 2, Name: "GNaturalDominantSeventh"}, leading.Chords[1].Chord)
+			require.Equal(t, "G7", leading.Chords[1].Symbol)
+			require.Len(t, leading.Chords[1].Notes, 4)
+			require.Equal(t, "G3", leading.Chords[1].Notes[0].String())
+			require.Zero(t, leading.Chords[0].Movement)
+			require.Equal(t, leading.Chords[1].Movement+leading.Chords[2].Movement, leading.Movement)
+		})
+	}
+}
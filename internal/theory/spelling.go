@@ -0,0 +1,107 @@
+package theory
+
+import (
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/scale"
+	"github.com/edipermadi/music-db/pkg/theory/spelling"
+)
+
+func keySpelling(detailed DetailedKey) []spelling.Name {
+	return spelling.Key(scale.FromString(detailed.Scale.Name), pitch.FromInt(int(detailed.Tonic.ID)))
+}
+
+// chordSpelling returns spelled chord pitches starting from the bass
+func chordSpelling(detailed DetailedChord) []spelling.Name {
+	return rootedChordSpelling(detailed, spelling.Name{})
+}
+
+// rootedChordSpelling returns spelled chord pitches starting from the bass, keeping given root spelling when it names
+// the chord root
+func rootedChordSpelling(detailed DetailedChord, root spelling.Name) []spelling.Name {
+	c := detailedChord(detailed)
+	names := spelling.Chord(c.Quality, c.Root)
+	if root.Valid() && root.Pitch() == c.Root {
+		names = spelling.ChordFromRoot(c.Quality, root)
+	}
+
+	if !c.IsSlash() {
+		return names
+	}
+
+	for i, v := range names {
+		if v.Pitch() == c.Bass {
+			rotated := append(make([]spelling.Name, 0), names[i:]...)
+			return append(rotated, names[:i]...)
+		}
+	}
+
+	return names
+}
+
+// keyNotes returns key notes in ascending order starting from the tonic at given octave
+func keyNotes(detailed DetailedKey, octave int) []pitch.Note {
+	return scale.FromString(detailed.Scale.Name).Notes(pitch.FromInt(int(detailed.Tonic.ID)), octave)
+}
+
+// chordNotes returns chord notes in close position starting from the bass at given octave
+func chordNotes(detailed DetailedChord, octave int) []pitch.Note {
+	return detailedChord(detailed).Notes(octave)
+}
+
+// voicedSpelling returns spelled notes of a chord voicing, pitches outside the chord are spelled on their own
+func voicedSpelling(detailed DetailedChord, notes []pitch.Note) []spelling.Name {
+	names := chordSpelling(detailed)
+	entries := make([]spelling.Name, 0)
+	for _, v := range notes {
+		name := spelling.Default(v.Pitch)
+		for _, spelled := range names {
+			if spelled.Pitch() == v.Pitch {
+				name = spelled
+			}
+		}
+
+		entries = append(entries, name)
+	}
+
+	return entries
+}
+
+// detailedChord returns chord of a detailed chord, chord without bass is treated as root position
+func detailedChord(detailed DetailedChord) chord.Chord {
+	return chord.Chord{
+		Quality: chord.FromString(detailed.Quality.Name),
+		Root:    pitch.FromInt(int(detailed.Root.ID)),
+		Bass:    pitch.FromInt(int(detailed.Bass.ID)),
+	}
+}
+
+// chordSymbol returns lead sheet symbol of a detailed chord, such as "Am7/G"
+func chordSymbol(detailed DetailedChord) string {
+	return spelling.Symbol(detailedChord(detailed))
+}
+
+// progressionSymbol returns lead sheet symbol of a progression chord, keeping root spelling of the given symbol or
+// spelling the root as in the key when no symbol is given
+func progressionSymbol(c chord.Chord, given string, key []spelling.Name) string {
+	if root, found := spelling.SymbolRoot(given); found {
+		return spelling.SymbolFromRoot(c, root)
+	}
+
+	for _, v := range key {
+		if v.Pitch() == c.Root {
+			return spelling.SymbolFromRoot(c, v)
+		}
+	}
+
+	return spelling.Symbol(c)
+}
+
+func spelledNames(names []spelling.Name) []string {
+	entries := make([]string, 0)
+	for _, v := range names {
+		entries = append(entries, v.String())
+	}
+
+	return entries
+}
@@ -22,6 +22,8 @@ type TheoryRepositoryReturnValues struct {
 	ListChordPitches []interface{}
 	ListChordScales  []interface{}
 	ListChords       []interface{}
+	ListChordsByID   []interface{}
+	ListChordsByName []interface{}
 
 	GetScale         []interface{}
 	ListScaleChords  []interface{}
@@ -34,6 +36,7 @@ type TheoryRepositoryReturnValues struct {
 	ListKeyModes   []interface{}
 	ListKeyPitches []interface{}
 	ListKeys       []interface{}
+	ListKeysByName []interface{}
 }
 
 // TheoryRepository returns a mock implementation of theory.Repository
@@ -47,6 +50,8 @@ func TheoryRepository(values TheoryRepositoryReturnValues) theory.Repository {
 	repository.On("ListChordPitches", mock.Anything, mock.Anything).Return(values.ListChordPitches...)
 	repository.On("ListChordScales", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(values.ListChordScales...)
 	repository.On("ListChords", mock.Anything, mock.Anything, mock.Anything).Return(values.ListChords...)
+	repository.On("ListChordsByID", mock.Anything, mock.Anything).Return(values.ListChordsByID...)
+	repository.On("ListChordsByName", mock.Anything, mock.Anything).Return(values.ListChordsByName...)
 
 	// setup mocked key functions
 	repository.On("GetKey", mock.Anything, mock.Anything).Return(values.GetKey...)
@@ -54,6 +59,7 @@ func TheoryRepository(values TheoryRepositoryReturnValues) theory.Repository {
 	repository.On("ListKeyModes", mock.Anything, mock.Anything, mock.Anything).Return(values.ListKeyModes...)
 	repository.On("ListKeyPitches", mock.Anything, mock.Anything).Return(values.ListKeyPitches...)
 	repository.On("ListKeys", mock.Anything, mock.Anything, mock.Anything).Return(values.ListKeys...)
+	repository.On("ListKeysByName", mock.Anything, mock.Anything).Return(values.ListKeysByName...)
 
 	// setup mocked pitch functions
 	repository.On("GetPitch", mock.Anything, mock.Anything).Return(values.GetPitch...)
@@ -378,3 +384,39 @@ func (m *theoryRepository) GetKey(ctx context.Context, keyID int64) (*theory.Det
 
 	return entry, args.Error(1)
 }
+
+// ListKeysByName mock theory.Repository#ListKeysByName
+func (m *theoryRepository) ListKeysByName(ctx context.Context, names []string) ([]theory.SimplifiedKey, error) {
+	args := m.Called(ctx, names)
+
+	var entries []theory.SimplifiedKey
+	if v, ok := args.Get(0).([]theory.SimplifiedKey); ok {
+		entries = v
+	}
+
+	return entries, args.Error(1)
+}
+
+// ListChordsByID mock theory.Repository#ListChordsByID
+func (m *theoryRepository) ListChordsByID(ctx context.Context, chordIDs []int64) ([]theory.DetailedChord, error) {
+	args := m.Called(ctx, chordIDs)
+
+	var entries []theory.DetailedChord
+	if v, ok := args.Get(0).([]theory.DetailedChord); ok {
+		entries = v
+	}
+
+	return entries, args.Error(1)
+}
+
+// ListChordsByName mock theory.Repository#ListChordsByName
+func (m *theoryRepository) ListChordsByName(ctx context.Context, names []string) ([]theory.SimplifiedChord, error) {
+	args := m.Called(ctx, names)
+
+	var entries []theory.SimplifiedChord
+	if v, ok := args.Get(0).([]theory.SimplifiedChord); ok {
+		entries = v
+	}
+
+	return entries, args.Error(1)
+}
@@ -5,11 +5,15 @@ import (
 
 	"github.com/edipermadi/music-db/internal/platform/api"
 	"github.com/edipermadi/music-db/internal/theory"
+	"github.com/edipermadi/music-db/pkg/theory/chord"
 	"github.com/stretchr/testify/mock"
 )
 
 // TheoryServiceReturnValues stores return value of mocked theory.Service
 type TheoryServiceReturnValues struct {
+	AnalyzeMidi  []interface{}
+	EstimateKeys []interface{}
+
 	GetPitch        []interface{}
 	ListPitchChords []interface{}
 	ListPitchKeys   []interface{}
@@ -22,6 +26,17 @@ type TheoryServiceReturnValues struct {
 	ListChordPitches []interface{}
 	ListChordScales  []interface{}
 	ListChords       []interface{}
+	LookupChord      []interface{}
+
+	ListChordTransformations []interface{}
+	FindChordPath            []interface{}
+	ListChordFingerings      []interface{}
+
+	Identify []interface{}
+
+	AnalyzeProgression  []interface{}
+	GenerateProgression []interface{}
+	LeadVoices          []interface{}
 
 	GetScale         []interface{}
 	ListScaleChords  []interface{}
@@ -34,12 +49,17 @@ type TheoryServiceReturnValues struct {
 	ListKeyModes   []interface{}
 	ListKeyPitches []interface{}
 	ListKeys       []interface{}
+	ListKeyHarmony []interface{}
 }
 
 // TheoryService return a mocked implementation of theory.Serice
 func TheoryService(values TheoryServiceReturnValues) theory.Service {
 	service := &theoryService{}
 
+	// setup mocked analysis functions
+	service.On("AnalyzeMidi", mock.Anything, mock.Anything, mock.Anything).Return(values.AnalyzeMidi...)
+	service.On("EstimateKeys", mock.Anything, mock.Anything).Return(values.EstimateKeys...)
+
 	// setup mocked chord functions
 	service.On("GetChord", mock.Anything, mock.Anything).Return(values.GetChord...)
 	service.On("GetChordQuality", mock.Anything, mock.Anything).Return(values.GetChordQuality...)
@@ -47,6 +67,13 @@ func TheoryService(values TheoryServiceReturnValues) theory.Service {
 	service.On("ListChordScales", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(values.ListChordScales...)
 	service.On("ListChordPitches", mock.Anything, mock.Anything).Return(values.ListChordPitches...)
 	service.On("ListChords", mock.Anything, mock.Anything, mock.Anything).Return(values.ListChords...)
+	service.On("LookupChord", mock.Anything, mock.Anything).Return(values.LookupChord...)
+	service.On("ListChordTransformations", mock.Anything, mock.Anything).Return(values.ListChordTransformations...)
+	service.On("FindChordPath", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(values.FindChordPath...)
+	service.On("ListChordFingerings", mock.Anything, mock.Anything, mock.Anything).Return(values.ListChordFingerings...)
+
+	// setup mocked identification functions
+	service.On("Identify", mock.Anything, mock.Anything).Return(values.Identify...)
 
 	// setup mocked key functions
 	service.On("GetKey", mock.Anything, mock.Anything).Return(values.GetKey...)
@@ -54,9 +81,15 @@ func TheoryService(values TheoryServiceReturnValues) theory.Service {
 	service.On("ListKeyModes", mock.Anything, mock.Anything, mock.Anything).Return(values.ListKeyModes...)
 	service.On("ListKeyPitches", mock.Anything, mock.Anything).Return(values.ListKeyPitches...)
 	service.On("ListKeys", mock.Anything, mock.Anything, mock.Anything).Return(values.ListKeys...)
+	service.On("ListKeyHarmony", mock.Anything, mock.Anything).Return(values.ListKeyHarmony...)
+
+	// setup mocked progression functions
+	service.On("AnalyzeProgression", mock.Anything, mock.Anything).Return(values.AnalyzeProgression...)
+	service.On("GenerateProgression", mock.Anything, mock.Anything, mock.Anything).Return(values.GenerateProgression...)
+	service.On("LeadVoices", mock.Anything, mock.Anything).Return(values.LeadVoices...)
 
 	// setup mocked pitch functions
-	service.On("GetPitch", mock.Anything, mock.Anything).Return(values.GetPitch...)
+	service.On("GetPitch", mock.Anything, mock.Anything, mock.Anything).Return(values.GetPitch...)
 	service.On("ListPitchChords", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(values.ListPitchChords...)
 	service.On("ListPitchKeys", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(values.ListPitchKeys...)
 	service.On("ListPitchScales", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(values.ListPitchScales...)
@@ -90,8 +123,8 @@ func (m *theoryService) ListPitches(ctx context.Context) ([]theory.SimplifiedPit
 }
 
 // GetPitch mock theory.Service#GetPitch
-func (m *theoryService) GetPitch(ctx context.Context, pitchID int64) (*theory.DetailedPitch, error) {
-	args := m.Called(ctx, pitchID)
+func (m *theoryService) GetPitch(ctx context.Context, pitchID int64, filter theory.TuningFilter) (*theory.DetailedPitch, error) {
+	args := m.Called(ctx, pitchID, filter)
 
 	var entry *theory.DetailedPitch
 	if v, ok := args.Get(0).(*theory.DetailedPitch); ok {
@@ -227,6 +260,54 @@ func (m *theoryService) GetChord(ctx context.Context, chordID int64) (*theory.De
 	return entry, args.Error(1)
 }
 
+// LookupChord mock theory.Service#LookupChord
+func (m *theoryService) LookupChord(ctx context.Context, symbol string) (*theory.DetailedChord, error) {
+	args := m.Called(ctx, symbol)
+
+	var entry *theory.DetailedChord
+	if v, ok := args.Get(0).(*theory.DetailedChord); ok {
+		entry = v
+	}
+
+	return entry, args.Error(1)
+}
+
+// ListChordTransformations mock theory.Service#ListChordTransformations
+func (m *theoryService) ListChordTransformations(ctx context.Context, chordID int64) ([]theory.ChordTransformation, error) {
+	args := m.Called(ctx, chordID)
+
+	var entries []theory.ChordTransformation
+	if v, ok := args.Get(0).([]theory.ChordTransformation); ok {
+		entries = v
+	}
+
+	return entries, args.Error(1)
+}
+
+// FindChordPath mock theory.Service#FindChordPath
+func (m *theoryService) FindChordPath(ctx context.Context, fromChordID, toChordID int64, transformations []chord.Transformation) (*theory.ChordPath, error) {
+	args := m.Called(ctx, fromChordID, toChordID, transformations)
+
+	var entry *theory.ChordPath
+	if v, ok := args.Get(0).(*theory.ChordPath); ok {
+		entry = v
+	}
+
+	return entry, args.Error(1)
+}
+
+// ListChordFingerings mock theory.Service#ListChordFingerings
+func (m *theoryService) ListChordFingerings(ctx context.Context, chordID int64, filter theory.FingeringFilter) ([]theory.ChordFingering, error) {
+	args := m.Called(ctx, chordID, filter)
+
+	var entries []theory.ChordFingering
+	if v, ok := args.Get(0).([]theory.ChordFingering); ok {
+		entries = v
+	}
+
+	return entries, args.Error(1)
+}
+
 // GetChordQuality mock theory.Service#GetChordQuality
 func (m *theoryService) GetChordQuality(ctx context.Context, chordID int64) (*theory.DetailedChordQuality, error) {
 	args := m.Called(ctx, chordID)
@@ -367,6 +448,18 @@ func (m *theoryService) ListKeyPitches(ctx context.Context, keyID int64) ([]theo
 	return entries, args.Error(1)
 }
 
+// ListKeyHarmony mock theory.Service#ListKeyHarmony
+func (m *theoryService) ListKeyHarmony(ctx context.Context, keyID int64) ([]theory.KeyHarmony, error) {
+	args := m.Called(ctx, keyID)
+
+	var entries []theory.KeyHarmony
+	if v, ok := args.Get(0).([]theory.KeyHarmony); ok {
+		entries = v
+	}
+
+	return entries, args.Error(1)
+}
+
 // GetKey mock theory.Service#GetKey
 func (m *theoryService) GetKey(ctx context.Context, keyID int64) (*theory.DetailedKey, error) {
 	args := m.Called(ctx, keyID)
@@ -378,3 +471,75 @@ func (m *theoryService) GetKey(ctx context.Context, keyID int64) (*theory.Detail
 
 	return entry, args.Error(1)
 }
+
+// AnalyzeMidi mock theory.Service#AnalyzeMidi
+func (m *theoryService) AnalyzeMidi(ctx context.Context, data []byte, filter theory.AnalysisFilter) (*theory.MidiAnalysis, error) {
+	args := m.Called(ctx, data, filter)
+
+	var entry *theory.MidiAnalysis
+	if v, ok := args.Get(0).(*theory.MidiAnalysis); ok {
+		entry = v
+	}
+
+	return entry, args.Error(1)
+}
+
+// EstimateKeys mock theory.Service#EstimateKeys
+func (m *theoryService) EstimateKeys(ctx context.Context, filter theory.KeyEstimationFilter) ([]theory.EstimatedKey, error) {
+	args := m.Called(ctx, filter)
+
+	var entries []theory.EstimatedKey
+	if v, ok := args.Get(0).([]theory.EstimatedKey); ok {
+		entries = v
+	}
+
+	return entries, args.Error(1)
+}
+
+// AnalyzeProgression mock theory.Service#AnalyzeProgression
+func (m *theoryService) AnalyzeProgression(ctx context.Context, request theory.ProgressionAnalysisRequest) (*theory.ProgressionAnalysis, error) {
+	args := m.Called(ctx, request)
+
+	var entry *theory.ProgressionAnalysis
+	if v, ok := args.Get(0).(*theory.ProgressionAnalysis); ok {
+		entry = v
+	}
+
+	return entry, args.Error(1)
+}
+
+// GenerateProgression mock theory.Service#GenerateProgression
+func (m *theoryService) GenerateProgression(ctx context.Context, keyID int64, filter theory.ProgressionFilter) (*theory.GeneratedProgression, error) {
+	args := m.Called(ctx, keyID, filter)
+
+	var entry *theory.GeneratedProgression
+	if v, ok := args.Get(0).(*theory.GeneratedProgression); ok {
+		entry = v
+	}
+
+	return entry, args.Error(1)
+}
+
+// LeadVoices mock theory.Service#LeadVoices
+func (m *theoryService) LeadVoices(ctx context.Context, filter theory.VoiceLeadingFilter) (*theory.VoiceLeading, error) {
+	args := m.Called(ctx, filter)
+
+	var entry *theory.VoiceLeading
+	if v, ok := args.Get(0).(*theory.VoiceLeading); ok {
+		entry = v
+	}
+
+	return entry, args.Error(1)
+}
+
+// Identify mock theory.Service#Identify
+func (m *theoryService) Identify(ctx context.Context, filter theory.IdentificationFilter) (*theory.Identification, error) {
+	args := m.Called(ctx, filter)
+
+	var entry *theory.Identification
+	if v, ok := args.Get(0).(*theory.Identification); ok {
+		entry = v
+	}
+
+	return entry, args.Error(1)
+}
@@ -1,11 +1,10 @@
 package illustations
 
 import (
-	"image"
 	"math"
 
 	"github.com/edipermadi/music-db/pkg/theory/pitch"
-	"github.com/fogleman/gg"
+	"github.com/edipermadi/music-db/pkg/theory/spelling"
 )
 
 func pitchSliceToMap(pitches []pitch.Type) map[pitch.Type]struct{} {
@@ -17,29 +16,50 @@ func pitchSliceToMap(pitches []pitch.Type) map[pitch.Type]struct{} {
 	return pitchMap
 }
 
-func drawBracelet(pitches []pitch.Type, circle []pitch.Type, labels map[pitch.Type]string) (image.Image, error) {
-	width := 400
-	height := 400
-	dc := gg.NewContext(width, height)
-	if err := dc.LoadFontFace("DroidSansFallback.ttf", 24); err != nil {
+func spelledPitches(names []spelling.Name) []pitch.Type {
+	pitches := make([]pitch.Type, 0)
+	for _, v := range names {
+		pitches = append(pitches, v.Pitch())
+	}
+
+	return pitches
+}
+
+// braceletLegend tells highlights of bracelet diagram, where pitches are colored by their adjacent fifths
+func braceletLegend(p Palette) []legendEntry {
+	return []legendEntry{
+		{Color: p.Primary, Text: "Starts a chain of fifths"},
+		{Color: p.Tertiary, Text: "Within a chain of fifths"},
+		{Color: p.Quaternary, Text: "Ends a chain of fifths"},
+		{Color: p.Secondary, Text: "Without adjacent fifths"},
+	}
+}
+
+func drawBracelet(names []spelling.Name, circle []pitch.Type, defaults func(pitch.Type) spelling.Name, options Options) (Illustration, error) {
+	palette := options.palette()
+	t := options.theme()
+	legend := braceletLegend(palette)
+
+	size := 400
+	r, err := NewRenderer(options, size, size+options.legendHeight(legend), 24)
+	if err != nil {
 		return nil, err
 	}
 
-	centerX := float64(width) / 2
-	centerY := float64(height) / 2
+	r.Clear(t.Background)
+
+	centerX := float64(size) / 2
+	centerY := float64(size) / 2
 
 	// large outer circle
-	dc.DrawCircle(centerX, centerY, 150)
-	dc.SetRGB(0, 0, 0)
-	dc.Fill()
+	r.Circle(centerX, centerY, 150, Style{Fill: t.Foreground})
 
 	// large circle
-	dc.DrawCircle(centerX, centerY, 148)
-	dc.SetRGB(1, 1, 1)
-	dc.Fill()
+	r.Circle(centerX, centerY, 148, Style{Fill: t.Background})
 
 	// convert pitches into map
-	pitchMap := pitchSliceToMap(pitches)
+	pitchMap := pitchSliceToMap(spelledPitches(names))
+	labels := pitchLabels(names, defaults, options.Labels)
 
 	step := math.Pi / 6
 	for i, p := range circle {
@@ -47,79 +67,47 @@ func drawBracelet(pitches []pitch.Type, circle []pitch.Type, labels map[pitch.Ty
 		y := math.Cos(step*float64(i)) * 145
 
 		// small outer circle
-		dc.DrawCircle(centerX+x, centerY-y, 32)
-		dc.SetRGB(0, 0, 0)
-		dc.Fill()
+		r.Circle(centerX+x, centerY-y, 32, Style{Fill: t.Foreground})
 
 		// check if current number matches the pitch
 		_, match := pitchMap[p]
 
-		// small inner circle
-		dc.DrawCircle(centerX+x, centerY-y, 30)
-
 		// set color
+		color := t.Surface
 		if match {
 			_, hasNextFifth := pitchMap[p.NextFifth()]
 			_, hasPreviousFifth := pitchMap[p.PreviousFifth()]
 			switch {
 			case hasPreviousFifth && hasNextFifth:
-				dc.SetHexColor("#4caf50")
+				color = palette.Tertiary
 			case !hasPreviousFifth && hasNextFifth:
-				dc.SetHexColor("#2196f3")
+				color = palette.Primary
 			case hasPreviousFifth && !hasNextFifth:
-				dc.SetHexColor("#ff9800")
+				color = palette.Quaternary
 			default:
-				dc.SetHexColor("#f44336")
+				color = palette.Secondary
 			}
-		} else {
-			dc.SetRGB(1, 1, 1)
 		}
 
-		// fill
-		dc.Fill()
+		// small inner circle
+		r.Circle(centerX+x, centerY-y, 30, Style{Fill: color})
 
 		// draw text
-		dc.SetRGB(0, 0, 0)
-		dc.DrawStringAnchored(labels[p], centerX+x, centerY-y, 0.5, 0.5)
+		r.Text(labels[p], centerX+x, centerY-y, 0.5, 0.5, t.Foreground)
 	}
 
-	return dc.Image(), nil
-}
-
-var pitchClassLabels = map[pitch.Type]string{
-	pitch.CNatural: "C",
-	pitch.CSharp:   "C♯",
-	pitch.DNatural: "D",
-	pitch.DSharp:   "D♯",
-	pitch.ENatural: "E",
-	pitch.FNatural: "F",
-	pitch.FSharp:   "F♯",
-	pitch.GNatural: "G",
-	pitch.GSharp:   "G♯",
-	pitch.ANatural: "A",
-	pitch.ASharp:   "A♯",
-	pitch.BNatural: "B",
-}
-
-var circleOfFifthLabels = map[pitch.Type]string{
-	pitch.CNatural: "C",
-	pitch.CSharp:   "D♭",
-	pitch.DNatural: "D",
-	pitch.DSharp:   "E♭",
-	pitch.ENatural: "E",
-	pitch.FNatural: "F",
-	pitch.FSharp:   "G♭",
-	pitch.GNatural: "G",
-	pitch.GSharp:   "A♭",
-	pitch.ANatural: "A",
-	pitch.ASharp:   "B♭",
-	pitch.BNatural: "B",
+	options.drawLegend(r, legend, float64(size))
+	return r, nil
 }
 
-func PitchClassBracelet(pitches []pitch.Type) (image.Image, error) {
-	return drawBracelet(pitches, pitch.AllPitches(), pitchClassLabels)
+// PitchClassBracelet illustrates spelled pitches as pitch class bracelet diagram, pitches which are not spelled are
+// labeled with sharps
+func PitchClassBracelet(names []spelling.Name, options Options) (Illustration, error) {
+	return drawBracelet(names, pitch.AllPitches(), spelling.Default, options)
 }
 
-func CircleOfFifthBracelet(pitches []pitch.Type) (image.Image, error) {
-	return drawBracelet(pitches, pitch.CircleOfFifths(), circleOfFifthLabels)
+// CircleOfFifthBracelet illustrates spelled pitches as circle of fifth bracelet diagram, pitches which are not spelled
+// are labeled with flats
+func CircleOfFifthBracelet(names []spelling.Name, options Options) (Illustration, error) {
+	return drawBracelet(names, pitch.CircleOfFifths(), flatSpelling, options)
 }
@@ -1,7 +1,6 @@
 package illustations_test
 
 import (
-	"image/png"
 	"log"
 	"os"
 	"testing"
@@ -9,49 +8,58 @@ import (
 	"github.com/edipermadi/music-db/pkg/illustations"
 	"github.com/edipermadi/music-db/pkg/theory/pitch"
 	"github.com/edipermadi/music-db/pkg/theory/scale"
+	"github.com/edipermadi/music-db/pkg/theory/spelling"
 	"github.com/stretchr/testify/require"
 )
 
 func TestPitchClassBracelet(t *testing.T) {
-	// generate image
-	pitches := scale.Ionian.Pitches(pitch.CNatural)
-	img, err := illustations.PitchClassBracelet(pitches)
-	require.NoError(t, err)
-
-	// create temporary file
-	file, err := os.CreateTemp(os.TempDir(), "pitch-class-bracelet.*.png")
-	if err != nil {
-		log.Fatal(err)
-	}
+	for _, format := range illustations.AllFormats() {
+		t.Run(format.String(), func(t *testing.T) {
+			// generate image
+			names := spelling.Key(scale.Ionian, pitch.CNatural)
+			img, err := illustations.PitchClassBracelet(names, illustations.Options{Format: format})
+			require.NoError(t, err)
+
+			// create temporary file
+			file, err := os.CreateTemp(os.TempDir(), "pitch-class-bracelet.*."+format.String())
+			if err != nil {
+				log.Fatal(err)
+			}
 
-	// close and delete file later
-	defer func() {
-		_ = file.Close()
-		_ = os.Remove(file.Name())
-	}()
+			// close and delete file later
+			defer func() {
+				_ = file.Close()
+				_ = os.Remove(file.Name())
+			}()
 
-	// save image as png
-	require.NoError(t, png.Encode(file, img))
+			// save image
+			require.NoError(t, img.Encode(file))
+		})
+	}
 }
 
 func TestCircleOfFifthBracelet(t *testing.T) {
-	// generate image
-	pitches := scale.Ionian.Pitches(pitch.CNatural)
-	img, err := illustations.CircleOfFifthBracelet(pitches)
-	require.NoError(t, err)
-
-	// create temporary file
-	file, err := os.CreateTemp(os.TempDir(), "circle-of-fifth-bracelet.*.png")
-	if err != nil {
-		log.Fatal(err)
-	}
+	for _, format := range illustations.AllFormats() {
+		t.Run(format.String(), func(t *testing.T) {
+			// generate image
+			names := spelling.Key(scale.Ionian, pitch.FNatural)
+			img, err := illustations.CircleOfFifthBracelet(names, illustations.Options{Format: format})
+			require.NoError(t, err)
+
+			// create temporary file
+			file, err := os.CreateTemp(os.TempDir(), "circle-of-fifth-bracelet.*."+format.String())
+			if err != nil {
+				log.Fatal(err)
+			}
 
-	// close and delete file later
-	defer func() {
-		_ = file.Close()
-		_ = os.Remove(file.Name())
-	}()
+			// close and delete file later
+			defer func() {
+				_ = file.Close()
+				_ = os.Remove(file.Name())
+			}()
 
-	// save image as png
-	require.NoError(t, png.Encode(file, img))
+			// save image
+			require.NoError(t, img.Encode(file))
+		})
+	}
 }
@@ -0,0 +1,94 @@
+package illustations
+
+import (
+	"fmt"
+
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+)
+
+// chordBoxFrets is the least number of frets shown by chord box
+const chordBoxFrets = 5
+
+// ChordBox illustrates a fretted instrument fingering as chord box, strings are drawn from the lowest on the left and
+// frets are given in the same order, where a negative fret is a muted string. Dots of root pitch are highlighted, open
+// and muted strings are marked above the nut and shapes beyond the fifth fret are labeled with their base fret. Strings
+// are labeled with their tuning regardless of label style.
+func ChordBox(strings []pitch.Note, frets []int, root pitch.Type, options Options) (Illustration, error) {
+	palette := options.palette()
+	t := options.theme()
+	legend := []legendEntry{
+		{Color: palette.Primary, Text: "Root"},
+		{Color: palette.Secondary, Text: "Other chord tones"},
+	}
+
+	width := 300
+	height := 400
+	r, err := NewRenderer(options, width, height+options.legendHeight(legend), 16)
+	if err != nil {
+		return nil, err
+	}
+
+	r.Clear(t.Background)
+
+	// find the first fret shown, shapes reaching beyond the box are moved up the neck
+	base := 1
+	highest, lowest := 0, 0
+	for _, v := range frets {
+		if v > 0 {
+			highest = max(highest, v)
+			if lowest == 0 || v < lowest {
+				lowest = v
+			}
+		}
+	}
+
+	if highest > chordBoxFrets {
+		base = lowest
+	}
+
+	shown := max(chordBoxFrets, highest-base+1)
+
+	left := 60.0
+	top := 70.0
+	boxWidth := float64(width) - left*2
+	boxHeight := float64(height) - top - 70
+	stringGap := boxWidth / float64(max(len(strings)-1, 1))
+	fretGap := boxHeight / float64(shown)
+
+	// draw frets, the nut is drawn thicker
+	for i := 0; i <= shown; i++ {
+		y := top + float64(i)*fretGap
+		lineWidth := 2.0
+		if i == 0 && base == 1 {
+			lineWidth = 8
+		}
+		r.Polyline(line(left, y, left+boxWidth, y), Style{Stroke: t.Foreground, LineWidth: lineWidth})
+	}
+
+	if base > 1 {
+		r.Text(fmt.Sprintf("%dfr", base), left-30, top+fretGap/2, 0.5, 0.5, t.Foreground)
+	}
+
+	// draw strings with their tuning
+	for i, v := range strings {
+		x := left + float64(i)*stringGap
+		r.Polyline(line(x, top, x, top+boxHeight), Style{Stroke: t.Foreground, LineWidth: 2})
+		r.Text(v.String(), x, top+boxHeight+30, 0.5, 0.5, t.Foreground)
+		if i >= len(frets) {
+			continue
+		}
+
+		fret := frets[i]
+		switch {
+		case fret < 0:
+			r.Text("X", x, top-25, 0.5, 0.5, t.Foreground)
+		case fret == 0:
+			r.Circle(x, top-25, 8, Style{Stroke: t.Foreground, LineWidth: 2})
+		default:
+			r.Circle(x, top+(float64(fret-base)+0.5)*fretGap, 12, Style{Fill: palette.highlight(v.Transpose(fret).Pitch == root)})
+		}
+	}
+
+	options.drawLegend(r, legend, float64(height))
+	return r, nil
+}
@@ -0,0 +1,33 @@
+package illustations_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/illustations"
+	"github.com/edipermadi/music-db/pkg/theory/fretboard"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChordBox(t *testing.T) {
+	for _, format := range illustations.AllFormats() {
+		t.Run(format.String(), func(t *testing.T) {
+			// generate image
+			frets := []int{fretboard.Muted, 3, 2, 0, 1, 0}
+			img, err := illustations.ChordBox(fretboard.Standard.Strings(), frets, pitch.CNatural, illustations.Options{Format: format})
+			require.NoError(t, err)
+
+			// create temporary file
+			file, err := os.CreateTemp(os.TempDir(), "chord-box.*."+format.String())
+			require.NoError(t, err)
+			defer func() {
+				_ = file.Close()
+				_ = os.Remove(file.Name())
+			}()
+
+			// save image
+			require.NoError(t, img.Encode(file))
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package illustations
+
+import (
+	"math"
+
+	"github.com/edipermadi/music-db/pkg/theory/spelling"
+)
+
+// staffSpace is distance between adjacent staff lines, glyphs are measured in staff spaces upward from their origin
+const staffSpace = 12.0
+
+// glyph places points measured in staff spaces at given origin on the illustration
+func glyph(x, y float64, points ...Point) []Point {
+	placed := make([]Point, 0)
+	for _, v := range points {
+		placed = append(placed, Point{X: x + v.X*staffSpace, Y: y - v.Y*staffSpace})
+	}
+
+	return placed
+}
+
+// spline returns points of a Catmull-Rom spline passing through given points, each segment is divided into given
+// number of lines
+func spline(points []Point, segments int) []Point {
+	if len(points) < 3 {
+		return points
+	}
+
+	curve := []Point{points[0]}
+	for i := 0; i < len(points)-1; i++ {
+		p0, p1, p2, p3 := points[max(i-1, 0)], points[i], points[i+1], points[min(i+2, len(points)-1)]
+		for s := 1; s <= segments; s++ {
+			t := float64(s) / float64(segments)
+			at := func(v0, v1, v2, v3 float64) float64 {
+				return 0.5 * (2*v1 + (v2-v0)*t + (2*v0-5*v1+4*v2-v3)*t*t + (3*v1-v0-3*v2+v3)*t*t*t)
+			}
+
+			curve = append(curve, Point{X: at(p0.X, p1.X, p2.X, p3.X), Y: at(p0.Y, p1.Y, p2.Y, p3.Y)})
+		}
+	}
+
+	return curve
+}
+
+// drawTrebleClef draws treble clef curling around G line at given origin
+func drawTrebleClef(r Renderer, x, y float64, color string) {
+	curve := spline(glyph(x, y,
+		Point{X: -0.55, Y: -2.05},
+		Point{X: -0.2, Y: -2.7},
+		Point{X: 0.35, Y: -2.3},
+		Point{X: 0.3, Y: -1},
+		Point{X: 0.15, Y: 0.5},
+		Point{X: 0, Y: 2},
+		Point{X: 0.05, Y: 3.3},
+		Point{X: 0.4, Y: 4.3},
+		Point{X: 0.75, Y: 3.8},
+		Point{X: 0.55, Y: 2.9},
+		Point{X: -0.2, Y: 2},
+		Point{X: -0.9, Y: 1.1},
+		Point{X: -1.2, Y: 0.1},
+		Point{X: -0.8, Y: -0.9},
+		Point{X: 0.1, Y: -1.2},
+		Point{X: 0.9, Y: -0.7},
+		Point{X: 1.05, Y: 0.2},
+		Point{X: 0.5, Y: 0.85},
+		Point{X: -0.25, Y: 0.7},
+		Point{X: -0.45, Y: 0},
+		Point{X: -0.1, Y: -0.45},
+	), 8)
+
+	r.Polyline(curve, Style{Stroke: color, LineWidth: 0.22 * staffSpace})
+	r.Circle(curve[0].X, curve[0].Y, 0.3*staffSpace, Style{Fill: color})
+}
+
+// drawBassClef draws bass clef with its head and dots around F line at given origin
+func drawBassClef(r Renderer, x, y float64, color string) {
+	curve := spline(glyph(x, y,
+		Point{X: -0.75, Y: 0.1},
+		Point{X: -0.4, Y: 0.75},
+		Point{X: 0.35, Y: 0.95},
+		Point{X: 0.95, Y: 0.45},
+		Point{X: 1.05, Y: -0.35},
+		Point{X: 0.7, Y: -1.2},
+		Point{X: -0.05, Y: -1.95},
+		Point{X: -0.95, Y: -2.5},
+	), 8)
+
+	r.Polyline(curve, Style{Stroke: color, LineWidth: 0.25 * staffSpace})
+	r.Circle(x-0.75*staffSpace, y, 0.35*staffSpace, Style{Fill: color})
+	r.Circle(x+1.5*staffSpace, y-0.5*staffSpace, 0.15*staffSpace, Style{Fill: color})
+	r.Circle(x+1.5*staffSpace, y+0.5*staffSpace, 0.15*staffSpace, Style{Fill: color})
+}
+
+// drawWholeNote draws hollow notehead of whole note centered at given point, the hole is traced backward so that it
+// is left unfilled
+func drawWholeNote(r Renderer, x, y float64, color string) {
+	points := make([]Point, 0)
+	for i := 0; i <= 24; i++ {
+		angle := 2 * math.Pi * float64(i) / 24
+		points = append(points, Point{X: x + 0.8*staffSpace*math.Cos(angle), Y: y + 0.55*staffSpace*math.Sin(angle)})
+	}
+
+	tilt := -0.6
+	for i := 24; i >= 0; i-- {
+		angle := 2 * math.Pi * float64(i) / 24
+		dx, dy := 0.27*staffSpace*math.Cos(angle), 0.45*staffSpace*math.Sin(angle)
+		points = append(points, Point{X: x + dx*math.Cos(tilt) - dy*math.Sin(tilt), Y: y + dx*math.Sin(tilt) + dy*math.Cos(tilt)})
+	}
+
+	r.Polygon(points, Style{Fill: color})
+}
+
+// accidentalBar returns slanted bar of sharp and natural signs centered at given height, spanning given half width
+func accidentalBar(x, y, center, halfWidth float64) []Point {
+	return glyph(x, y,
+		Point{X: -halfWidth, Y: center - 0.3*halfWidth - 0.11},
+		Point{X: halfWidth, Y: center + 0.3*halfWidth - 0.11},
+		Point{X: halfWidth, Y: center + 0.3*halfWidth + 0.11},
+		Point{X: -halfWidth, Y: center - 0.3*halfWidth + 0.11},
+	)
+}
+
+// drawFlat draws flat sign, its bowl sits on given point
+func drawFlat(r Renderer, x, y float64, color string) {
+	r.Polyline(glyph(x, y, Point{X: -0.3, Y: 2}, Point{X: -0.3, Y: -0.5}), Style{Stroke: color, LineWidth: 0.12 * staffSpace})
+	r.Polyline(spline(glyph(x, y,
+		Point{X: -0.3, Y: -0.5},
+		Point{X: 0.35, Y: 0.05},
+		Point{X: 0.4, Y: 0.5},
+		Point{X: 0.05, Y: 0.6},
+		Point{X: -0.3, Y: 0.25},
+	), 6), Style{Stroke: color, LineWidth: 0.2 * staffSpace})
+}
+
+// drawAccidental draws accidental sign centered at given point, naturals are drawn as well
+func drawAccidental(r Renderer, accidental spelling.Accidental, x, y float64, color string) {
+	line := Style{Stroke: color, LineWidth: 0.12 * staffSpace}
+	switch accidental {
+	case spelling.Sharp:
+		r.Polyline(glyph(x, y, Point{X: -0.22, Y: -1.35}, Point{X: -0.22, Y: 1.15}), line)
+		r.Polyline(glyph(x, y, Point{X: 0.22, Y: -1.15}, Point{X: 0.22, Y: 1.35}), line)
+		r.Polygon(accidentalBar(x, y, 0.45, 0.5), Style{Fill: color})
+		r.Polygon(accidentalBar(x, y, -0.45, 0.5), Style{Fill: color})
+	case spelling.Flat:
+		drawFlat(r, x, y, color)
+	case spelling.DoubleSharp:
+		cross := Style{Stroke: color, LineWidth: 0.18 * staffSpace}
+		r.Polyline(glyph(x, y, Point{X: -0.4, Y: -0.4}, Point{X: 0.4, Y: 0.4}), cross)
+		r.Polyline(glyph(x, y, Point{X: -0.4, Y: 0.4}, Point{X: 0.4, Y: -0.4}), cross)
+	case spelling.DoubleFlat:
+		drawFlat(r, x-0.35*staffSpace, y, color)
+		drawFlat(r, x+0.35*staffSpace, y, color)
+	default:
+		r.Polyline(glyph(x, y, Point{X: -0.25, Y: 1.3}, Point{X: -0.25, Y: -0.45}), line)
+		r.Polyline(glyph(x, y, Point{X: 0.25, Y: 0.45}, Point{X: 0.25, Y: -1.3}), line)
+		r.Polygon(accidentalBar(x, y, 0.35, 0.25), Style{Fill: color})
+		r.Polygon(accidentalBar(x, y, -0.35, 0.25), Style{Fill: color})
+	}
+}
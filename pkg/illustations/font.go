@@ -0,0 +1,152 @@
+package illustations
+
+import (
+	_ "embed"
+	"image"
+	"image/draw"
+	"math"
+	"sync"
+
+	"github.com/golang/freetype/truetype"
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+)
+
+// dejaVuSans is the embedded font of png illustrations, which has glyphs of accidentals such as ♯ and ♭
+//
+//go:embed fonts/DejaVuSans.ttf
+var dejaVuSans []byte
+
+// maxFaces is maximum count of cached faces, the least recently used face is evicted first
+const maxFaces = 8
+
+// glyphCacheEntries is count of glyph masks cached by each face, which are allocated along with the face
+const glyphCacheEntries = 64
+
+// faces is the shared face cache of png illustrations
+var faces = &faceCache{ttf: dejaVuSans, faces: make(map[float64]*cachedFace)}
+
+// SetFontFace replaces font face of texts on png illustrations, such as a face of a font parsed by the caller. The face
+// is used regardless of font size of illustrations and is shared by concurrent illustrations, nil restores faces of
+// the embedded font.
+func SetFontFace(face font.Face) {
+	faces.mutex.Lock()
+	defer faces.mutex.Unlock()
+
+	faces.custom = nil
+	if face != nil {
+		faces.custom = &sharedFace{face: face}
+	}
+}
+
+// faceCache parses a font once and caches a few of its faces by size
+type faceCache struct {
+	mutex  sync.Mutex
+	ttf    []byte
+	parsed *truetype.Font
+	faces  map[float64]*cachedFace
+	custom font.Face
+	clock  uint64
+}
+
+// cachedFace is a cached face with the time it was last used
+type cachedFace struct {
+	face *sharedFace
+	used uint64
+}
+
+// Face returns face of given size in points rounded to whole points, or the injected face when there is one
+func (c *faceCache) Face(size float64) (font.Face, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.custom != nil {
+		return c.custom, nil
+	}
+
+	c.clock++
+	size = max(math.Round(size), 1)
+	if cached, ok := c.faces[size]; ok {
+		cached.used = c.clock
+		return cached.face, nil
+	}
+
+	if c.parsed == nil {
+		parsed, err := truetype.Parse(c.ttf)
+		if err != nil {
+			return nil, err
+		}
+
+		c.parsed = parsed
+	}
+
+	// evict the least recently used face, renderers holding it keep using it
+	if len(c.faces) >= maxFaces {
+		evicted, oldest := 0.0, c.clock
+		for k, v := range c.faces {
+			if v.used < oldest {
+				evicted, oldest = k, v.used
+			}
+		}
+
+		delete(c.faces, evicted)
+	}
+
+	face := &sharedFace{face: truetype.NewFace(c.parsed, &truetype.Options{Size: size, GlyphCacheEntries: glyphCacheEntries})}
+	c.faces[size] = &cachedFace{face: face, used: c.clock}
+	return face, nil
+}
+
+// sharedFace guards a face from concurrent use, since faces cache glyphs and reuse their glyph masks between calls
+type sharedFace struct {
+	mutex sync.Mutex
+	face  font.Face
+}
+
+func (f *sharedFace) Close() error {
+	return nil
+}
+
+func (f *sharedFace) Glyph(dot fixed.Point26_6, r rune) (image.Rectangle, image.Image, image.Point, fixed.Int26_6, bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	dr, mask, maskp, advance, ok := f.face.Glyph(dot, r)
+	if !ok || mask == nil {
+		return dr, mask, maskp, advance, ok
+	}
+
+	// copy glyph mask before it is overwritten by the next call
+	bounds := image.Rectangle{Min: maskp, Max: maskp.Add(dr.Size())}
+	copied := image.NewAlpha(bounds)
+	draw.Draw(copied, bounds, mask, maskp, draw.Src)
+	return dr, copied, maskp, advance, ok
+}
+
+func (f *sharedFace) GlyphBounds(r rune) (fixed.Rectangle26_6, fixed.Int26_6, bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return f.face.GlyphBounds(r)
+}
+
+func (f *sharedFace) GlyphAdvance(r rune) (fixed.Int26_6, bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return f.face.GlyphAdvance(r)
+}
+
+func (f *sharedFace) Kern(r0, r1 rune) fixed.Int26_6 {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return f.face.Kern(r0, r1)
+}
+
+func (f *sharedFace) Metrics() font.Metrics {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return f.face.Metrics()
+}
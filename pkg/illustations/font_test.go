@@ -0,0 +1,58 @@
+package illustations_test
+
+import (
+	"io"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/illustations"
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/fretboard"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/spelling"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/image/font/basicfont"
+)
+
+func TestSetFontFace(t *testing.T) {
+	illustations.SetFontFace(basicfont.Face7x13)
+	defer illustations.SetFontFace(nil)
+
+	img, err := illustations.Keyboard(spelling.Chord(chord.Major, pitch.CNatural), illustations.KeyboardRange{}, illustations.Options{Format: illustations.PNG})
+	require.NoError(t, err)
+	require.NoError(t, img.Encode(io.Discard))
+}
+
+func TestFontFace_Concurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for _, v := range pitch.AllPitches() {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			img, err := illustations.PitchClassBracelet(spelling.Chord(chord.Major, v), illustations.Options{Format: illustations.PNG})
+			require.NoError(t, err)
+			require.NoError(t, img.Encode(io.Discard))
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestFontFace_Sizes(t *testing.T) {
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+
+	// every canvas height asks for another face size, only a few faces are kept
+	for height := 2048; height > 2028; height-- {
+		img, err := illustations.ChordBox(fretboard.Standard.Strings(), []int{-1, 3, 2, 0, 1, 0}, pitch.CNatural, illustations.Options{Format: illustations.PNG, Height: height})
+		require.NoError(t, err)
+		require.NoError(t, img.Encode(io.Discard))
+	}
+
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+	require.Less(t, int64(after.HeapAlloc)-int64(before.HeapAlloc), int64(64<<20))
+}
@@ -0,0 +1,56 @@
+package illustations
+
+// Format is a type for illustration output format
+type Format int
+
+// Illustration format enumerations
+const (
+	InvalidFormat Format = iota
+	PNG           Format = iota
+	SVG           Format = iota
+)
+
+// AllFormats returns all illustration formats
+func AllFormats() []Format {
+	return []Format{
+		PNG,
+		SVG,
+	}
+}
+
+// String returns illustration format name, being its file extension
+func (f Format) String() string {
+	if f < PNG || f > SVG {
+		return "invalid"
+	}
+
+	return [...]string{
+		"invalid",
+		"png",
+		"svg",
+	}[f]
+}
+
+// ContentType returns media type of illustration format
+func (f Format) ContentType() string {
+	if f < PNG || f > SVG {
+		return ""
+	}
+
+	return [...]string{
+		"",
+		"image/png",
+		"image/svg+xml",
+	}[f]
+}
+
+// FormatFromString returns illustration format from its name or media type, such as "svg" or "image/svg+xml"
+func FormatFromString(name string) Format {
+	for _, v := range AllFormats() {
+		if v.String() == name || v.ContentType() == name {
+			return v
+		}
+	}
+
+	return InvalidFormat
+}
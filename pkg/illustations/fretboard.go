@@ -0,0 +1,84 @@
+package illustations
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/spelling"
+)
+
+// fretboardInlays are frets marked with inlays, the twelfth fret is marked twice
+var fretboardInlays = []int{3, 5, 7, 9, 12, 15, 17, 19, 21, 24}
+
+// Fretboard illustrates spelled pitches on a fretted instrument neck from the open strings up to given fret, strings
+// are given from the lowest one which is drawn at the bottom. Every position of spelled pitches is labeled with its
+// spelling and positions of the first spelled pitch are highlighted.
+func Fretboard(strings []pitch.Note, names []spelling.Name, frets int, options Options) (Illustration, error) {
+	pitches := spelledPitches(names)
+	labels := pitchLabels(names, spelling.Default, options.Labels)
+	palette := options.palette()
+	t := options.theme()
+	legend := []legendEntry{
+		{Color: palette.Primary, Text: "Tonic"},
+		{Color: palette.Secondary, Text: "Other pitches"},
+	}
+
+	fretWidth := 60.0
+	stringGap := 40.0
+	left := 80.0
+	top := 40.0
+	width := int(left + float64(frets)*fretWidth + 20)
+	height := int(top + float64(max(len(strings)-1, 1))*stringGap + 50)
+	r, err := NewRenderer(options, width, height+options.legendHeight(legend), 16)
+	if err != nil {
+		return nil, err
+	}
+
+	r.Clear(t.Background)
+
+	neckHeight := float64(len(strings)-1) * stringGap
+	stringY := func(i int) float64 {
+		return top + neckHeight - float64(i)*stringGap
+	}
+
+	// draw frets, the nut is drawn thicker, with inlays and fret numbers below the neck
+	for i := 0; i <= frets; i++ {
+		x := left + float64(i)*fretWidth
+		lineWidth := 2.0
+		if i == 0 {
+			lineWidth = 8
+		}
+		r.Polyline(line(x, top, x, top+neckHeight), Style{Stroke: t.Foreground, LineWidth: lineWidth})
+
+		if i > 0 && slices.Contains(fretboardInlays, i) {
+			r.Text(fmt.Sprintf("%d", i), x-fretWidth/2, top+neckHeight+30, 0.5, 0.5, t.Foreground)
+		}
+	}
+
+	// draw strings with their tuning and positions of spelled pitches
+	for i, open := range strings {
+		y := stringY(i)
+		r.Polyline(line(left, y, left+float64(frets)*fretWidth, y), Style{Stroke: t.Foreground, LineWidth: 2})
+		r.Text(open.String(), 20, y, 0.5, 0.5, t.Foreground)
+
+		for fret := 0; fret <= frets; fret++ {
+			note := open.Transpose(fret)
+			if !slices.Contains(pitches, note.Pitch) {
+				continue
+			}
+
+			// open strings are marked left of the nut
+			x := left - 25
+			if fret > 0 {
+				x = left + (float64(fret)-0.5)*fretWidth
+			}
+
+			r.Circle(x, y, 14, Style{Fill: palette.highlight(note.Pitch == pitches[0])})
+			r.Text(labels[note.Pitch], x, y, 0.5, 0.35, "#ffffff")
+		}
+	}
+
+	options.drawLegend(r, legend, float64(height))
+	return r, nil
+}
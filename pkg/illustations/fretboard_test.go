@@ -0,0 +1,35 @@
+package illustations_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/illustations"
+	"github.com/edipermadi/music-db/pkg/theory/fretboard"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/scale"
+	"github.com/edipermadi/music-db/pkg/theory/spelling"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFretboard(t *testing.T) {
+	for _, format := range illustations.AllFormats() {
+		t.Run(format.String(), func(t *testing.T) {
+			// generate image
+			names := spelling.Key(scale.Ionian, pitch.GNatural)
+			img, err := illustations.Fretboard(fretboard.Standard.Strings(), names, 12, illustations.Options{Format: format})
+			require.NoError(t, err)
+
+			// create temporary file
+			file, err := os.CreateTemp(os.TempDir(), "fretboard.*."+format.String())
+			require.NoError(t, err)
+			defer func() {
+				_ = file.Close()
+				_ = os.Remove(file.Name())
+			}()
+
+			// save image
+			require.NoError(t, img.Encode(file))
+		})
+	}
+}
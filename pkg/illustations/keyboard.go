@@ -1,145 +1,185 @@
 package illustations
 
 import (
-	"image"
-	"slices"
+	"fmt"
+	"math"
 
 	"github.com/edipermadi/music-db/pkg/theory/pitch"
-	"github.com/fogleman/gg"
+	"github.com/edipermadi/music-db/pkg/theory/spelling"
 )
 
-func Keyboard(pitches []pitch.Type) (image.Image, error) {
+// KeyboardRange is range of keyboard keys from the lowest to the highest note, the zero range fits the illustrated notes
+type KeyboardRange struct {
+	Low  pitch.Note
+	High pitch.Note
+}
+
+// KeyboardSize returns range of a common keyboard by its number of keys, either 25, 37, 49, 61, 76 or 88 keys
+func KeyboardSize(keys int) (KeyboardRange, bool) {
+	ranges := map[int]KeyboardRange{
+		25: {Low: pitch.NewNote(pitch.CNatural, 3), High: pitch.NewNote(pitch.CNatural, 5)},
+		37: {Low: pitch.NewNote(pitch.CNatural, 3), High: pitch.NewNote(pitch.CNatural, 6)},
+		49: {Low: pitch.NewNote(pitch.CNatural, 2), High: pitch.NewNote(pitch.CNatural, 6)},
+		61: {Low: pitch.NewNote(pitch.CNatural, 2), High: pitch.NewNote(pitch.CNatural, 7)},
+		76: {Low: pitch.NewNote(pitch.ENatural, 1), High: pitch.NewNote(pitch.GNatural, 7)},
+		88: {Low: pitch.NewNote(pitch.ANatural, 0), High: pitch.NewNote(pitch.CNatural, 8)},
+	}
+
+	r, found := ranges[keys]
+	return r, found
+}
+
+// Valid returns true when both ends are valid notes within MIDI note numbers and the lowest note is below the highest one
+func (r KeyboardRange) Valid() bool {
+	return r.Low.Valid() && r.High.Valid() && r.Low.Midi() >= 0 && r.High.Midi() <= 127 && r.Low.Midi() < r.High.Midi()
+}
+
+// Keys returns number of keys within the range
+func (r KeyboardRange) Keys() int {
+	if !r.Valid() {
+		return 0
+	}
+
+	return r.High.Midi() - r.Low.Midi() + 1
+}
+
+// whiteKeysBefore is number of white keys before each semitone of an octave
+var whiteKeysBefore = [...]int{0, 1, 1, 2, 2, 3, 4, 4, 5, 5, 6, 6}
+
+// isBlackKey returns true when MIDI note number is a black key
+func isBlackKey(number int) bool {
+	switch number % 12 {
+	case 1, 3, 6, 8, 10:
+		return true
+	default:
+		return false
+	}
+}
+
+// whiteKeys returns number of white keys below MIDI note number
+func whiteKeys(number int) int {
+	return 7*(number/12) + whiteKeysBefore[number%12]
+}
+
+// fit returns MIDI note numbers of the lowest and the highest key drawing given notes. The zero range is fitted to whole
+// octaves of the notes within MIDI note numbers, a single octave from C4 when there are none, and ends of other ranges
+// are widened to white keys.
+func (r KeyboardRange) fit(notes []pitch.Note) (int, int) {
+	if r.Valid() {
+		low, high := r.Low.Midi(), r.High.Midi()
+		if isBlackKey(low) {
+			low--
+		}
+
+		if isBlackKey(high) {
+			high++
+		}
+
+		return low, high
+	}
+
+	low, high := pitch.NewNote(pitch.CNatural, 4).Midi(), pitch.NewNote(pitch.BNatural, 4).Midi()
+	for i, v := range notes {
+		if i == 0 || v.Midi() < low {
+			low = v.Midi()
+		}
+
+		if i == 0 || v.Midi() > high {
+			high = v.Midi()
+		}
+	}
+
+	low, high = min(max(low, 0), 127), min(max(high, 0), 127)
+	return low - low%12, min(high-high%12+11, 127)
+}
+
+// Keyboard illustrates spelled pitches using keyboard, pitches are placed ascending from the first one in the fourth
+// octave, which is highlighted
+func Keyboard(names []spelling.Name, keys KeyboardRange, options Options) (Illustration, error) {
+	return KeyboardNotes(pitch.Slice(spelledPitches(names)).Notes(4), names, keys, options)
+}
+
+// KeyboardNotes illustrates notes using keyboard of given range, the first note is highlighted as tonic, root or bass.
+// Keys are labeled with given spelling of their pitch class, keys of pitches which are not spelled use sharps. Notes
+// beyond the range are not drawn.
+func KeyboardNotes(notes []pitch.Note, names []spelling.Name, keys KeyboardRange, options Options) (Illustration, error) {
 	// https://bootcamp.uxdesign.cc/drawing-a-flat-piano-keyboard-in-illustrator-de07c74a64c6
 
-	width := 450
-	height := 250
-	dc := gg.NewContext(width, height)
-	if err := dc.LoadFontFace("DroidSansFallback.ttf", 16); err != nil {
+	labels := pitchLabels(names, spelling.Default, options.Labels)
+	palette := options.palette()
+	t := options.theme()
+	legend := []legendEntry{
+		{Color: palette.Primary, Text: "Tonic, root or bass"},
+		{Color: palette.Secondary, Text: "Other pitches"},
+	}
+
+	low, high := keys.fit(notes)
+	whiteKeyWidth := 450.0 / 7
+	blackKeyWidth := whiteKeyWidth * 7 / 12
+	whiteKeyHeight := 250.0
+	blackKeyHeight := whiteKeyHeight * 0.6
+	borderWidth := 3.0
+
+	width := int(math.Ceil(float64(whiteKeys(high+1)-whiteKeys(low)) * 450 / 7))
+	height := int(whiteKeyHeight)
+	r, err := NewRenderer(options, width, height+options.legendHeight(legend), 16)
+	if err != nil {
 		return nil, err
 	}
 
-	displayed := make(map[pitch.Type]struct{})
-
-	// draw white keys
-	{
-		x := 0.0
-		y := 0.0
-		keyWidth := float64(width) / 7
-		keyHeight := float64(height)
-		padding := (x + keyWidth) / 2
-		borderWidth := 3.0
-		labels := []string{"C", "D", "E", "F", "G", "A", "B"}
-		keyPitches := []pitch.Type{pitch.CNatural, pitch.DNatural, pitch.ENatural, pitch.FNatural, pitch.GNatural, pitch.ANatural, pitch.BNatural}
-
-		for i := 0; i < 7; i++ {
-			// draw white key border
-			dc.DrawRectangle(x, y, keyWidth, keyHeight)
-			dc.SetRGB(0, 0, 0)
-			dc.Fill()
-
-			// draw white key
-			if i == 6 {
-				dc.DrawRectangle(x+borderWidth, y+borderWidth, keyWidth-(borderWidth*2), keyHeight-(borderWidth*2))
-			} else {
-				dc.DrawRectangle(x+borderWidth, y+borderWidth, keyWidth-borderWidth, keyHeight-(borderWidth*2))
-			}
-
-			dc.SetRGB(1, 1, 1)
-			dc.Fill()
-
-			// add label
-			dc.SetRGB(0, 0, 0)
-			dc.DrawStringAnchored(labels[i], x+padding, keyHeight-20, 0.5, 0.5)
-
-			// check matching pitch
-			keyPitch := keyPitches[i]
-			matchingPitch := slices.ContainsFunc(pitches, func(p pitch.Type) bool {
-				return keyPitch == p
-			})
-
-			// indicate if pitch is active
-			if matchingPitch {
-				if _, found := displayed[keyPitch]; !found {
-					dc.DrawCircle(x+padding, keyHeight-50, 10)
-					if keyPitch == pitches[0] {
-						dc.SetHexColor("#2196f3")
-					} else {
-						dc.SetHexColor("#f44336")
-					}
-					dc.Fill()
-
-					displayed[keyPitch] = struct{}{}
-				}
-			}
-
-			// increase for the next key
-			x += keyWidth
-		}
+	r.Clear(t.Background)
+
+	// highlighted keys, where the first note is primary
+	highlighted := make(map[int]string)
+	for i := len(notes) - 1; i >= 0; i-- {
+		highlighted[notes[i].Midi()] = palette.highlight(i == 0)
 	}
 
-	// draw black keys
-	{
-		x := 0.0
-		y := 0.0
-		keyWidth := float64(width) / 12
-		keyHeight := float64(height) * 0.6
-		padding := (x + keyWidth) / 2
-
-		displayMap := map[int]string{
-			1:  "C♯",
-			3:  "D♯",
-			6:  "F♯",
-			8:  "G♯",
-			10: "A♯",
+	// draw white keys, each key is drawn on its left border and the last key has its right border
+	for number := low; number <= high; number++ {
+		if isBlackKey(number) {
+			continue
+		}
+
+		note := pitch.NoteFromMidi(number)
+		x := float64(whiteKeys(number)-whiteKeys(low)) * whiteKeyWidth
+		padding := whiteKeyWidth / 2
+		r.Rectangle(x, 0, whiteKeyWidth, whiteKeyHeight, Style{Fill: t.Foreground})
+
+		innerWidth := whiteKeyWidth - borderWidth
+		if number == high {
+			innerWidth -= borderWidth
 		}
+		r.Rectangle(x+borderWidth, borderWidth, innerWidth, whiteKeyHeight-(borderWidth*2), Style{Fill: t.Surface})
 
-		keyPitches := map[int]pitch.Type{
-			1:  pitch.CSharp,
-			3:  pitch.DSharp,
-			6:  pitch.FSharp,
-			8:  pitch.GSharp,
-			10: pitch.ASharp,
+		// mark octaves on C keys
+		if note.Pitch == pitch.CNatural {
+			r.Text(fmt.Sprintf("C%d", note.Octave), x+padding, blackKeyHeight+20, 0.5, 0.5, t.Grid)
 		}
 
-		for i := 0; i < 12; i++ {
-			if label, draw := displayMap[i]; draw {
-				// draw black key
-				dc.DrawRectangle(x, y, keyWidth, keyHeight)
-				dc.SetRGB(0, 0, 0)
-				dc.Fill()
-
-				// add label
-				dc.SetRGB(1, 1, 1)
-				dc.DrawStringAnchored(label, x+padding, keyHeight-20, 0.5, 0.5)
-
-				// check matching pitch
-				keyPitch := keyPitches[i]
-				matchingPitch := slices.ContainsFunc(pitches, func(p pitch.Type) bool {
-					return keyPitch == p
-				})
-
-				// indicate if pitch is active
-				if matchingPitch {
-					if _, found := displayed[keyPitch]; !found {
-						dc.DrawCircle(x+padding, keyHeight-50, 10)
-						if keyPitch == pitches[0] {
-							dc.SetHexColor("#2196f3")
-						} else {
-							dc.SetHexColor("#f44336")
-						}
-						dc.Fill()
-
-						// mark as displayed
-						displayed[keyPitch] = struct{}{}
-					}
-				}
-
-			}
-
-			// increase for the next key
-			x += keyWidth
+		r.Text(labels[note.Pitch], x+padding, whiteKeyHeight-20, 0.5, 0.5, t.Foreground)
+		if color, found := highlighted[number]; found {
+			r.Circle(x+padding, whiteKeyHeight-50, 10, Style{Fill: color})
+		}
+	}
+
+	// draw black keys on a twelfth of an octave from C of their octave
+	for number := low; number <= high; number++ {
+		if !isBlackKey(number) {
+			continue
+		}
+
+		note := pitch.NoteFromMidi(number)
+		x := float64(whiteKeys(number-number%12)-whiteKeys(low))*whiteKeyWidth + float64(number%12)*blackKeyWidth
+		padding := blackKeyWidth / 2
+		r.Rectangle(x, 0, blackKeyWidth, blackKeyHeight, Style{Fill: "#000000"})
+
+		r.Text(labels[note.Pitch], x+padding, blackKeyHeight-20, 0.5, 0.5, "#ffffff")
+		if color, found := highlighted[number]; found {
+			r.Circle(x+padding, blackKeyHeight-50, 10, Style{Fill: color})
 		}
 	}
 
-	return dc.Image(), nil
+	options.drawLegend(r, legend, float64(height))
+	return r, nil
 }
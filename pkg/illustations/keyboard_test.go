@@ -1,49 +1,106 @@
 package illustations_test
 
 import (
-	"image/png"
+	"bytes"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/edipermadi/music-db/pkg/illustations"
 	"github.com/edipermadi/music-db/pkg/theory/chord"
 	"github.com/edipermadi/music-db/pkg/theory/pitch"
 	"github.com/edipermadi/music-db/pkg/theory/scale"
+	"github.com/edipermadi/music-db/pkg/theory/spelling"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func TestKeyboard_Scale(t *testing.T) {
-	// generate image
-	pitches := scale.Ionian.Pitches(pitch.FNatural)
-	img, err := illustations.Keyboard(pitches)
-	require.NoError(t, err)
-
-	// create temporary file
-	file, err := os.CreateTemp(os.TempDir(), "keyboard-scale.*.png")
-	require.NoError(t, err)
-	defer func() {
-		_ = file.Close()
-		_ = os.Remove(file.Name())
-	}()
-
-	// save image as png
-	require.NoError(t, png.Encode(file, img))
+	for _, format := range illustations.AllFormats() {
+		t.Run(format.String(), func(t *testing.T) {
+			// generate image
+			names := spelling.Key(scale.Ionian, pitch.FNatural)
+			img, err := illustations.Keyboard(names, illustations.KeyboardRange{}, illustations.Options{Format: format})
+			require.NoError(t, err)
+
+			// create temporary file
+			file, err := os.CreateTemp(os.TempDir(), "keyboard-scale.*."+format.String())
+			require.NoError(t, err)
+			defer func() {
+				_ = file.Close()
+				_ = os.Remove(file.Name())
+			}()
+
+			// save image
+			require.NoError(t, img.Encode(file))
+		})
+	}
 }
 
 func TestKeyboard_Chord(t *testing.T) {
-	// generate image
-	pitches := chord.Major.Pitches(pitch.CNatural)
-	img, err := illustations.Keyboard(pitches)
-	require.NoError(t, err)
-
-	// create temporary file
-	file, err := os.CreateTemp(os.TempDir(), "keyboard-chord.*.png")
-	require.NoError(t, err)
-	defer func() {
-		_ = file.Close()
-		_ = os.Remove(file.Name())
-	}()
-
-	// save image as png
-	require.NoError(t, png.Encode(file, img))
+	for _, format := range illustations.AllFormats() {
+		t.Run(format.String(), func(t *testing.T) {
+			// generate image
+			names := spelling.Chord(chord.Major, pitch.CNatural)
+			img, err := illustations.Keyboard(names, illustations.KeyboardRange{}, illustations.Options{Format: format})
+			require.NoError(t, err)
+
+			// create temporary file
+			file, err := os.CreateTemp(os.TempDir(), "keyboard-chord.*."+format.String())
+			require.NoError(t, err)
+			defer func() {
+				_ = file.Close()
+				_ = os.Remove(file.Name())
+			}()
+
+			// save image
+			require.NoError(t, img.Encode(file))
+		})
+	}
+}
+
+func TestKeyboardSize(t *testing.T) {
+	for _, keys := range []int{25, 37, 49, 61, 76, 88} {
+		r, ok := illustations.KeyboardSize(keys)
+		require.True(t, ok)
+		assert.Equal(t, keys, r.Keys())
+	}
+
+	_, ok := illustations.KeyboardSize(64)
+	assert.False(t, ok)
+}
+
+func TestKeyboardNotes_Voicing(t *testing.T) {
+	// first inversion of C major spread across two octaves
+	names := spelling.Chord(chord.Major, pitch.CNatural)
+	notes := []pitch.Note{
+		pitch.NewNote(pitch.ENatural, 3),
+		pitch.NewNote(pitch.CNatural, 4),
+		pitch.NewNote(pitch.GNatural, 4),
+	}
+
+	svg := func(keys illustations.KeyboardRange) string {
+		img, err := illustations.KeyboardNotes(notes, names, keys, illustations.Options{Format: illustations.SVG})
+		require.NoError(t, err)
+
+		var buffer bytes.Buffer
+		require.NoError(t, img.Encode(&buffer))
+		return buffer.String()
+	}
+
+	// fitted to whole octaves, where only the bass is the primary highlight
+	fitted := svg(illustations.KeyboardRange{})
+	assert.Contains(t, fitted, `viewBox="0 0 900 250"`)
+	assert.Contains(t, fitted, ">C3<")
+	assert.Contains(t, fitted, ">C4<")
+	assert.NotContains(t, fitted, ">C5<")
+	assert.Equal(t, 1, strings.Count(fitted, `fill="#2196f3"`))
+	assert.Equal(t, 2, strings.Count(fitted, `fill="#f44336"`))
+
+	// ends of the range on black keys are widened to white keys, notes beyond the range are not drawn
+	keys := illustations.KeyboardRange{Low: pitch.NewNote(pitch.CSharp, 4), High: pitch.NewNote(pitch.FSharp, 4)}
+	ranged := svg(keys)
+	assert.Contains(t, ranged, `viewBox="0 0 322 250"`)
+	assert.NotContains(t, ranged, `fill="#2196f3"`)
+	assert.Equal(t, 2, strings.Count(ranged, `fill="#f44336"`))
 }
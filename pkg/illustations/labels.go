@@ -0,0 +1,149 @@
+package illustations
+
+import (
+	"strconv"
+
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/spelling"
+)
+
+// LabelStyle is a type for pitch labels of illustrations
+type LabelStyle int
+
+// Label style enumerations
+const (
+	InvalidLabelStyle LabelStyle = iota
+	SpelledLabels     LabelStyle = iota
+	SharpLabels       LabelStyle = iota
+	FlatLabels        LabelStyle = iota
+	NumberLabels      LabelStyle = iota
+	SolfegeLabels     LabelStyle = iota
+	DegreeLabels      LabelStyle = iota
+)
+
+// AllLabelStyles returns all label styles
+func AllLabelStyles() []LabelStyle {
+	return []LabelStyle{
+		SpelledLabels,
+		SharpLabels,
+		FlatLabels,
+		NumberLabels,
+		SolfegeLabels,
+		DegreeLabels,
+	}
+}
+
+// String returns label style name
+func (s LabelStyle) String() string {
+	if s < SpelledLabels || s > DegreeLabels {
+		return "Invalid"
+	}
+
+	return [...]string{
+		"Invalid",
+		"Spelled",
+		"Sharps",
+		"Flats",
+		"Numbers",
+		"Solfège",
+		"Degrees",
+	}[s]
+}
+
+// Identifier returns label style identifier
+func (s LabelStyle) Identifier() string {
+	if s < SpelledLabels || s > DegreeLabels {
+		return "invalid"
+	}
+
+	return [...]string{
+		"invalid",
+		"spelled",
+		"sharps",
+		"flats",
+		"numbers",
+		"solfege",
+		"degrees",
+	}[s]
+}
+
+// LabelStyleFromString returns label style from its name or identifier
+func LabelStyleFromString(name string) LabelStyle {
+	for _, v := range AllLabelStyles() {
+		if v.String() == name || v.Identifier() == name {
+			return v
+		}
+	}
+
+	return InvalidLabelStyle
+}
+
+var solfegeSyllables = [...]string{"", "Do", "Re", "Mi", "Fa", "Sol", "La", "Si"}
+
+var majorDegrees = [...]int{0, 2, 4, 5, 7, 9, 11}
+
+var chromaticDegrees = [...]string{"1", "♭2", "2", "♭3", "3", "4", "♯4", "5", "♭6", "6", "♭7", "7"}
+
+// pitchLabels returns labels of all pitch classes in given style, spelled pitches are labeled by their spelling and
+// the rest by given default spelling. Degrees are relative to the first spelled pitch, or to C when there is none.
+func pitchLabels(names []spelling.Name, defaults func(pitch.Type) spelling.Name, style LabelStyle) map[pitch.Type]string {
+	spelled := make(map[pitch.Type]spelling.Name)
+	for _, v := range names {
+		spelled[v.Pitch()] = v
+	}
+
+	tonic := spelling.Name{Letter: spelling.C}
+	if len(names) > 0 {
+		tonic = names[0]
+	}
+
+	labels := make(map[pitch.Type]string)
+	for _, p := range pitch.AllPitches() {
+		name, isSpelled := spelled[p]
+		if !isSpelled {
+			name = defaults(p)
+		}
+
+		switch style {
+		case SharpLabels:
+			labels[p] = spelling.Default(p).String()
+		case FlatLabels:
+			labels[p] = flatSpelling(p).String()
+		case NumberLabels:
+			labels[p] = strconv.Itoa(int(p - pitch.CNatural))
+		case SolfegeLabels:
+			labels[p] = solfegeSyllables[name.Letter] + name.Accidental.String()
+		case DegreeLabels:
+			labels[p] = degree(tonic, name, isSpelled)
+		default:
+			labels[p] = name.String()
+		}
+	}
+
+	return labels
+}
+
+// flatSpelling returns spelling of pitch using flats for black keys
+func flatSpelling(p pitch.Type) spelling.Name {
+	name := spelling.Default(p)
+	if name.Accidental == spelling.Sharp {
+		return spelling.Name{Letter: name.Letter.Next(1), Accidental: spelling.Flat}
+	}
+
+	return name
+}
+
+// degree returns scale degree of a spelled pitch from its letter, such as "♭3", unspelled pitches and degrees needing
+// more than double accidentals are named after their semitones from the tonic
+func degree(tonic, name spelling.Name, spelled bool) string {
+	semitones := (int(name.Pitch()-tonic.Pitch())%12 + 12) % 12
+	if spelled {
+		steps := (int(name.Letter-tonic.Letter)%7 + 7) % 7
+		alteration := (semitones-majorDegrees[steps]+18)%12 - 6
+		if alteration >= int(spelling.DoubleFlat) && alteration <= int(spelling.DoubleSharp) {
+			return spelling.Accidental(alteration).String() + strconv.Itoa(steps+1)
+		}
+	}
+
+	return chromaticDegrees[semitones]
+}
@@ -0,0 +1,33 @@
+package illustations
+
+// legendRowHeight is height of a legend entry
+const legendRowHeight = 32.0
+
+// legendEntry is a highlight color with its meaning
+type legendEntry struct {
+	Color string
+	Text  string
+}
+
+// legendHeight returns height of legend below an illustration, which is zero when legend is hidden
+func (o Options) legendHeight(entries []legendEntry) int {
+	if !o.Legend {
+		return 0
+	}
+
+	return int(float64(len(entries))*legendRowHeight + legendRowHeight/2)
+}
+
+// drawLegend lists highlight colors with their meaning from given top, when legend is shown
+func (o Options) drawLegend(r Renderer, entries []legendEntry, top float64) {
+	if !o.Legend {
+		return
+	}
+
+	t := o.theme()
+	for i, v := range entries {
+		y := top + (float64(i)+0.5)*legendRowHeight
+		r.Circle(30, y, 10, Style{Fill: v.Color, Stroke: t.Foreground, LineWidth: 1})
+		r.Text(v.Text, 50, y, 0, 0.5, t.Foreground)
+	}
+}
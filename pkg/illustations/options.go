@@ -0,0 +1,116 @@
+package illustations
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Options are options of illustrations, zero values other than format are defaults where illustrations are drawn in
+// their natural size, highlighted with material palette in light mode, labeled with spelled pitches and without legend
+type Options struct {
+	Format  Format
+	Width   int
+	Height  int
+	Palette Palette
+	Dark    bool
+	Labels  LabelStyle
+	Legend  bool
+}
+
+// palette returns highlight colors of the options
+func (o Options) palette() Palette {
+	if o.Palette == (Palette{}) {
+		return MaterialPalette
+	}
+
+	return o.Palette
+}
+
+// theme returns colors other than highlights of the options
+func (o Options) theme() theme {
+	if o.Dark {
+		return darkTheme
+	}
+
+	return lightTheme
+}
+
+// Palette is highlight colors of illustrations as hex triplets. The primary color highlights the first pitch, such as
+// tonic, root or bass, and the secondary color highlights the rest. Bracelet diagrams use all colors to tell whether
+// highlighted pitches have adjacent fifths.
+type Palette struct {
+	Primary    string
+	Secondary  string
+	Tertiary   string
+	Quaternary string
+}
+
+// Named palettes
+var (
+	MaterialPalette   = Palette{Primary: "#2196f3", Secondary: "#f44336", Tertiary: "#4caf50", Quaternary: "#ff9800"}
+	ColorBlindPalette = Palette{Primary: "#0072b2", Secondary: "#d55e00", Tertiary: "#009e73", Quaternary: "#e69f00"}
+)
+
+var hexTriplet = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// PaletteFromString returns palette by its name, either "material" or "color_blind", or from four comma separated
+// hex triplets such as "#2196f3,#f44336,#4caf50,#ff9800"
+func PaletteFromString(s string) (Palette, bool) {
+	switch s {
+	case "material":
+		return MaterialPalette, true
+	case "color_blind":
+		return ColorBlindPalette, true
+	}
+
+	colors := strings.Split(s, ",")
+	if len(colors) != 4 {
+		return Palette{}, false
+	}
+
+	for i, v := range colors {
+		colors[i] = strings.ToLower(strings.TrimSpace(v))
+		if !hexTriplet.MatchString(colors[i]) {
+			return Palette{}, false
+		}
+	}
+
+	return Palette{Primary: colors[0], Secondary: colors[1], Tertiary: colors[2], Quaternary: colors[3]}, true
+}
+
+// highlight returns fill color of highlighted pitches, where the first pitch such as tonic, root or bass is primary and
+// the rest are secondary
+func (p Palette) highlight(first bool) string {
+	if first {
+		return p.Primary
+	}
+
+	return p.Secondary
+}
+
+// theme is colors of illustrations other than highlights
+type theme struct {
+	Background string // canvas
+	Foreground string // outlines and texts
+	Surface    string // unhighlighted shapes, such as pitch nodes and white keys
+	Grid       string // secondary lines, such as lattice edges
+}
+
+var (
+	lightTheme = theme{Background: "#ffffff", Foreground: "#000000", Surface: "#ffffff", Grid: "#9e9e9e"}
+	darkTheme  = theme{Background: "#121212", Foreground: "#e0e0e0", Surface: "#2c2c2c", Grid: "#616161"}
+)
+
+// mix blends two hex triplets, where the given weight of the first color is between 0 and 1
+func mix(first, second string, weight float64) string {
+	var r1, g1, b1, r2, g2, b2 int
+	_, _ = fmt.Sscanf(first, "#%02x%02x%02x", &r1, &g1, &b1)
+	_, _ = fmt.Sscanf(second, "#%02x%02x%02x", &r2, &g2, &b2)
+
+	blend := func(a, b int) int {
+		return int(float64(a)*weight + float64(b)*(1-weight) + 0.5)
+	}
+
+	return fmt.Sprintf("#%02x%02x%02x", blend(r1, r2), blend(g1, g2), blend(b1, b2))
+}
@@ -0,0 +1,102 @@
+package illustations_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/illustations"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/scale"
+	"github.com/edipermadi/music-db/pkg/theory/spelling"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaletteFromString(t *testing.T) {
+	palette, ok := illustations.PaletteFromString("color_blind")
+	require.True(t, ok)
+	assert.Equal(t, illustations.ColorBlindPalette, palette)
+
+	palette, ok = illustations.PaletteFromString("#E91E63, #3f51b5,#009688,#ffc107")
+	require.True(t, ok)
+	assert.Equal(t, illustations.Palette{Primary: "#e91e63", Secondary: "#3f51b5", Tertiary: "#009688", Quaternary: "#ffc107"}, palette)
+
+	for _, v := range []string{"", "neon", "#e91e63,#3f51b5,#009688", "#e91e63,#3f51b5,#009688,red"} {
+		_, ok = illustations.PaletteFromString(v)
+		assert.False(t, ok, v)
+	}
+}
+
+func TestLabelStyleFromString(t *testing.T) {
+	for _, v := range illustations.AllLabelStyles() {
+		assert.Equal(t, v, illustations.LabelStyleFromString(v.String()))
+		assert.Equal(t, v, illustations.LabelStyleFromString(v.Identifier()))
+	}
+
+	assert.Equal(t, illustations.InvalidLabelStyle, illustations.LabelStyleFromString("roman"))
+}
+
+// keyboardSVG draws F major on an octave of keyboard from F4 as svg
+func keyboardSVG(t *testing.T, options illustations.Options) string {
+	options.Format = illustations.SVG
+	keys := illustations.KeyboardRange{Low: pitch.NewNote(pitch.FNatural, 4), High: pitch.NewNote(pitch.ENatural, 5)}
+	img, err := illustations.Keyboard(spelling.Key(scale.Ionian, pitch.FNatural), keys, options)
+	require.NoError(t, err)
+
+	var buffer bytes.Buffer
+	require.NoError(t, img.Encode(&buffer))
+	return buffer.String()
+}
+
+func TestOptions_Labels(t *testing.T) {
+	type testCase struct {
+		Style    illustations.LabelStyle
+		Expected []string
+	}
+
+	testCases := []testCase{
+		{Style: illustations.SpelledLabels, Expected: []string{">B♭<", ">C♯<"}},
+		{Style: illustations.SharpLabels, Expected: []string{">A♯<", ">C♯<"}},
+		{Style: illustations.FlatLabels, Expected: []string{">B♭<", ">D♭<"}},
+		{Style: illustations.NumberLabels, Expected: []string{">0<", ">10<", ">11<"}},
+		{Style: illustations.SolfegeLabels, Expected: []string{">Fa<", ">Si♭<", ">Do♯<"}},
+		{Style: illustations.DegreeLabels, Expected: []string{">1<", ">4<", ">7<", ">♭6<"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Style.String(), func(t *testing.T) {
+			svg := keyboardSVG(t, illustations.Options{Labels: tc.Style})
+			for _, v := range tc.Expected {
+				assert.Contains(t, svg, v)
+			}
+		})
+	}
+}
+
+func TestOptions_Size(t *testing.T) {
+	svg := keyboardSVG(t, illustations.Options{Width: 900})
+	assert.Contains(t, svg, `width="900" height="500" viewBox="0 0 450 250"`)
+
+	svg = keyboardSVG(t, illustations.Options{Width: 900, Height: 250})
+	assert.Contains(t, svg, `width="900" height="250" viewBox="-225 0 900 250"`)
+}
+
+func TestOptions_Theme(t *testing.T) {
+	svg := keyboardSVG(t, illustations.Options{})
+	assert.Contains(t, svg, `fill="#2196f3"`)
+	assert.NotContains(t, svg, `fill="#121212"`)
+
+	svg = keyboardSVG(t, illustations.Options{Palette: illustations.ColorBlindPalette, Dark: true})
+	assert.Contains(t, svg, `fill="#0072b2"`)
+	assert.Contains(t, svg, `fill="#121212"`)
+	assert.NotContains(t, svg, `fill="#2196f3"`)
+}
+
+func TestOptions_Legend(t *testing.T) {
+	svg := keyboardSVG(t, illustations.Options{})
+	assert.NotContains(t, svg, "Tonic, root or bass")
+
+	svg = keyboardSVG(t, illustations.Options{Legend: true})
+	assert.Contains(t, svg, `height="330"`)
+	assert.Contains(t, svg, "Tonic, root or bass")
+}
@@ -0,0 +1,104 @@
+package illustations
+
+import (
+	"errors"
+	"io"
+)
+
+// ErrUnsupportedFormat is returned when illustration format is not supported
+var ErrUnsupportedFormat = errors.New("unsupported illustration format")
+
+// Illustration is a drawn illustration which is encoded in its format
+type Illustration interface {
+	Format() Format
+	Encode(writer io.Writer) error
+}
+
+// Point is a position on an illustration, from the top left corner
+type Point struct {
+	X float64
+	Y float64
+}
+
+// Style is fill and outline of a shape, where colors are hex triplets such as "#2196f3" and empty colors are not drawn
+type Style struct {
+	Fill      string
+	Stroke    string
+	LineWidth float64
+}
+
+// Renderer draws shapes and text on an illustration, illustrations are drawn once through a renderer and encoded
+// by the renderer of the requested format
+type Renderer interface {
+	Illustration
+
+	// Clear fills the whole illustration with given color
+	Clear(color string)
+
+	// Rectangle draws a rectangle from its top left corner
+	Rectangle(x, y, width, height float64, style Style)
+
+	// Circle draws a circle around its center
+	Circle(x, y, radius float64, style Style)
+
+	// Polygon draws a closed shape through given points
+	Polygon(points []Point, style Style)
+
+	// Polyline draws an open line through given points, only its stroke is drawn
+	Polyline(points []Point, style Style)
+
+	// Text draws text anchored at given point, where anchors are fractions of text width and height such as 0.5 for
+	// the center
+	Text(text string, x, y, ax, ay float64, color string)
+}
+
+// NewRenderer returns renderer of given options for an illustration drawn in given natural size in pixels, texts are
+// drawn in given font size in points. The illustration is scaled to fit width and height of the options and centered,
+// when only one of them is given the other one keeps the natural aspect ratio.
+func NewRenderer(options Options, width, height int, fontSize float64) (Renderer, error) {
+	v := newViewport(options, width, height)
+	switch options.Format {
+	case PNG:
+		return newPNGRenderer(v, fontSize)
+	case SVG:
+		return newSVGRenderer(v, fontSize), nil
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+// viewport maps an illustration drawn in its natural size onto its canvas
+type viewport struct {
+	Width   int
+	Height  int
+	Scale   float64
+	OffsetX float64
+	OffsetY float64
+}
+
+func newViewport(options Options, width, height int) viewport {
+	canvasWidth := max(options.Width, 0)
+	canvasHeight := max(options.Height, 0)
+	switch {
+	case canvasWidth == 0 && canvasHeight == 0:
+		return viewport{Width: width, Height: height, Scale: 1}
+	case canvasHeight == 0:
+		canvasHeight = max(canvasWidth*height/width, 1)
+	case canvasWidth == 0:
+		canvasWidth = max(canvasHeight*width/height, 1)
+	}
+
+	scale := min(float64(canvasWidth)/float64(width), float64(canvasHeight)/float64(height))
+	return viewport{
+		Width:   canvasWidth,
+		Height:  canvasHeight,
+		Scale:   scale,
+		OffsetX: (float64(canvasWidth) - float64(width)*scale) / 2,
+		OffsetY: (float64(canvasHeight) - float64(height)*scale) / 2,
+	}
+}
+
+// line returns points of a straight line
+func line(x1, y1, x2, y2 float64) []Point {
+	return []Point{{X: x1, Y: y1}, {X: x2, Y: y2}}
+}
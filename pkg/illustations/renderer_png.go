@@ -0,0 +1,105 @@
+package illustations
+
+import (
+	"image/png"
+	"io"
+
+	"github.com/fogleman/gg"
+)
+
+// pngRenderer rasterizes illustrations using gg, shapes are scaled by the context transformation while line widths and
+// texts are scaled by the renderer
+type pngRenderer struct {
+	dc    *gg.Context
+	scale float64
+}
+
+func newPNGRenderer(v viewport, fontSize float64) (*pngRenderer, error) {
+	face, err := faces.Face(fontSize * v.Scale)
+	if err != nil {
+		return nil, err
+	}
+
+	dc := gg.NewContext(v.Width, v.Height)
+	dc.SetFontFace(face)
+	dc.Translate(v.OffsetX, v.OffsetY)
+	dc.Scale(v.Scale, v.Scale)
+	return &pngRenderer{dc: dc, scale: v.Scale}, nil
+}
+
+func (r *pngRenderer) Format() Format {
+	return PNG
+}
+
+func (r *pngRenderer) Encode(writer io.Writer) error {
+	return png.Encode(writer, r.dc.Image())
+}
+
+func (r *pngRenderer) Clear(color string) {
+	r.dc.SetHexColor(color)
+	r.dc.Clear()
+}
+
+func (r *pngRenderer) Rectangle(x, y, width, height float64, style Style) {
+	r.dc.DrawRectangle(x, y, width, height)
+	r.paint(style)
+}
+
+func (r *pngRenderer) Circle(x, y, radius float64, style Style) {
+	r.dc.DrawCircle(x, y, radius)
+	r.paint(style)
+}
+
+func (r *pngRenderer) Polygon(points []Point, style Style) {
+	r.path(points)
+	r.dc.ClosePath()
+	r.paint(style)
+}
+
+func (r *pngRenderer) Polyline(points []Point, style Style) {
+	r.path(points)
+	r.paint(Style{Stroke: style.Stroke, LineWidth: style.LineWidth})
+}
+
+// Text anchors text on the canvas, since anchors are measured in font size of the canvas
+func (r *pngRenderer) Text(text string, x, y, ax, ay float64, color string) {
+	x, y = r.dc.TransformPoint(x, y)
+
+	r.dc.Push()
+	defer r.dc.Pop()
+
+	r.dc.Identity()
+	r.dc.SetHexColor(color)
+	r.dc.DrawStringAnchored(text, x, y, ax, ay)
+}
+
+func (r *pngRenderer) path(points []Point) {
+	r.dc.NewSubPath()
+	for i, v := range points {
+		if i == 0 {
+			r.dc.MoveTo(v.X, v.Y)
+		} else {
+			r.dc.LineTo(v.X, v.Y)
+		}
+	}
+}
+
+// paint fills and strokes current path, the path is cleared afterwards
+func (r *pngRenderer) paint(style Style) {
+	if style.Fill != "" {
+		r.dc.SetHexColor(style.Fill)
+		if style.Stroke != "" {
+			r.dc.FillPreserve()
+		} else {
+			r.dc.Fill()
+		}
+	}
+
+	if style.Stroke != "" {
+		r.dc.SetHexColor(style.Stroke)
+		r.dc.SetLineWidth(style.LineWidth * r.scale)
+		r.dc.Stroke()
+	}
+
+	r.dc.ClearPath()
+}
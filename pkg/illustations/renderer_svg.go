@@ -0,0 +1,115 @@
+package illustations
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strings"
+)
+
+// svgRenderer writes illustrations as scalable vector graphics, texts are left to the viewer font
+type svgRenderer struct {
+	viewport viewport
+	fontSize float64
+	elements strings.Builder
+}
+
+func newSVGRenderer(v viewport, fontSize float64) *svgRenderer {
+	return &svgRenderer{viewport: v, fontSize: fontSize}
+}
+
+func (r *svgRenderer) Format() Format {
+	return SVG
+}
+
+// Encode writes illustration where the view box covers the whole canvas in natural coordinates, so that the viewer
+// scales the illustration
+func (r *svgRenderer) Encode(writer io.Writer) error {
+	x, y, width, height := r.viewBox()
+	_, err := fmt.Fprintf(writer,
+		"<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\" viewBox=\"%s %s %s %s\" font-family=\"sans-serif\" font-size=\"%s\">\n%s</svg>\n",
+		r.viewport.Width, r.viewport.Height, number(x), number(y), number(width), number(height), number(r.fontSize), r.elements.String())
+	return err
+}
+
+func (r *svgRenderer) Clear(color string) {
+	r.elements.Reset()
+	x, y, width, height := r.viewBox()
+	r.Rectangle(x, y, width, height, Style{Fill: color})
+}
+
+func (r *svgRenderer) viewBox() (float64, float64, float64, float64) {
+	v := r.viewport
+	return -v.OffsetX / v.Scale, -v.OffsetY / v.Scale, float64(v.Width) / v.Scale, float64(v.Height) / v.Scale
+}
+
+func (r *svgRenderer) Rectangle(x, y, width, height float64, style Style) {
+	r.element("<rect x=\"%s\" y=\"%s\" width=\"%s\" height=\"%s\"%s/>", number(x), number(y), number(width), number(height), attributes(style))
+}
+
+func (r *svgRenderer) Circle(x, y, radius float64, style Style) {
+	r.element("<circle cx=\"%s\" cy=\"%s\" r=\"%s\"%s/>", number(x), number(y), number(radius), attributes(style))
+}
+
+func (r *svgRenderer) Polygon(points []Point, style Style) {
+	r.element("<polygon points=\"%s\"%s/>", pointList(points), attributes(style))
+}
+
+func (r *svgRenderer) Polyline(points []Point, style Style) {
+	r.element("<polyline points=\"%s\"%s/>", pointList(points), attributes(Style{Stroke: style.Stroke, LineWidth: style.LineWidth}))
+}
+
+// Text places text by its anchors, the baseline is moved down by the anchored fraction of text height, which is three
+// quarters of font size as measured when rasterizing
+func (r *svgRenderer) Text(text string, x, y, ax, ay float64, color string) {
+	anchor := "middle"
+	switch {
+	case ax < 0.25:
+		anchor = "start"
+	case ax > 0.75:
+		anchor = "end"
+	}
+
+	baseline := y + ay*r.fontSize*0.75
+	r.element("<text x=\"%s\" y=\"%s\" text-anchor=\"%s\" fill=\"%s\">%s</text>", number(x), number(baseline), anchor, color, html.EscapeString(text))
+}
+
+func (r *svgRenderer) element(format string, args ...interface{}) {
+	_, _ = fmt.Fprintf(&r.elements, format, args...)
+	r.elements.WriteByte('\n')
+}
+
+// attributes returns presentation attributes of a style, shapes without fill are left transparent
+func attributes(style Style) string {
+	fill := style.Fill
+	if fill == "" {
+		fill = "none"
+	}
+
+	s := fmt.Sprintf(" fill=\"%s\"", fill)
+	if style.Stroke != "" {
+		s += fmt.Sprintf(" stroke=\"%s\" stroke-width=\"%s\"", style.Stroke, number(style.LineWidth))
+	}
+
+	return s
+}
+
+func pointList(points []Point) string {
+	entries := make([]string, 0)
+	for _, v := range points {
+		entries = append(entries, number(v.X)+","+number(v.Y))
+	}
+
+	return strings.Join(entries, " ")
+}
+
+// number formats coordinates with at most two decimals
+func number(v float64) string {
+	s := strings.TrimRight(fmt.Sprintf("%.2f", v), "0")
+	s = strings.TrimSuffix(s, ".")
+	if s == "-0" {
+		return "0"
+	}
+
+	return s
+}
@@ -0,0 +1,47 @@
+package illustations_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/illustations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatFromString(t *testing.T) {
+	for _, v := range illustations.AllFormats() {
+		assert.Equal(t, v, illustations.FormatFromString(v.String()))
+		assert.Equal(t, v, illustations.FormatFromString(v.ContentType()))
+	}
+
+	assert.Equal(t, illustations.InvalidFormat, illustations.FormatFromString("gif"))
+	assert.Equal(t, "invalid", illustations.InvalidFormat.String())
+	assert.Empty(t, illustations.InvalidFormat.ContentType())
+}
+
+func TestNewRenderer(t *testing.T) {
+	_, err := illustations.NewRenderer(illustations.Options{}, 100, 100, 16)
+	require.ErrorIs(t, err, illustations.ErrUnsupportedFormat)
+
+	r, err := illustations.NewRenderer(illustations.Options{Format: illustations.SVG}, 100, 50, 16)
+	require.NoError(t, err)
+	require.Equal(t, illustations.SVG, r.Format())
+
+	r.Clear("#ffffff")
+	r.Rectangle(10, 10, 20, 20, illustations.Style{Fill: "#000000"})
+	r.Circle(50, 25, 10.5, illustations.Style{Stroke: "#f44336", LineWidth: 2})
+	r.Polyline([]illustations.Point{{X: 0, Y: 0}, {X: 100, Y: 50}}, illustations.Style{Stroke: "#9e9e9e", LineWidth: 1})
+	r.Text("C<D", 50, 25, 0.5, 0.5, "#000000")
+
+	var buffer bytes.Buffer
+	require.NoError(t, r.Encode(&buffer))
+
+	svg := buffer.String()
+	assert.Contains(t, svg, `<svg xmlns="http://www.w3.org/2000/svg" width="100" height="50"`)
+	assert.Contains(t, svg, `<rect x="0" y="0" width="100" height="50" fill="#ffffff"/>`)
+	assert.Contains(t, svg, `<rect x="10" y="10" width="20" height="20" fill="#000000"/>`)
+	assert.Contains(t, svg, `<circle cx="50" cy="25" r="10.5" fill="none" stroke="#f44336" stroke-width="2"/>`)
+	assert.Contains(t, svg, `<polyline points="0,0 100,50" fill="none" stroke="#9e9e9e" stroke-width="1"/>`)
+	assert.Contains(t, svg, `<text x="50" y="31" text-anchor="middle" fill="#000000">C&lt;D</text>`)
+}
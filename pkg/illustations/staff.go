@@ -0,0 +1,344 @@
+package illustations
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/spelling"
+)
+
+// ErrUnsupportedStaff is returned when notes are engraved on an invalid staff type
+var ErrUnsupportedStaff = errors.New("unsupported staff")
+
+// StaffType is a type for staff of staff notation
+type StaffType int
+
+// Staff type enumerations
+const (
+	InvalidStaffType StaffType = iota
+	TrebleStaff      StaffType = iota
+	BassStaff        StaffType = iota
+	GrandStaff       StaffType = iota
+)
+
+// AllStaffTypes returns all staff types
+func AllStaffTypes() []StaffType {
+	return []StaffType{
+		TrebleStaff,
+		BassStaff,
+		GrandStaff,
+	}
+}
+
+// String returns staff type name
+func (s StaffType) String() string {
+	if s < TrebleStaff || s > GrandStaff {
+		return "Invalid"
+	}
+
+	return [...]string{
+		"Invalid",
+		"Treble",
+		"Bass",
+		"Grand",
+	}[s]
+}
+
+// Identifier returns staff type identifier
+func (s StaffType) Identifier() string {
+	if s < TrebleStaff || s > GrandStaff {
+		return "invalid"
+	}
+
+	return [...]string{
+		"invalid",
+		"treble",
+		"bass",
+		"grand",
+	}[s]
+}
+
+// StaffTypeFromString returns staff type from its name or identifier
+func StaffTypeFromString(name string) StaffType {
+	for _, v := range AllStaffTypes() {
+		if v.String() == name || v.Identifier() == name {
+			return v
+		}
+	}
+
+	return InvalidStaffType
+}
+
+// stave is five staff lines with a treble or bass clef, staff positions are diatonic steps counted from C0
+type stave struct {
+	Clef   StaffType
+	Bottom int
+	Y      float64
+}
+
+// y returns height of staff position on the stave
+func (s stave) y(step int) float64 {
+	return s.Y - float64(step-s.Bottom)*staffSpace/2
+}
+
+// signatureSteps returns staff positions of key signature accidentals on treble staff, in the order they are written
+func signatureSteps(signature spelling.Signature) []int {
+	if signature > 0 {
+		return []int{38, 35, 39, 36, 33, 37, 34}
+	}
+
+	return []int{34, 37, 33, 36, 32, 35, 31}
+}
+
+// engravedNote is a spelled note on a stave, noteheads of seconds are shifted to the right and accidentals are placed
+// in columns counted away from the noteheads
+type engravedNote struct {
+	Name       spelling.Name
+	Step       int
+	Stave      int
+	Color      string
+	Label      string
+	Accidental bool
+	Shifted    bool
+	Column     int
+}
+
+// writtenStep returns staff position of spelled note, the octave follows the letter so B♯3 is written below C4
+func writtenStep(note pitch.Note, name spelling.Name) int {
+	octave := int(math.Floor(float64(note.Midi()-name.Letter.Semitones()-int(name.Accidental))/12)) - 1
+	return octave*7 + int(name.Letter-spelling.C)
+}
+
+// Staff engraves whole notes on staff of given type with key signature. Notes of a column are sounded together, such
+// as a chord, while columns follow one another. Notes are spelled with given names of their pitch class, the others
+// use sharps, and accidentals hold through the illustration as a single measure. The first note of the first column is
+// highlighted as tonic, root or bass. Notes from middle C are written on the treble staff of grand staff.
+func Staff(columns [][]pitch.Note, names []spelling.Name, staff StaffType, signature spelling.Signature, options Options) (Illustration, error) {
+	staves := make([]stave, 0)
+	switch staff {
+	case TrebleStaff:
+		staves = append(staves, stave{Clef: TrebleStaff, Bottom: 30})
+	case BassStaff:
+		staves = append(staves, stave{Clef: BassStaff, Bottom: 18})
+	case GrandStaff:
+		staves = append(staves, stave{Clef: TrebleStaff, Bottom: 30}, stave{Clef: BassStaff, Bottom: 18})
+	default:
+		return nil, ErrUnsupportedStaff
+	}
+
+	labels := pitchLabels(names, spelling.Default, options.Labels)
+	palette := options.palette()
+	t := options.theme()
+	legend := []legendEntry{
+		{Color: palette.Primary, Text: "Tonic, root or bass"},
+		{Color: palette.Secondary, Text: "Other pitches"},
+	}
+
+	// spell notes and place them on staves, notes of a column ascend
+	engraved := make([][]engravedNote, 0)
+	for i, column := range columns {
+		notes := make([]engravedNote, 0)
+		for j, v := range column {
+			if !v.Valid() {
+				continue
+			}
+
+			name := spelling.Default(v.Pitch)
+			for _, spelled := range names {
+				if spelled.Pitch() == v.Pitch {
+					name = spelled
+					break
+				}
+			}
+
+			note := engravedNote{Name: name, Step: writtenStep(v, name), Color: palette.highlight(i == 0 && j == 0), Label: labels[v.Pitch]}
+			if len(staves) > 1 && note.Step < 28 {
+				note.Stave = 1
+			}
+
+			notes = append(notes, note)
+		}
+
+		sort.SliceStable(notes, func(a, b int) bool { return notes[a].Step < notes[b].Step })
+		engraved = append(engraved, notes)
+	}
+
+	// accidentals are written when they differ from the signature or from previous note of the same staff position
+	written := make(map[int]spelling.Accidental)
+	for _, notes := range engraved {
+		for k, v := range notes {
+			current, found := written[v.Step]
+			if !found {
+				current = signature.Accidental(v.Name.Letter)
+			}
+
+			notes[k].Accidental = v.Name.Accidental != current
+		}
+
+		for _, v := range notes {
+			written[v.Step] = v.Name.Accidental
+		}
+	}
+
+	// noteheads of seconds are shifted, accidentals are stacked from the highest note in columns avoiding each other
+	accidentalColumns := make([]int, len(engraved))
+	shifted := make([]bool, len(engraved))
+	for i, notes := range engraved {
+		for k := 1; k < len(notes); k++ {
+			previous := notes[k-1]
+			notes[k].Shifted = previous.Stave == notes[k].Stave && notes[k].Step-previous.Step <= 1 && !previous.Shifted
+			shifted[i] = shifted[i] || notes[k].Shifted
+		}
+
+		placed := make([][]engravedNote, 0)
+		for k := len(notes) - 1; k >= 0; k-- {
+			if !notes[k].Accidental {
+				continue
+			}
+
+			column := 0
+			for ; column < len(placed); column++ {
+				collides := false
+				for _, v := range placed[column] {
+					collides = collides || (v.Stave == notes[k].Stave && v.Step-notes[k].Step < 6)
+				}
+
+				if !collides {
+					break
+				}
+			}
+
+			if column == len(placed) {
+				placed = append(placed, make([]engravedNote, 0))
+			}
+
+			placed[column] = append(placed[column], notes[k])
+			notes[k].Column = column
+		}
+
+		accidentalColumns[i] = len(placed)
+	}
+
+	// staves leave room for their clef, ledger lines and accidentals
+	half := staffSpace / 2
+	cursor := 10.0
+	rows := 0
+	for s := range staves {
+		above, below := 12, 4
+		for _, notes := range engraved {
+			rows = max(rows, len(notes))
+			for _, v := range notes {
+				if v.Stave == s {
+					above = max(above, v.Step-staves[s].Bottom+5)
+					below = max(below, staves[s].Bottom-v.Step+3)
+				}
+			}
+		}
+
+		staves[s].Y = cursor + float64(above)*half
+		cursor = staves[s].Y + float64(below)*half
+	}
+
+	// columns follow the clef and key signature, noteheads are placed after accidentals
+	left := 20.0
+	x := left + 4.5*staffSpace + float64(len(signature.Letters()))*1.1*staffSpace
+	centers := make([]float64, len(engraved))
+	for i := range engraved {
+		accidentals := float64(accidentalColumns[i]) * 1.3 * staffSpace
+		centers[i] = x + accidentals + 1.1*staffSpace
+
+		x += accidentals + 1.9*staffSpace + 2.5*staffSpace
+		if shifted[i] {
+			x += 1.6 * staffSpace
+		}
+	}
+
+	right := x
+	width := int(math.Ceil(right + staffSpace + 20))
+	height := int(math.Ceil(cursor + 10 + float64(rows)*20))
+	r, err := NewRenderer(options, width, height+options.legendHeight(legend), 16)
+	if err != nil {
+		return nil, err
+	}
+
+	r.Clear(t.Background)
+
+	// draw staves with their clef and key signature
+	line := Style{Stroke: t.Foreground, LineWidth: 1.2}
+	for _, s := range staves {
+		for i := 0; i < 5; i++ {
+			y := s.Y - float64(i)*staffSpace
+			r.Polyline([]Point{{X: left, Y: y}, {X: right + staffSpace, Y: y}}, line)
+		}
+
+		steps := signatureSteps(signature)
+		if s.Clef == BassStaff {
+			drawBassClef(r, left+1.4*staffSpace, s.y(s.Bottom+6), t.Foreground)
+			for i := range steps {
+				steps[i] -= 14
+			}
+		} else {
+			drawTrebleClef(r, left+1.6*staffSpace, s.y(s.Bottom+2), t.Foreground)
+		}
+
+		for i, letter := range signature.Letters() {
+			drawAccidental(r, signature.Accidental(letter), left+4*staffSpace+float64(i)*1.1*staffSpace, s.y(steps[i]), t.Foreground)
+		}
+	}
+
+	// join staves of grand staff with system line and brace, then close them with final barline
+	top, bottom := staves[0].Y-4*staffSpace, staves[len(staves)-1].Y
+	if len(staves) > 1 {
+		middle := (top + bottom) / 2
+		r.Polyline([]Point{{X: left, Y: top}, {X: left, Y: bottom}}, line)
+		r.Polyline(spline([]Point{
+			{X: left - 4, Y: top},
+			{X: left - 9, Y: top + (middle-top)*0.2},
+			{X: left - 9, Y: middle - (middle-top)*0.2},
+			{X: left - 14, Y: middle},
+			{X: left - 9, Y: middle + (bottom-middle)*0.2},
+			{X: left - 9, Y: bottom - (bottom-middle)*0.2},
+			{X: left - 4, Y: bottom},
+		}, 8), Style{Stroke: t.Foreground, LineWidth: 2.5})
+	}
+
+	r.Polyline([]Point{{X: right, Y: top}, {X: right, Y: bottom}}, line)
+	r.Rectangle(right+0.35*staffSpace, top, 0.45*staffSpace, bottom-top, Style{Fill: t.Foreground})
+
+	// draw notes with their ledger lines and accidentals
+	ledger := Style{Stroke: t.Foreground, LineWidth: 1.6}
+	for i, notes := range engraved {
+		for _, v := range notes {
+			s := staves[v.Stave]
+			x := centers[i]
+			if v.Shifted {
+				x += 1.6 * staffSpace
+			}
+
+			for step := s.Bottom - 2; step >= v.Step; step -= 2 {
+				r.Polyline([]Point{{X: x - 1.2*staffSpace, Y: s.y(step)}, {X: x + 1.2*staffSpace, Y: s.y(step)}}, ledger)
+			}
+
+			for step := s.Bottom + 10; step <= v.Step; step += 2 {
+				r.Polyline([]Point{{X: x - 1.2*staffSpace, Y: s.y(step)}, {X: x + 1.2*staffSpace, Y: s.y(step)}}, ledger)
+			}
+
+			drawWholeNote(r, x, s.y(v.Step), v.Color)
+			if v.Accidental {
+				drawAccidental(r, v.Name.Accidental, centers[i]-1.75*staffSpace-float64(v.Column)*1.3*staffSpace, s.y(v.Step), t.Foreground)
+			}
+		}
+	}
+
+	// label notes below the staves, from the highest note of each column
+	for i, notes := range engraved {
+		for k := range notes {
+			r.Text(notes[len(notes)-1-k].Label, centers[i], cursor+20+float64(k)*20, 0.5, 0.5, t.Foreground)
+		}
+	}
+
+	options.drawLegend(r, legend, float64(height))
+	return r, nil
+}
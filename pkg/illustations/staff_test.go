@@ -0,0 +1,83 @@
+package illustations_test
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/illustations"
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/scale"
+	"github.com/edipermadi/music-db/pkg/theory/spelling"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStaffTypeFromString(t *testing.T) {
+	for _, v := range illustations.AllStaffTypes() {
+		assert.Equal(t, v, illustations.StaffTypeFromString(v.String()))
+		assert.Equal(t, v, illustations.StaffTypeFromString(v.Identifier()))
+	}
+
+	assert.Equal(t, illustations.InvalidStaffType, illustations.StaffTypeFromString("alto"))
+}
+
+func TestStaff(t *testing.T) {
+	for _, format := range illustations.AllFormats() {
+		for _, staff := range illustations.AllStaffTypes() {
+			t.Run(format.String()+staff.String(), func(t *testing.T) {
+				// generate image, chord tones are written in columns after the chord
+				names := spelling.Chord(chord.DominantSeventh, pitch.GNatural)
+				notes := chord.DominantSeventh.Notes(pitch.GNatural, 3)
+				columns := [][]pitch.Note{notes}
+				for _, v := range notes {
+					columns = append(columns, []pitch.Note{v})
+				}
+
+				img, err := illustations.Staff(columns, names, staff, 1, illustations.Options{Format: format})
+				require.NoError(t, err)
+
+				// create temporary file
+				file, err := os.CreateTemp(os.TempDir(), "staff.*."+format.String())
+				require.NoError(t, err)
+				defer func() {
+					_ = file.Close()
+					_ = os.Remove(file.Name())
+				}()
+
+				// save image
+				require.NoError(t, img.Encode(file))
+			})
+		}
+	}
+}
+
+func TestStaff_Accidentals(t *testing.T) {
+	svg := func(s scale.Type, tonic pitch.Type) string {
+		names := spelling.Key(s, tonic)
+		columns := make([][]pitch.Note, 0)
+		for _, v := range s.Notes(tonic, 4) {
+			columns = append(columns, []pitch.Note{v})
+		}
+
+		img, err := illustations.Staff(columns, names, illustations.TrebleStaff, spelling.KeySignature(names), illustations.Options{Format: illustations.SVG})
+		require.NoError(t, err)
+
+		var buffer bytes.Buffer
+		require.NoError(t, img.Encode(&buffer))
+		return buffer.String()
+	}
+
+	// accidentals of the key signature are not written on the notes, noteheads are the only polygons
+	assert.Equal(t, 7, strings.Count(svg(scale.Ionian, pitch.CSharp), "<polygon"))
+
+	// C harmonic minor has a natural on B, drawn with two bars
+	assert.Equal(t, 9, strings.Count(svg(scale.Mydian, pitch.CNatural), "<polygon"))
+}
+
+func TestStaff_InvalidStaffType(t *testing.T) {
+	_, err := illustations.Staff(nil, nil, illustations.InvalidStaffType, 0, illustations.Options{Format: illustations.SVG})
+	assert.ErrorIs(t, err, illustations.ErrUnsupportedStaff)
+}
@@ -0,0 +1,155 @@
+package illustations
+
+import (
+	"math"
+
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/spelling"
+)
+
+type tonnetzTriangle struct {
+	Chord    chord.Chord
+	Vertices [3]Point
+	Centroid Point
+}
+
+// Tonnetz illustrates triads on a Tonnetz, where pitch classes are laid out by perfect fifths horizontally and by major
+// thirds diagonally, so every triangle is a major or minor triad. Given triads are highlighted as a path, the first one
+// in blue and the rest in red, connected from one triad to the next. Chords other than major or minor triads are skipped.
+func Tonnetz(chords []chord.Chord, options Options) (Illustration, error) {
+	palette := options.palette()
+	t := options.theme()
+	legend := []legendEntry{
+		{Color: palette.Primary, Text: "First triad"},
+		{Color: palette.Secondary, Text: "Following triads"},
+	}
+
+	width := 600
+	height := 420
+	r, err := NewRenderer(options, width, height+options.legendHeight(legend), 16)
+	if err != nil {
+		return nil, err
+	}
+
+	r.Clear(t.Background)
+
+	side := 70.0
+	rowHeight := side * math.Sqrt(3) / 2
+	centerX := float64(width) / 2
+	centerY := float64(height) / 2
+	rows := 3
+	columns := 6
+
+	// lattice node position and pitch, C natural is placed at the center
+	position := func(row, column int) Point {
+		return Point{
+			X: centerX + (float64(column)+float64(row)/2)*side,
+			Y: centerY - float64(row)*rowHeight,
+		}
+	}
+	pitchAt := func(row, column int) pitch.Type {
+		return pitch.CNatural.Transpose(7*column + 4*row)
+	}
+	visible := func(p Point) bool {
+		return p.X >= 20 && p.X <= float64(width)-20 && p.Y >= 20 && p.Y <= float64(height)-20
+	}
+
+	// collect triangles, upward triangles are major triads and downward triangles are minor triads
+	triangles := make([]tonnetzTriangle, 0)
+	for row := -rows; row < rows; row++ {
+		for column := -columns - rows; column <= columns+rows; column++ {
+			candidates := []tonnetzTriangle{
+				{
+					Chord:    chord.New(chord.Major, pitchAt(row, column)),
+					Vertices: [3]Point{position(row, column), position(row, column+1), position(row+1, column)},
+				},
+				{
+					Chord:    chord.New(chord.Minor, pitchAt(row+1, column)),
+					Vertices: [3]Point{position(row, column+1), position(row+1, column), position(row+1, column+1)},
+				},
+			}
+
+			for _, v := range candidates {
+				if visible(v.Vertices[0]) && visible(v.Vertices[1]) && visible(v.Vertices[2]) {
+					v.Centroid = Point{
+						X: (v.Vertices[0].X + v.Vertices[1].X + v.Vertices[2].X) / 3,
+						Y: (v.Vertices[0].Y + v.Vertices[1].Y + v.Vertices[2].Y) / 3,
+					}
+					triangles = append(triangles, v)
+				}
+			}
+		}
+	}
+
+	// place each triad at its occurrence closest to the previous one, so that a path stays connected
+	path := make([]tonnetzTriangle, 0)
+	reference := Point{X: centerX, Y: centerY}
+	for _, c := range chords {
+		if !c.IsTriad() {
+			continue
+		}
+
+		wanted := chord.New(c.Quality, c.Root)
+		closest := -1
+		closestDistance := math.MaxFloat64
+		for i, v := range triangles {
+			distance := math.Hypot(v.Centroid.X-reference.X, v.Centroid.Y-reference.Y)
+			if v.Chord == wanted && distance < closestDistance {
+				closest = i
+				closestDistance = distance
+			}
+		}
+
+		if closest >= 0 {
+			path = append(path, triangles[closest])
+			reference = triangles[closest].Centroid
+		}
+	}
+
+	// highlight triads in a lighter shade
+	for i, v := range path {
+		r.Polygon(v.Vertices[:], Style{Fill: mix(palette.highlight(i == 0), t.Background, 0.5)})
+	}
+
+	// draw lattice edges
+	for _, v := range triangles {
+		r.Polygon(v.Vertices[:], Style{Stroke: t.Grid, LineWidth: 1})
+	}
+
+	// connect consecutive triads
+	if len(path) > 1 {
+		centroids := make([]Point, 0)
+		for _, v := range path {
+			centroids = append(centroids, v.Centroid)
+		}
+		r.Polyline(centroids, Style{Stroke: palette.Secondary, LineWidth: 3})
+
+		for i, v := range path {
+			r.Circle(v.Centroid.X, v.Centroid.Y, 5, Style{Fill: palette.highlight(i == 0)})
+		}
+	}
+
+	// draw pitch nodes, degrees are relative to root of the first triad
+	names := make([]spelling.Name, 0)
+	if len(path) > 0 {
+		names = append(names, spelling.Default(path[0].Chord.Root))
+	}
+
+	labels := pitchLabels(names, spelling.Default, options.Labels)
+	for row := -rows; row <= rows; row++ {
+		for column := -columns - rows; column <= columns+rows; column++ {
+			p := position(row, column)
+			if !visible(p) {
+				continue
+			}
+
+			r.Circle(p.X, p.Y, 17, Style{Fill: t.Foreground})
+			r.Circle(p.X, p.Y, 15, Style{Fill: t.Surface})
+			r.Text(labels[pitchAt(row, column)], p.X, p.Y, 0.5, 0.5, t.Foreground)
+		}
+	}
+
+	options.drawLegend(r, legend, float64(height))
+	return r, nil
+}
@@ -0,0 +1,41 @@
+package illustations_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/illustations"
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTonnetz(t *testing.T) {
+	// generate image of C major to E major path
+	from := chord.New(chord.Major, pitch.CNatural)
+	steps, found := chord.ShortestPath(from, chord.New(chord.Major, pitch.ENatural))
+	require.True(t, found)
+
+	chords := []chord.Chord{from}
+	for _, v := range steps {
+		chords = append(chords, v.Chord)
+	}
+
+	for _, format := range illustations.AllFormats() {
+		t.Run(format.String(), func(t *testing.T) {
+			img, err := illustations.Tonnetz(chords, illustations.Options{Format: format})
+			require.NoError(t, err)
+
+			// create temporary file
+			file, err := os.CreateTemp(os.TempDir(), "tonnetz.*."+format.String())
+			require.NoError(t, err)
+			defer func() {
+				_ = file.Close()
+				_ = os.Remove(file.Name())
+			}()
+
+			// save image
+			require.NoError(t, img.Encode(file))
+		})
+	}
+}
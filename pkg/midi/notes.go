@@ -0,0 +1,81 @@
+package midi
+
+import (
+	"math"
+
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/tuning"
+)
+
+// NotesOn starts playing given notes, notes outside MIDI range are skipped
+func NotesOn(synthesizer Synthesizer, channel int32, notes []pitch.Note, velocity int32) {
+	for _, v := range notes {
+		if key := v.Midi(); v.Valid() && key >= 0 && key <= 127 {
+			synthesizer.NoteOn(channel, int32(key), velocity)
+		}
+	}
+}
+
+// NotesOff stops playing given notes, notes outside MIDI range are skipped
+func NotesOff(synthesizer Synthesizer, channel int32, notes []pitch.Note) {
+	for _, v := range notes {
+		if key := v.Midi(); v.Valid() && key >= 0 && key <= 127 {
+			synthesizer.NoteOff(channel, int32(key))
+		}
+	}
+}
+
+// pitchBendRange is the default pitch bend range of a channel in cents
+const pitchBendRange = 200.0
+
+// TunedNotesOn starts playing given notes in given tuning. Each note is played on its own channel,
+// using the closest MIDI key and pitch bend for the remaining deviation. Channel 10 is reserved for
+// percussion, so at most 15 notes are played.
+func TunedNotesOn(synthesizer Synthesizer, notes []pitch.Note, t tuning.Tuning, velocity int32) {
+	var channel int32
+	for _, v := range notes {
+		if channel == 9 {
+			channel++
+		}
+
+		if channel > 15 {
+			return
+		}
+
+		if _, played := TunedNoteOn(synthesizer, channel, v, t, velocity); played {
+			channel++
+		}
+	}
+}
+
+// TunedNoteOn starts playing a note in given tuning by bending the pitch of given channel,
+// returns the played MIDI key or false when the note is outside MIDI range
+func TunedNoteOn(synthesizer Synthesizer, channel int32, note pitch.Note, t tuning.Tuning, velocity int32) (int32, bool) {
+	key, bend, ok := TunedKey(note, t)
+	if !ok {
+		return 0, false
+	}
+
+	synthesizer.ProcessMidiMessage(channel, 0xE0, bend&0x7F, bend>>7)
+	synthesizer.NoteOn(channel, key, velocity)
+	return key, true
+}
+
+// TunedKey returns closest MIDI key of a note in given tuning and pitch bend value for the remaining deviation,
+// where 8192 means no bend. Valid is false when the note is outside MIDI range.
+func TunedKey(note pitch.Note, t tuning.Tuning) (key int32, bend int32, valid bool) {
+	frequency := t.Frequency(note)
+	if frequency <= 0 {
+		return 0, 0, false
+	}
+
+	// find closest key and remaining deviation relative to A4 at 440Hz
+	cents := 1200 * math.Log2(frequency/tuning.DefaultReference)
+	key = int32(math.Round(cents/100)) + 69
+	if key < 0 || key > 127 {
+		return 0, 0, false
+	}
+
+	bend = int32(math.Round((cents-float64(key-69)*100)/pitchBendRange*8192)) + 8192
+	return key, max(0, min(16383, bend)), true
+}
@@ -0,0 +1,80 @@
+package midi_test
+
+import (
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/midi"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/tuning"
+	"github.com/stretchr/testify/assert"
+)
+
+type message struct {
+	Channel int32
+	Command int32
+	Data1   int32
+	Data2   int32
+}
+
+// recorder is a synthesizer which records received messages
+type recorder struct {
+	messages []message
+}
+
+func (r *recorder) ProcessMidiMessage(channel int32, command int32, data1 int32, data2 int32) {
+	r.messages = append(r.messages, message{Channel: channel, Command: command, Data1: data1, Data2: data2})
+}
+
+func (r *recorder) NoteOff(channel int32, key int32) {
+	r.ProcessMidiMessage(channel, 0x80, key, 0)
+}
+
+func (r *recorder) NoteOn(channel int32, key int32, velocity int32) {
+	r.ProcessMidiMessage(channel, 0x90, key, velocity)
+}
+
+func (r *recorder) NoteOffAll(bool)                  {}
+func (r *recorder) NoteOffAllChannel(int32, bool)    {}
+func (r *recorder) ResetAllControllers()             {}
+func (r *recorder) ResetAllControllersChannel(int32) {}
+func (r *recorder) Reset()                           {}
+func (r *recorder) Render([]float32, []float32)      {}
+
+func TestNotesOn(t *testing.T) {
+	var synthesizer recorder
+	notes := []pitch.Note{pitch.NewNote(pitch.CNatural, 4), pitch.NewNote(pitch.CNatural, 10), pitch.NewNote(pitch.BNatural, 4)}
+	midi.NotesOn(&synthesizer, 0, notes, 100)
+	midi.NotesOff(&synthesizer, 0, notes)
+
+	expected := []message{
+		{Channel: 0, Command: 0x90, Data1: 60, Data2: 100},
+		{Channel: 0, Command: 0x90, Data1: 71, Data2: 100},
+		{Channel: 0, Command: 0x80, Data1: 60},
+		{Channel: 0, Command: 0x80, Data1: 71},
+	}
+	assert.Equal(t, expected, synthesizer.messages)
+}
+
+func TestTunedNotesOn(t *testing.T) {
+	var synthesizer recorder
+	notes := []pitch.Note{pitch.NewNote(pitch.ANatural, 4), pitch.NewNote(pitch.CSharp, 5)}
+	midi.TunedNotesOn(&synthesizer, notes, tuning.New(tuning.JustIntonation, 440, pitch.ANatural), 100)
+
+	// major third is 13.686 cents flat, which is bent down by 561 steps
+	expected := []message{
+		{Channel: 0, Command: 0xE0, Data1: 0, Data2: 64},
+		{Channel: 0, Command: 0x90, Data1: 69, Data2: 100},
+		{Channel: 1, Command: 0xE0, Data1: (8192 - 561) & 0x7F, Data2: (8192 - 561) >> 7},
+		{Channel: 1, Command: 0x90, Data1: 73, Data2: 100},
+	}
+	assert.Equal(t, expected, synthesizer.messages)
+}
+
+func TestTunedNotesOn_Reference(t *testing.T) {
+	var synthesizer recorder
+	midi.TunedNotesOn(&synthesizer, []pitch.Note{pitch.NewNote(pitch.ANatural, 4)}, tuning.New(tuning.EqualTemperament, 415, pitch.CNatural), 100)
+
+	// A4 at 415Hz is closer to G#4 at 440Hz, bent slightly flat
+	assert.Equal(t, int32(68), synthesizer.messages[1].Data1)
+	assert.Less(t, synthesizer.messages[0].Data1|synthesizer.messages[0].Data2<<7, int32(8192))
+}
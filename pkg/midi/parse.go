@@ -0,0 +1,285 @@
+package midi
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"sort"
+)
+
+// ErrInvalidFile is returned when standard MIDI file is malformed
+var ErrInvalidFile = errors.New("invalid standard midi file")
+
+// MaxFileSize is maximum size of parsed standard MIDI file in bytes
+const MaxFileSize = 4 << 20
+
+// Parse decodes standard MIDI file of format 0 or 1 with metrical division. Events are stored with absolute ticks,
+// running status is expanded and end of track events are dropped. Chunks other than header and tracks are skipped.
+// Files larger than MaxFileSize are rejected.
+func Parse(reader io.Reader) (File, error) {
+	r := bufio.NewReader(io.LimitReader(reader, MaxFileSize))
+
+	chunkType, chunk, err := readChunk(r)
+	if err != nil || chunkType != "MThd" || len(chunk) < 6 {
+		return File{}, ErrInvalidFile
+	}
+
+	format := Format(binary.BigEndian.Uint16(chunk[0:2]))
+	numTracks := int(binary.BigEndian.Uint16(chunk[2:4]))
+	division := binary.BigEndian.Uint16(chunk[4:6])
+
+	switch {
+	case format != Format0 && format != Format1:
+		return File{}, ErrInvalidFormat
+	case division&0x8000 != 0 || division == 0:
+		// SMPTE time code is not supported
+		return File{}, ErrInvalidDivision
+	}
+
+	file := File{Format: format, Division: int(division), Tracks: make([]Track, 0)}
+	for len(file.Tracks) < numTracks {
+		chunkType, chunk, err = readChunk(r)
+		if err != nil {
+			return File{}, ErrInvalidFile
+		}
+
+		if chunkType != "MTrk" {
+			continue
+		}
+
+		track, err := parseTrack(chunk)
+		if err != nil {
+			return File{}, err
+		}
+
+		file.Tracks = append(file.Tracks, track)
+	}
+
+	return file, nil
+}
+
+func readChunk(r io.Reader) (string, []byte, error) {
+	header := make([]byte, 8)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return "", nil, err
+	}
+
+	// chunk length is untrusted, buffer grows with data actually read rather than being allocated upfront
+	length := int64(binary.BigEndian.Uint32(header[4:]))
+	if length > MaxFileSize {
+		return "", nil, ErrInvalidFile
+	}
+
+	var chunk bytes.Buffer
+	if _, err := io.CopyN(&chunk, r, length); err != nil {
+		return "", nil, err
+	}
+
+	return string(header[:4]), chunk.Bytes(), nil
+}
+
+// trackReader reads track chunk data
+type trackReader struct {
+	data     []byte
+	position int
+}
+
+func (t *trackReader) done() bool {
+	return t.position >= len(t.data)
+}
+
+func (t *trackReader) byte() (byte, error) {
+	if t.done() {
+		return 0, ErrInvalidFile
+	}
+
+	b := t.data[t.position]
+	t.position++
+	return b, nil
+}
+
+func (t *trackReader) bytes(n int) ([]byte, error) {
+	if n < 0 || t.position+n > len(t.data) {
+		return nil, ErrInvalidFile
+	}
+
+	b := make([]byte, n)
+	copy(b, t.data[t.position:t.position+n])
+	t.position += n
+	return b, nil
+}
+
+// variableLength reads variable length quantity of at most four bytes
+func (t *trackReader) variableLength() (int, error) {
+	var value int
+	for i := 0; i < 4; i++ {
+		b, err := t.byte()
+		if err != nil {
+			return 0, err
+		}
+
+		value = value<<7 | int(b&0x7F)
+		if b&0x80 == 0 {
+			return value, nil
+		}
+	}
+
+	return 0, ErrInvalidFile
+}
+
+func parseTrack(data []byte) (Track, error) {
+	r := &trackReader{data: data}
+	track := Track{Events: make([]Event, 0)}
+
+	var tick int
+	var runningStatus byte
+	for !r.done() {
+		delta, err := r.variableLength()
+		if err != nil {
+			return Track{}, err
+		}
+		tick += delta
+
+		status, err := r.byte()
+		if err != nil {
+			return Track{}, err
+		}
+
+		// running status reuses previous channel message status
+		if status < 0x80 {
+			if runningStatus == 0 {
+				return Track{}, ErrInvalidFile
+			}
+
+			status = runningStatus
+			r.position--
+		}
+
+		event := Event{Tick: tick, Status: status}
+		switch {
+		case status == 0xFF:
+			if event.Meta, err = r.byte(); err != nil {
+				return Track{}, err
+			}
+
+			fallthrough
+		case status == 0xF0 || status == 0xF7:
+			length, err := r.variableLength()
+			if err != nil {
+				return Track{}, err
+			}
+
+			if event.Data, err = r.bytes(length); err != nil {
+				return Track{}, err
+			}
+		case status >= 0x80 && status < 0xF0:
+			runningStatus = status
+			if event.Data, err = r.bytes(channelMessageLength(status)); err != nil {
+				return Track{}, err
+			}
+		default:
+			return Track{}, ErrInvalidFile
+		}
+
+		if status == 0xFF && event.Meta == MetaEndOfTrack {
+			break
+		}
+
+		track.Events = append(track.Events, event)
+	}
+
+	return track, nil
+}
+
+// channelMessageLength returns count of data bytes of channel message
+func channelMessageLength(status byte) int {
+	switch status & 0xF0 {
+	case 0xC0, 0xD0:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// Channel returns channel of channel message
+func (e Event) Channel() int32 {
+	return int32(e.Status & 0x0F)
+}
+
+// IsNoteOn returns true for note on event with positive velocity
+func (e Event) IsNoteOn() bool {
+	return e.Status&0xF0 == 0x90 && len(e.Data) == 2 && e.Data[1] > 0
+}
+
+// IsNoteOff returns true for note off event, including note on event with zero velocity
+func (e Event) IsNoteOff() bool {
+	if len(e.Data) != 2 {
+		return false
+	}
+
+	return e.Status&0xF0 == 0x80 || (e.Status&0xF0 == 0x90 && e.Data[1] == 0)
+}
+
+// TimedNote is a note played between two ticks
+type TimedNote struct {
+	Start    int
+	End      int
+	Channel  int32
+	Key      int32
+	Velocity int32
+}
+
+// Notes returns notes of all tracks ordered by start tick. Note on events are paired with the first following note off
+// of the same channel and key, notes left sounding are ended at the last event of the file.
+func (f File) Notes() []TimedNote {
+	notes := make([]TimedNote, 0)
+
+	var last int
+	for _, track := range f.Tracks {
+		for _, v := range track.Events {
+			last = max(last, v.Tick)
+		}
+	}
+
+	for _, track := range f.Tracks {
+		sounding := make(map[[2]int32][]TimedNote)
+		for _, v := range track.Events {
+			switch {
+			case v.IsNoteOn():
+				key := [2]int32{v.Channel(), int32(v.Data[0])}
+				sounding[key] = append(sounding[key], TimedNote{Start: v.Tick, Channel: key[0], Key: key[1], Velocity: int32(v.Data[1])})
+			case v.IsNoteOff():
+				key := [2]int32{v.Channel(), int32(v.Data[0])}
+				if started := sounding[key]; len(started) > 0 {
+					note := started[0]
+					note.End = v.Tick
+					notes = append(notes, note)
+					sounding[key] = started[1:]
+				}
+			}
+		}
+
+		for _, started := range sounding {
+			for _, note := range started {
+				note.End = last
+				notes = append(notes, note)
+			}
+		}
+	}
+
+	sort.SliceStable(notes, func(i, j int) bool {
+		if notes[i].Start != notes[j].Start {
+			return notes[i].Start < notes[j].Start
+		}
+
+		if notes[i].Key != notes[j].Key {
+			return notes[i].Key < notes[j].Key
+		}
+
+		return notes[i].Channel < notes[j].Channel
+	})
+
+	return notes
+}
@@ -0,0 +1,135 @@
+package midi_test
+
+import (
+	"bytes"
+	"runtime"
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/midi"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/tuning"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_RoundTrip(t *testing.T) {
+	sequence := midi.Sequence{
+		Notes:      []pitch.Note{pitch.NewNote(pitch.CNatural, 4), pitch.NewNote(pitch.ENatural, 4), pitch.NewNote(pitch.GNatural, 4)},
+		Tempo:      90,
+		NoteLength: 1,
+		Velocity:   80,
+		Tuning:     tuning.Default(),
+	}
+
+	for _, format := range []midi.Format{midi.Format0, midi.Format1} {
+		file, err := sequence.File(format)
+		require.NoError(t, err)
+
+		encoded, err := file.Bytes()
+		require.NoError(t, err)
+
+		parsed, err := midi.Parse(bytes.NewReader(encoded))
+		require.NoError(t, err)
+		assert.Equal(t, format, parsed.Format)
+		assert.Equal(t, midi.DefaultDivision, parsed.Division)
+		assert.Len(t, parsed.Tracks, len(file.Tracks))
+
+		expected := []midi.TimedNote{
+			{Start: 0, End: 480, Channel: 0, Key: 60, Velocity: 80},
+			{Start: 480, End: 960, Channel: 1, Key: 64, Velocity: 80},
+			{Start: 960, End: 1440, Channel: 2, Key: 67, Velocity: 80},
+		}
+		assert.Equal(t, expected, parsed.Notes())
+	}
+}
+
+func TestParse_RunningStatus(t *testing.T) {
+	encoded := []byte{
+		'M', 'T', 'h', 'd', 0, 0, 0, 6, 0, 0, 0, 1, 0, 96,
+		'M', 'T', 'r', 'k', 0, 0, 0, 18,
+		0x00, 0x91, 60, 100,
+		0x00, 62, 90, // running status note on
+		0x60, 60, 0, // note on with zero velocity ends the note
+		0x30, 0x81, 62, 0,
+		0x00, 0xFF, 0x2F, 0x00,
+	}
+
+	file, err := midi.Parse(bytes.NewReader(encoded))
+	require.NoError(t, err)
+	require.Len(t, file.Tracks, 1)
+	assert.Len(t, file.Tracks[0].Events, 4)
+
+	expected := []midi.TimedNote{
+		{Start: 0, End: 96, Channel: 1, Key: 60, Velocity: 100},
+		{Start: 0, End: 144, Channel: 1, Key: 62, Velocity: 90},
+	}
+	assert.Equal(t, expected, file.Notes())
+}
+
+func TestParse_Invalid(t *testing.T) {
+	type testCase struct {
+		Title    string
+		Given    []byte
+		Expected error
+	}
+
+	testCases := []testCase{
+		{
+			Title:    "Empty",
+			Given:    []byte{},
+			Expected: midi.ErrInvalidFile,
+		},
+		{
+			Title:    "NotMidi",
+			Given:    []byte("RIFF\x00\x00\x00\x06WAVEfm"),
+			Expected: midi.ErrInvalidFile,
+		},
+		{
+			Title:    "Format2",
+			Given:    []byte{'M', 'T', 'h', 'd', 0, 0, 0, 6, 0, 2, 0, 1, 0, 96},
+			Expected: midi.ErrInvalidFormat,
+		},
+		{
+			Title:    "SMPTE",
+			Given:    []byte{'M', 'T', 'h', 'd', 0, 0, 0, 6, 0, 0, 0, 1, 0xE7, 0x28},
+			Expected: midi.ErrInvalidDivision,
+		},
+		{
+			Title:    "MissingTrack",
+			Given:    []byte{'M', 'T', 'h', 'd', 0, 0, 0, 6, 0, 0, 0, 1, 0, 96},
+			Expected: midi.ErrInvalidFile,
+		},
+		{
+			Title:    "TruncatedChunk",
+			Given:    []byte{'M', 'T', 'h', 'd', 0, 0, 0, 6, 0, 0, 0, 1, 0, 96, 'M', 'T', 'r', 'k', 0, 0, 0x10, 0, 0x00},
+			Expected: midi.ErrInvalidFile,
+		},
+		{
+			Title:    "TruncatedEvent",
+			Given:    []byte{'M', 'T', 'h', 'd', 0, 0, 0, 6, 0, 0, 0, 1, 0, 96, 'M', 'T', 'r', 'k', 0, 0, 0, 3, 0x00, 0x90, 60},
+			Expected: midi.ErrInvalidFile,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			_, err := midi.Parse(bytes.NewReader(tc.Given))
+			assert.ErrorIs(t, err, tc.Expected)
+		})
+	}
+}
+
+func TestParse_HugeChunkLength(t *testing.T) {
+	// header claiming a 4 GiB chunk must not make the parser allocate it
+	encoded := []byte{'M', 'T', 'h', 'd', 0xFF, 0xFF, 0xFF, 0xFF, 0, 0}
+
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+
+	_, err := midi.Parse(bytes.NewReader(encoded))
+	require.ErrorIs(t, err, midi.ErrInvalidFile)
+
+	runtime.ReadMemStats(&after)
+	assert.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(1<<20))
+}
@@ -0,0 +1,182 @@
+package midi
+
+import (
+	"sort"
+
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/tuning"
+)
+
+// Sequence describes notes played one after another, or all at once when Block is set
+type Sequence struct {
+	Notes         []pitch.Note
+	Tempo         int     // beats per minute, each note starts at a beat
+	NoteLength    float64 // length of each note in beats
+	Velocity      int32
+	Tuning        tuning.Tuning
+	Block         bool   // play all notes at once
+	Onsets        []int  // beat where each note starts, overrides Block when given for every note
+	Program       int32  // general MIDI program, only used by standard MIDI file
+	TimeSignature [2]int // numerator and denominator, defaults to 4/4
+}
+
+// Duration returns count of samples of the sequence at given sample rate, including a second of release after the last note
+func (s Sequence) Duration(sampleRate int) int {
+	if len(s.Notes) == 0 || s.Tempo <= 0 {
+		return 0
+	}
+
+	return s.beatSamples(sampleRate)*s.lastBeat() + s.noteSamples(sampleRate) + sampleRate
+}
+
+// NewChordSequence returns sequence playing chords one after another, each chord lasting given count of beats
+func NewChordSequence(chords [][]pitch.Note, beats int) Sequence {
+	notes := make([]pitch.Note, 0)
+	onsets := make([]int, 0)
+	for i, v := range chords {
+		for _, note := range v {
+			notes = append(notes, note)
+			onsets = append(onsets, i*beats)
+		}
+	}
+
+	return Sequence{Notes: notes, Onsets: onsets, NoteLength: float64(beats)}
+}
+
+// beat returns beat where note of given index starts
+func (s Sequence) beat(index int) int {
+	switch {
+	case len(s.Onsets) == len(s.Notes):
+		return s.Onsets[index]
+	case s.Block:
+		return 0
+	default:
+		return index
+	}
+}
+
+func (s Sequence) lastBeat() int {
+	last := 0
+	for i := range s.Notes {
+		last = max(last, s.beat(i))
+	}
+
+	return last
+}
+
+// channel returns channel of note of given index, notes rotate over melodic channels skipping percussion
+func channel(index int) int32 {
+	c := int32(index % 15)
+	if c >= 9 {
+		c++
+	}
+
+	return c
+}
+
+func (s Sequence) beatSamples(sampleRate int) int {
+	return sampleRate * 60 / s.Tempo
+}
+
+func (s Sequence) noteSamples(sampleRate int) int {
+	return int(float64(s.beatSamples(sampleRate)) * s.NoteLength)
+}
+
+type sequenceEvent struct {
+	position int
+	on       *sequenceEvent // note on event released by this event, nil for note on events
+	channel  int32
+	note     pitch.Note
+	key      int32
+	played   bool
+}
+
+// Render plays the sequence using given synthesizer and returns rendered left and right channels.
+// Notes rotate over melodic channels, so overlapping notes keep their own pitch bend, and each note off releases
+// the note it belongs to even when the same note overlaps itself.
+func (s Sequence) Render(synthesizer Synthesizer, sampleRate int) ([]float32, []float32) {
+	numSamples := s.Duration(sampleRate)
+	left := make([]float32, numSamples)
+	right := make([]float32, numSamples)
+	if numSamples == 0 {
+		return left, right
+	}
+
+	// schedule note on and off events
+	events := make([]*sequenceEvent, 0)
+	for i, v := range s.Notes {
+		start := s.beat(i) * s.beatSamples(sampleRate)
+		on := &sequenceEvent{position: start, channel: channel(i), note: v}
+		off := &sequenceEvent{position: start + s.noteSamples(sampleRate), on: on}
+		events = append(events, on, off)
+	}
+
+	// release notes before playing the ones starting at the same position
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].position != events[j].position {
+			return events[i].position < events[j].position
+		}
+
+		return events[i].on != nil && events[j].on == nil
+	})
+
+	// render samples between events
+	position := 0
+	for _, v := range events {
+		if v.position > position {
+			synthesizer.Render(left[position:v.position], right[position:v.position])
+			position = v.position
+		}
+
+		if v.on == nil {
+			v.key, v.played = TunedNoteOn(synthesizer, v.channel, v.note, s.Tuning, s.Velocity)
+		} else if v.on.played {
+			synthesizer.NoteOff(v.on.channel, v.on.key)
+		}
+	}
+
+	synthesizer.Render(left[position:], right[position:])
+	return left, right
+}
+
+// File returns the sequence as standard MIDI file with given format. Tuning is applied using pitch bend,
+// format 1 files have the tempo and time signature in the first track and the notes in the second one.
+func (s Sequence) File(format Format) (File, error) {
+	if format != Format0 && format != Format1 {
+		return File{}, ErrInvalidFormat
+	}
+
+	numerator, denominator := s.TimeSignature[0], s.TimeSignature[1]
+	if numerator <= 0 || denominator <= 0 {
+		numerator, denominator = 4, 4
+	}
+
+	var conductor Track
+	conductor.Add(TempoEvent(0, s.Tempo), TimeSignatureEvent(0, numerator, denominator))
+
+	var notes Track
+	configured := make(map[int32]bool)
+	length := int(float64(DefaultDivision) * s.NoteLength)
+	for i, v := range s.Notes {
+		key, bend, ok := TunedKey(v, s.Tuning)
+		if !ok {
+			continue
+		}
+
+		c := channel(i)
+		if !configured[c] {
+			notes.Add(ProgramChangeEvent(0, c, s.Program))
+			configured[c] = true
+		}
+
+		start := s.beat(i) * DefaultDivision
+		notes.Add(PitchBendEvent(start, c, bend), NoteOnEvent(start, c, key, s.Velocity), NoteOffEvent(start+length, c, key))
+	}
+
+	if format == Format0 {
+		conductor.Add(notes.Events...)
+		return File{Format: format, Division: DefaultDivision, Tracks: []Track{conductor}}, nil
+	}
+
+	return File{Format: format, Division: DefaultDivision, Tracks: []Track{conductor, notes}}, nil
+}
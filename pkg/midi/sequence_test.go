@@ -0,0 +1,104 @@
+package midi_test
+
+import (
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/midi"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/tuning"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSequence_Render(t *testing.T) {
+	var synthesizer recorder
+	sequence := midi.Sequence{
+		Notes:      []pitch.Note{pitch.NewNote(pitch.CNatural, 4), pitch.NewNote(pitch.ENatural, 4)},
+		Tempo:      60,
+		NoteLength: 0.5,
+		Velocity:   100,
+		Tuning:     tuning.Default(),
+	}
+
+	// one beat between notes, half a beat of the last note and a second of release
+	assert.Equal(t, 25, sequence.Duration(10))
+
+	left, right := sequence.Render(&synthesizer, 10)
+	assert.Len(t, left, 25)
+	assert.Len(t, right, 25)
+
+	expected := []message{
+		{Channel: 0, Command: 0xE0, Data1: 0, Data2: 64},
+		{Channel: 0, Command: 0x90, Data1: 60, Data2: 100},
+		{Channel: 0, Command: 0x80, Data1: 60},
+		{Channel: 1, Command: 0xE0, Data1: 0, Data2: 64},
+		{Channel: 1, Command: 0x90, Data1: 64, Data2: 100},
+		{Channel: 1, Command: 0x80, Data1: 64},
+	}
+	assert.Equal(t, expected, synthesizer.messages)
+}
+
+func TestSequence_RenderRepeatedNote(t *testing.T) {
+	var synthesizer recorder
+	sequence := midi.Sequence{
+		Notes:      []pitch.Note{pitch.NewNote(pitch.CNatural, 4), pitch.NewNote(pitch.CNatural, 4), pitch.NewNote(pitch.CNatural, 4)},
+		Onsets:     []int{0, 1, 3},
+		Tempo:      60,
+		NoteLength: 2,
+		Velocity:   100,
+		Tuning:     tuning.Default(),
+	}
+
+	// second note starts before the first one ends, third note starts as the second one ends
+	sequence.Render(&synthesizer, 10)
+	expected := []message{
+		{Channel: 0, Command: 0xE0, Data1: 0, Data2: 64},
+		{Channel: 0, Command: 0x90, Data1: 60, Data2: 100},
+		{Channel: 1, Command: 0xE0, Data1: 0, Data2: 64},
+		{Channel: 1, Command: 0x90, Data1: 60, Data2: 100},
+		{Channel: 0, Command: 0x80, Data1: 60},
+		{Channel: 1, Command: 0x80, Data1: 60},
+		{Channel: 2, Command: 0xE0, Data1: 0, Data2: 64},
+		{Channel: 2, Command: 0x90, Data1: 60, Data2: 100},
+		{Channel: 2, Command: 0x80, Data1: 60},
+	}
+	assert.Equal(t, expected, synthesizer.messages)
+}
+
+func TestSequence_Empty(t *testing.T) {
+	var synthesizer recorder
+	left, right := midi.Sequence{Tempo: 120}.Render(&synthesizer, 10)
+	assert.Empty(t, left)
+	assert.Empty(t, right)
+	assert.Empty(t, synthesizer.messages)
+}
+
+func TestNewChordSequence(t *testing.T) {
+	var synthesizer recorder
+	sequence := midi.NewChordSequence([][]pitch.Note{
+		{pitch.NewNote(pitch.CNatural, 4), pitch.NewNote(pitch.ENatural, 4)},
+		{pitch.NewNote(pitch.DNatural, 4)},
+	}, 2)
+	sequence.Tempo = 60
+	sequence.Velocity = 100
+	sequence.Tuning = tuning.Default()
+
+	assert.Equal(t, []int{0, 0, 2}, sequence.Onsets)
+	assert.Equal(t, float64(2), sequence.NoteLength)
+
+	// two beats of the first chord, two beats of the second chord and a second of release
+	assert.Equal(t, 50, sequence.Duration(10))
+
+	sequence.Render(&synthesizer, 10)
+	expected := []message{
+		{Channel: 0, Command: 0xE0, Data1: 0, Data2: 64},
+		{Channel: 0, Command: 0x90, Data1: 60, Data2: 100},
+		{Channel: 1, Command: 0xE0, Data1: 0, Data2: 64},
+		{Channel: 1, Command: 0x90, Data1: 64, Data2: 100},
+		{Channel: 0, Command: 0x80, Data1: 60},
+		{Channel: 1, Command: 0x80, Data1: 64},
+		{Channel: 2, Command: 0xE0, Data1: 0, Data2: 64},
+		{Channel: 2, Command: 0x90, Data1: 62, Data2: 100},
+		{Channel: 2, Command: 0x80, Data1: 62},
+	}
+	assert.Equal(t, expected, synthesizer.messages)
+}
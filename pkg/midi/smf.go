@@ -0,0 +1,206 @@
+package midi
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"sort"
+)
+
+// Standard MIDI file errors
+var (
+	ErrInvalidFormat   = errors.New("invalid standard midi file format")
+	ErrInvalidDivision = errors.New("invalid standard midi file division")
+)
+
+// Format is a type for standard MIDI file format
+type Format int
+
+// Standard MIDI file format enumerations
+const (
+	Format0 Format = 0 // single multi-channel track
+	Format1 Format = 1 // simultaneous tracks, the first one holds tempo and time signature
+)
+
+// DefaultDivision is the default amount of ticks per quarter note
+const DefaultDivision = 480
+
+// Meta event types
+const (
+	MetaTrackName     byte = 0x03
+	MetaEndOfTrack    byte = 0x2F
+	MetaTempo         byte = 0x51
+	MetaTimeSignature byte = 0x58
+)
+
+// Event is a timed MIDI event. Status 0xFF denotes a meta event whose type is stored in Meta.
+type Event struct {
+	Tick   int // absolute time in ticks
+	Status byte
+	Meta   byte
+	Data   []byte
+}
+
+// NoteOnEvent returns note on event of given channel, key and velocity
+func NoteOnEvent(tick int, channel int32, key int32, velocity int32) Event {
+	return Event{Tick: tick, Status: 0x90 | byte(channel&0x0F), Data: []byte{byte(key & 0x7F), byte(velocity & 0x7F)}}
+}
+
+// NoteOffEvent returns note off event of given channel and key
+func NoteOffEvent(tick int, channel int32, key int32) Event {
+	return Event{Tick: tick, Status: 0x80 | byte(channel&0x0F), Data: []byte{byte(key & 0x7F), 0}}
+}
+
+// ProgramChangeEvent returns program change event of given channel, program is general MIDI instrument from 0 to 127
+func ProgramChangeEvent(tick int, channel int32, program int32) Event {
+	return Event{Tick: tick, Status: 0xC0 | byte(channel&0x0F), Data: []byte{byte(program & 0x7F)}}
+}
+
+// PitchBendEvent returns pitch bend event of given channel, bend ranges from 0 to 16383 where 8192 is no bend
+func PitchBendEvent(tick int, channel int32, bend int32) Event {
+	return Event{Tick: tick, Status: 0xE0 | byte(channel&0x0F), Data: []byte{byte(bend & 0x7F), byte((bend >> 7) & 0x7F)}}
+}
+
+// TempoEvent returns tempo meta event in beats per minute
+func TempoEvent(tick int, bpm int) Event {
+	if bpm <= 0 {
+		bpm = 120
+	}
+
+	microseconds := 60000000 / bpm
+	return Event{Tick: tick, Status: 0xFF, Meta: MetaTempo, Data: []byte{byte(microseconds >> 16), byte(microseconds >> 8), byte(microseconds)}}
+}
+
+// TimeSignatureEvent returns time signature meta event, denominator must be a power of two
+func TimeSignatureEvent(tick int, numerator int, denominator int) Event {
+	var exponent byte
+	for d := denominator; d > 1; d >>= 1 {
+		exponent++
+	}
+
+	// a metronome click every quarter note with 8 thirty-second notes per quarter note
+	return Event{Tick: tick, Status: 0xFF, Meta: MetaTimeSignature, Data: []byte{byte(numerator), exponent, 24, 8}}
+}
+
+// TrackNameEvent returns track name meta event
+func TrackNameEvent(tick int, name string) Event {
+	return Event{Tick: tick, Status: 0xFF, Meta: MetaTrackName, Data: []byte(name)}
+}
+
+// Track is a list of events, end of track is appended when written
+type Track struct {
+	Events []Event
+}
+
+// Add appends events to the track
+func (t *Track) Add(events ...Event) {
+	t.Events = append(t.Events, events...)
+}
+
+// File represents a standard MIDI file
+type File struct {
+	Format   Format
+	Division int // ticks per quarter note
+	Tracks   []Track
+}
+
+// Bytes returns encoded standard MIDI file
+func (f File) Bytes() ([]byte, error) {
+	var buff bytes.Buffer
+	if err := f.Write(&buff); err != nil {
+		return nil, err
+	}
+
+	return buff.Bytes(), nil
+}
+
+// Write encodes standard MIDI file into given writer. Format 0 files must have exactly one track.
+func (f File) Write(writer io.Writer) error {
+	switch {
+	case f.Format != Format0 && f.Format != Format1:
+		return ErrInvalidFormat
+	case f.Format == Format0 && len(f.Tracks) != 1:
+		return ErrInvalidFormat
+	case f.Division <= 0 || f.Division > 0x7FFF:
+		return ErrInvalidDivision
+	}
+
+	var buff bytes.Buffer
+	buff.WriteString("MThd")
+	_ = binary.Write(&buff, binary.BigEndian, uint32(6))
+	_ = binary.Write(&buff, binary.BigEndian, []uint16{uint16(f.Format), uint16(len(f.Tracks)), uint16(f.Division)})
+
+	for _, track := range f.Tracks {
+		chunk := track.encode()
+		buff.WriteString("MTrk")
+		_ = binary.Write(&buff, binary.BigEndian, uint32(len(chunk)))
+		buff.Write(chunk)
+	}
+
+	_, err := writer.Write(buff.Bytes())
+	return err
+}
+
+// encode returns track chunk data with events sorted by time, note offs come before note ons at the same tick
+func (t Track) encode() []byte {
+	events := make([]Event, 0)
+	for _, v := range t.Events {
+		if v.Status != 0xFF || v.Meta != MetaEndOfTrack {
+			events = append(events, v)
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].Tick != events[j].Tick {
+			return events[i].Tick < events[j].Tick
+		}
+
+		return eventPriority(events[i]) < eventPriority(events[j])
+	})
+
+	var buff bytes.Buffer
+	tick := 0
+	for _, v := range events {
+		if v.Tick < tick {
+			v.Tick = tick
+		}
+
+		writeVariableLength(&buff, v.Tick-tick)
+		tick = v.Tick
+
+		buff.WriteByte(v.Status)
+		if v.Status == 0xFF {
+			buff.WriteByte(v.Meta)
+			writeVariableLength(&buff, len(v.Data))
+		}
+		buff.Write(v.Data)
+	}
+
+	buff.Write([]byte{0x00, 0xFF, MetaEndOfTrack, 0x00})
+	return buff.Bytes()
+}
+
+// eventPriority orders simultaneous events: meta events, then note offs, then channel setup and finally note ons
+func eventPriority(e Event) int {
+	switch e.Status & 0xF0 {
+	case 0xF0:
+		return 0
+	case 0x80:
+		return 1
+	case 0x90:
+		return 3
+	default:
+		return 2
+	}
+}
+
+// writeVariableLength writes value as variable length quantity, seven bits per byte with the most significant group first
+func writeVariableLength(buff *bytes.Buffer, value int) {
+	groups := []byte{byte(value & 0x7F)}
+	for value >>= 7; value > 0; value >>= 7 {
+		groups = append([]byte{byte(value&0x7F) | 0x80}, groups...)
+	}
+
+	buff.Write(groups)
+}
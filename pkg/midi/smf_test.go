@@ -0,0 +1,90 @@
+package midi_test
+
+import (
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/midi"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/tuning"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFile_Bytes(t *testing.T) {
+	var track midi.Track
+	track.Add(
+		midi.NoteOffEvent(480, 0, 60),
+		midi.TempoEvent(0, 120),
+		midi.NoteOnEvent(0, 0, 60, 100),
+	)
+
+	encoded, err := midi.File{Format: midi.Format0, Division: 480, Tracks: []midi.Track{track}}.Bytes()
+	require.NoError(t, err)
+
+	expected := []byte{
+		'M', 'T', 'h', 'd', 0, 0, 0, 6, 0, 0, 0, 1, 0x01, 0xE0,
+		'M', 'T', 'r', 'k', 0, 0, 0, 20,
+		0x00, 0xFF, 0x51, 0x03, 0x07, 0xA1, 0x20, // tempo of 500000 microseconds per quarter note
+		0x00, 0x90, 60, 100,
+		0x83, 0x60, 0x80, 60, 0, // delta of 480 ticks as variable length quantity
+		0x00, 0xFF, 0x2F, 0x00,
+	}
+	assert.Equal(t, expected, encoded)
+}
+
+func TestFile_Invalid(t *testing.T) {
+	_, err := midi.File{Format: midi.Format0, Division: 480}.Bytes()
+	assert.ErrorIs(t, err, midi.ErrInvalidFormat)
+
+	_, err = midi.File{Format: 2, Division: 480, Tracks: make([]midi.Track, 1)}.Bytes()
+	assert.ErrorIs(t, err, midi.ErrInvalidFormat)
+
+	_, err = midi.File{Format: midi.Format1, Tracks: make([]midi.Track, 1)}.Bytes()
+	assert.ErrorIs(t, err, midi.ErrInvalidDivision)
+}
+
+func TestTimeSignatureEvent(t *testing.T) {
+	event := midi.TimeSignatureEvent(0, 6, 8)
+	assert.Equal(t, byte(0xFF), event.Status)
+	assert.Equal(t, midi.MetaTimeSignature, event.Meta)
+	assert.Equal(t, []byte{6, 3, 24, 8}, event.Data)
+}
+
+func TestSequence_File(t *testing.T) {
+	sequence := midi.Sequence{
+		Notes:      []pitch.Note{pitch.NewNote(pitch.CNatural, 4), pitch.NewNote(pitch.ENatural, 4)},
+		Tempo:      120,
+		NoteLength: 0.5,
+		Velocity:   90,
+		Tuning:     tuning.Default(),
+		Program:    24,
+	}
+
+	file, err := sequence.File(midi.Format1)
+	require.NoError(t, err)
+	require.Len(t, file.Tracks, 2)
+	assert.Equal(t, midi.DefaultDivision, file.Division)
+	assert.Equal(t, []midi.Event{midi.TempoEvent(0, 120), midi.TimeSignatureEvent(0, 4, 4)}, file.Tracks[0].Events)
+
+	expected := []midi.Event{
+		midi.ProgramChangeEvent(0, 0, 24),
+		midi.PitchBendEvent(0, 0, 8192),
+		midi.NoteOnEvent(0, 0, 60, 90),
+		midi.NoteOffEvent(240, 0, 60),
+		midi.ProgramChangeEvent(0, 1, 24),
+		midi.PitchBendEvent(480, 1, 8192),
+		midi.NoteOnEvent(480, 1, 64, 90),
+		midi.NoteOffEvent(720, 1, 64),
+	}
+	assert.Equal(t, expected, file.Tracks[1].Events)
+
+	// block notes start together on a single track
+	sequence.Block = true
+	file, err = sequence.File(midi.Format0)
+	require.NoError(t, err)
+	require.Len(t, file.Tracks, 1)
+	assert.Contains(t, file.Tracks[0].Events, midi.NoteOnEvent(0, 1, 64, 90))
+
+	_, err = sequence.File(3)
+	assert.ErrorIs(t, err, midi.ErrInvalidFormat)
+}
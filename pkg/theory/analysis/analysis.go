@@ -0,0 +1,188 @@
+package analysis
+
+import (
+	"math"
+	"sort"
+
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/scale"
+)
+
+// Profile is a type for key profile, the expected weight of each pitch class relative to the tonic
+type Profile int
+
+// Key profile enumerations
+const (
+	Invalid          Profile = iota
+	KrumhanslKessler Profile = iota
+	Temperley        Profile = iota
+	AardenEssen      Profile = iota
+)
+
+// AllProfiles returns all key profiles
+func AllProfiles() []Profile {
+	return []Profile{
+		KrumhanslKessler,
+		Temperley,
+		AardenEssen,
+	}
+}
+
+// String returns key profile name
+func (p Profile) String() string {
+	if p < KrumhanslKessler || p > AardenEssen {
+		return "Invalid"
+	}
+
+	return [...]string{
+		"Invalid",
+		"KrumhanslKessler",
+		"Temperley",
+		"AardenEssen",
+	}[p]
+}
+
+// Identifier returns key profile identifier as used in query parameters, such as "krumhansl_kessler"
+func (p Profile) Identifier() string {
+	if p < KrumhanslKessler || p > AardenEssen {
+		return "invalid"
+	}
+
+	return [...]string{
+		"invalid",
+		"krumhansl_kessler",
+		"temperley",
+		"aarden_essen",
+	}[p]
+}
+
+// FromString returns key profile from its name or identifier, such as "AardenEssen" or "aarden_essen"
+func FromString(name string) Profile {
+	for _, v := range AllProfiles() {
+		if v.String() == name || v.Identifier() == name {
+			return v
+		}
+	}
+
+	return Invalid
+}
+
+// Scales returns scales having weights in the profile
+func (p Profile) Scales() []scale.Type {
+	if p < KrumhanslKessler || p > AardenEssen {
+		return make([]scale.Type, 0)
+	}
+
+	return []scale.Type{scale.Ionian, scale.Aeolian}
+}
+
+// Weights returns expected weight of each semitone step above the tonic for given scale,
+// found is false when the profile has no weights for the scale
+func (p Profile) Weights(s scale.Type) ([]float64, bool) {
+	var major, minor []float64
+	switch p {
+	case KrumhanslKessler:
+		// probe tone ratings of Krumhansl and Kessler (1982)
+		major = []float64{6.35, 2.23, 3.48, 2.33, 4.38, 4.09, 2.52, 5.19, 2.39, 3.66, 2.29, 2.88}
+		minor = []float64{6.33, 2.68, 3.52, 5.38, 2.60, 3.53, 2.54, 4.75, 3.98, 2.69, 3.34, 3.17}
+	case Temperley:
+		// pitch class probabilities of Kostka-Payne corpus by Temperley (2007)
+		major = []float64{0.748, 0.060, 0.488, 0.082, 0.670, 0.460, 0.096, 0.715, 0.104, 0.366, 0.057, 0.400}
+		minor = []float64{0.712, 0.084, 0.474, 0.618, 0.049, 0.460, 0.105, 0.747, 0.404, 0.067, 0.133, 0.330}
+	case AardenEssen:
+		// pitch class frequencies of Essen folksong collection by Aarden (2003)
+		major = []float64{17.7661, 0.145624, 14.9265, 0.160186, 19.8049, 11.3587, 0.291248, 22.062, 0.145624, 8.15494, 0.232998, 4.95122}
+		minor = []float64{18.2648, 0.737619, 14.0499, 16.8599, 0.702494, 14.4362, 0.702494, 18.6161, 4.56621, 1.93186, 7.37619, 1.75623}
+	default:
+		return nil, false
+	}
+
+	switch s {
+	case scale.Ionian:
+		return major, true
+	case scale.Aeolian:
+		return minor, true
+	default:
+		return nil, false
+	}
+}
+
+// Estimate is a key with correlation between its profile and a pitch distribution
+type Estimate struct {
+	Scale       scale.Type
+	Tonic       pitch.Type
+	Correlation float64
+}
+
+// Keys estimates keys of a pitch distribution using Krumhansl-Schmuckler algorithm. Profile of every key the profile
+// has weights for is correlated against the distribution, estimates are ranked by descending correlation.
+// Empty or uniform distribution yields no estimates.
+func Keys(d pitch.Distribution, p Profile) []Estimate {
+	estimates := make([]Estimate, 0)
+	if variance(d[:]) == 0 {
+		return estimates
+	}
+
+	for _, s := range p.Scales() {
+		weights, _ := p.Weights(s)
+		for _, tonic := range pitch.AllPitches() {
+			// rotate distribution so it starts from the tonic
+			rotated := make([]float64, 12)
+			for i := range rotated {
+				rotated[i] = d.Weight(tonic.Transpose(i))
+			}
+
+			estimates = append(estimates, Estimate{Scale: s, Tonic: tonic, Correlation: Correlation(rotated, weights)})
+		}
+	}
+
+	sort.SliceStable(estimates, func(i, j int) bool {
+		return estimates[i].Correlation > estimates[j].Correlation
+	})
+
+	return estimates
+}
+
+// Correlation returns Pearson correlation coefficient of two series of equal length,
+// zero is returned when either series is constant
+func Correlation(x, y []float64) float64 {
+	if len(x) != len(y) || len(x) == 0 {
+		return 0
+	}
+
+	meanX, meanY := mean(x), mean(y)
+
+	var covariance, varianceX, varianceY float64
+	for i := range x {
+		dx, dy := x[i]-meanX, y[i]-meanY
+		covariance += dx * dy
+		varianceX += dx * dx
+		varianceY += dy * dy
+	}
+
+	if varianceX == 0 || varianceY == 0 {
+		return 0
+	}
+
+	return covariance / math.Sqrt(varianceX*varianceY)
+}
+
+func mean(values []float64) float64 {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+
+	return sum / float64(len(values))
+}
+
+func variance(values []float64) float64 {
+	m := mean(values)
+
+	var sum float64
+	for _, v := range values {
+		sum += (v - m) * (v - m)
+	}
+
+	return sum / float64(len(values))
+}
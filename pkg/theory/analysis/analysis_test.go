@@ -0,0 +1,95 @@
+package analysis_test
+
+import (
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/theory/analysis"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/scale"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfile_FromString(t *testing.T) {
+	for _, v := range analysis.AllProfiles() {
+		assert.Equal(t, v, analysis.FromString(v.String()))
+		assert.Equal(t, v, analysis.FromString(v.Identifier()))
+	}
+
+	assert.Equal(t, analysis.Invalid, analysis.FromString("bellman_budge"))
+	assert.Equal(t, "Invalid", analysis.Invalid.String())
+	assert.Empty(t, analysis.Invalid.Scales())
+}
+
+func TestProfile_Weights(t *testing.T) {
+	for _, p := range analysis.AllProfiles() {
+		for _, s := range p.Scales() {
+			weights, found := p.Weights(s)
+			require.True(t, found)
+			assert.Len(t, weights, 12)
+		}
+
+		_, found := p.Weights(scale.Dorian)
+		assert.False(t, found)
+	}
+}
+
+func TestKeys(t *testing.T) {
+	type testCase struct {
+		Title         string
+		Weights       map[pitch.Type]float64
+		ExpectedScale scale.Type
+		ExpectedTonic pitch.Type
+	}
+
+	testCases := []testCase{
+		{
+			Title: "CMajor",
+			Weights: map[pitch.Type]float64{
+				pitch.CNatural: 4, pitch.DNatural: 2, pitch.ENatural: 3, pitch.FNatural: 2,
+				pitch.GNatural: 4, pitch.ANatural: 2, pitch.BNatural: 1,
+			},
+			ExpectedScale: scale.Ionian,
+			ExpectedTonic: pitch.CNatural,
+		},
+		{
+			Title: "AMinorWithLeadingTone",
+			Weights: map[pitch.Type]float64{
+				pitch.ANatural: 4, pitch.BNatural: 2, pitch.CNatural: 3, pitch.DNatural: 2,
+				pitch.ENatural: 4, pitch.FNatural: 1, pitch.GSharp: 1,
+			},
+			ExpectedScale: scale.Aeolian,
+			ExpectedTonic: pitch.ANatural,
+		},
+	}
+
+	for _, tc := range testCases {
+		for _, p := range analysis.AllProfiles() {
+			t.Run(tc.Title+p.String(), func(t *testing.T) {
+				var d pitch.Distribution
+				for k, v := range tc.Weights {
+					d.Add(k, v)
+				}
+
+				estimates := analysis.Keys(d, p)
+				require.Len(t, estimates, 24)
+				assert.Equal(t, tc.ExpectedScale, estimates[0].Scale)
+				assert.Equal(t, tc.ExpectedTonic, estimates[0].Tonic)
+
+				for i := 1; i < len(estimates); i++ {
+					assert.GreaterOrEqual(t, estimates[i-1].Correlation, estimates[i].Correlation)
+				}
+			})
+		}
+	}
+
+	assert.Empty(t, analysis.Keys(pitch.Distribution{}, analysis.KrumhanslKessler))
+	assert.Empty(t, analysis.Keys(pitch.Distribution{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, analysis.Temperley))
+}
+
+func TestCorrelation(t *testing.T) {
+	assert.InDelta(t, 1, analysis.Correlation([]float64{1, 2, 3}, []float64{2, 4, 6}), 1e-9)
+	assert.InDelta(t, -1, analysis.Correlation([]float64{1, 2, 3}, []float64{3, 2, 1}), 1e-9)
+	assert.Equal(t, 0.0, analysis.Correlation([]float64{1, 1, 1}, []float64{1, 2, 3}))
+	assert.Equal(t, 0.0, analysis.Correlation([]float64{1, 2}, []float64{1, 2, 3}))
+}
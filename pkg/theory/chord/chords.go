@@ -86,11 +86,32 @@ const (
 	MajorAddFlatNinth                                 Quality = iota
 	MinorAddFlatNinth                                 Quality = iota
 	MajorAddSharpNinth                                Quality = iota
+	DominantNinth                                     Quality = iota
+	MajorNinth                                        Quality = iota
+	MinorNinth                                        Quality = iota
+	MinorMajorNinth                                   Quality = iota
+	DominantEleventh                                  Quality = iota
+	MinorEleventh                                     Quality = iota
+	DominantThirteenth                                Quality = iota
+	MajorThirteenth                                   Quality = iota
+	MinorThirteenth                                   Quality = iota
+	DominantSeventhFlatNinth                          Quality = iota
+	DominantSeventhSharpNinth                         Quality = iota
+	DominantSeventhFlatThirteenth                     Quality = iota
+	DominantSeventhFlatNinthSharpNinth                Quality = iota
+	DominantSeventhFlatNinthFlatThirteenth            Quality = iota
+	DominantSeventhSharpNinthFlatThirteenth           Quality = iota
+	DominantSeventhAltered                            Quality = iota
+	DominantNinthSharpEleventh                        Quality = iota
+	DominantThirteenthFlatNinth                       Quality = iota
+	DominantThirteenthSharpEleventh                   Quality = iota
+	MajorNinthSharpEleventh                           Quality = iota
+	MinorNinthFlatFifth                               Quality = iota
 )
 
 // String returns chord name
 func (q Quality) String() string {
-	if q < Major || q > MajorAddSharpNinth {
+	if q < Major || q > MinorNinthFlatFifth {
 		return "Invalid"
 	}
 	return [...]string{
@@ -173,6 +194,27 @@ func (q Quality) String() string {
 		"MajorAddFlatNinth",
 		"MinorAddFlatNinth",
 		"MajorAddSharpNinth",
+		"DominantNinth",
+		"MajorNinth",
+		"MinorNinth",
+		"MinorMajorNinth",
+		"DominantEleventh",
+		"MinorEleventh",
+		"DominantThirteenth",
+		"MajorThirteenth",
+		"MinorThirteenth",
+		"DominantSeventhFlatNinth",
+		"DominantSeventhSharpNinth",
+		"DominantSeventhFlatThirteenth",
+		"DominantSeventhFlatNinthSharpNinth",
+		"DominantSeventhFlatNinthFlatThirteenth",
+		"DominantSeventhSharpNinthFlatThirteenth",
+		"DominantSeventhAltered",
+		"DominantNinthSharpEleventh",
+		"DominantThirteenthFlatNinth",
+		"DominantThirteenthSharpEleventh",
+		"MajorNinthSharpEleventh",
+		"MinorNinthFlatFifth",
 	}[q]
 }
 
@@ -183,7 +225,7 @@ func (q Quality) Number() int {
 
 // ZeitlerNumber returns chord number according to William Zeitler's system
 func (q Quality) ZeitlerNumber() int {
-	if q < Major || q > MajorAddSharpNinth {
+	if q < Major || q > MinorNinthFlatFifth {
 		return 0
 	}
 
@@ -194,12 +236,13 @@ func (q Quality) ZeitlerNumber() int {
 		2570, 2146, 2122, 2642, 2577, 2593, 2129, 2641, 2314, 2370, 2313, 2372, 2209, 2181, 2337, 2121, 2117, 3089,
 		2097, 2256, 2384, 2258, 2257, 2224, 2352, 2226, 2225, 2600, 2196, 2324, 2212, 2580, 2132, 2596, 2628, 2704,
 		2832, 2708, 2836, 3216, 3344, 2448,
+		2706, 2705, 2834, 2833, 2770, 2898, 2710, 2709, 2902, 3218, 2450, 2202, 3474, 3226, 2458, 3498, 2738, 3222, 2742, 2737, 2850,
 	}[q]
 }
 
 // RingNumber return chord quality number according to Ian Ring's numbering system
 func (q Quality) RingNumber() int {
-	if q < Major || q > MajorAddSharpNinth {
+	if q < Major || q > MinorNinthFlatFifth {
 		return 0
 	}
 
@@ -208,6 +251,7 @@ func (q Quality) RingNumber() int {
 		2081, 1169, 1161, 2193, 2185, 585, 1097, 1297, 2321, 1105, 1157, 1185, 1093, 1285, 1121, 1313, 1189, 2181, 2117,
 		2209, 2213, 1289, 1065, 2313, 553, 2129, 2577, 2121, 2337, 2593, 2179, 2241, 177, 169, 1201, 2225, 209, 201,
 		1233, 2257, 325, 657, 649, 593, 645, 673, 581, 549, 149, 141, 661, 653, 147, 139, 153,
+		1173, 2197, 1165, 2189, 1205, 1197, 1685, 2709, 1709, 1171, 1177, 1425, 1179, 1427, 1433, 1371, 1237, 1683, 1749, 2261, 1101,
 	}[q]
 }
 
@@ -224,6 +268,60 @@ func (q Quality) Pitches(root pitch.Type) []pitch.Type {
 	return pitches
 }
 
+// Notes returns chord notes in root position, where the root is placed at given octave. Chord tones within an octave
+// are voiced in close position, while tensions such as ninths, elevenths and thirteenths are voiced above the octave.
+func (q Quality) Notes(root pitch.Type, octave int) []pitch.Note {
+	notes := make([]pitch.Note, 0)
+	if root < pitch.CNatural || root > pitch.BNatural {
+		return notes
+	}
+
+	for _, v := range q.Intervals() {
+		notes = append(notes, pitch.NewNote(root, octave).Transpose(v))
+	}
+
+	return notes
+}
+
+// Intervals returns chord tones in root position as semitones above the root. Extended chords have their tensions
+// as compound intervals, such as 14 for the ninth, 17 for the eleventh and 21 for the thirteenth, other chords
+// have the same intervals as their pitch class.
+func (q Quality) Intervals() []int {
+	if q < DominantNinth || q > MinorNinthFlatFifth {
+		return q.PitchClass()
+	}
+
+	return [...][]int{
+		{0, 4, 7, 10, 14},
+		{0, 4, 7, 11, 14},
+		{0, 3, 7, 10, 14},
+		{0, 3, 7, 11, 14},
+		{0, 4, 7, 10, 14, 17},
+		{0, 3, 7, 10, 14, 17},
+		{0, 4, 7, 10, 14, 21},
+		{0, 4, 7, 11, 14, 21},
+		{0, 3, 7, 10, 14, 17, 21},
+		{0, 4, 7, 10, 13},
+		{0, 4, 7, 10, 15},
+		{0, 4, 7, 10, 20},
+		{0, 4, 7, 10, 13, 15},
+		{0, 4, 7, 10, 13, 20},
+		{0, 4, 7, 10, 15, 20},
+		{0, 4, 10, 13, 15, 18, 20},
+		{0, 4, 7, 10, 14, 18},
+		{0, 4, 7, 10, 13, 21},
+		{0, 4, 7, 10, 14, 18, 21},
+		{0, 4, 7, 11, 14, 18},
+		{0, 3, 6, 10, 14},
+	}[q-DominantNinth]
+}
+
+// Extended returns true when the chord has tensions above the octave
+func (q Quality) Extended() bool {
+	intervals := q.Intervals()
+	return len(intervals) > 0 && intervals[len(intervals)-1] > 11
+}
+
 // PitchClass returns chord pitch class
 func (q Quality) PitchClass() []int {
 	class := make([]int, 0)
@@ -332,5 +430,137 @@ func AllQualities() []Quality {
 		MajorAddFlatNinth,
 		MinorAddFlatNinth,
 		MajorAddSharpNinth,
+		DominantNinth,
+		MajorNinth,
+		MinorNinth,
+		MinorMajorNinth,
+		DominantEleventh,
+		MinorEleventh,
+		DominantThirteenth,
+		MajorThirteenth,
+		MinorThirteenth,
+		DominantSeventhFlatNinth,
+		DominantSeventhSharpNinth,
+		DominantSeventhFlatThirteenth,
+		DominantSeventhFlatNinthSharpNinth,
+		DominantSeventhFlatNinthFlatThirteenth,
+		DominantSeventhSharpNinthFlatThirteenth,
+		DominantSeventhAltered,
+		DominantNinthSharpEleventh,
+		DominantThirteenthFlatNinth,
+		DominantThirteenthSharpEleventh,
+		MajorNinthSharpEleventh,
+		MinorNinthFlatFifth,
 	}
 }
+
+// GenericIntervals returns generic interval number of each chord pitch relative to the root,
+// such as 1 for the root, 3 for the third and 5 for the fifth, in the same order as chord pitches
+func (q Quality) GenericIntervals() []int {
+	class := q.PitchClass()
+	contains := func(semitones int) bool {
+		for _, v := range class {
+			if v == semitones {
+				return true
+			}
+		}
+		return false
+	}
+
+	numbers := make([]int, 0)
+	for _, v := range class {
+		var number int
+		switch v {
+		case 0:
+			number = 1
+		case 1, 2:
+			number = 2
+		case 3:
+			// sharp ninth when major third is present
+			if contains(4) {
+				number = 2
+			} else {
+				number = 3
+			}
+		case 4:
+			number = 3
+		case 5:
+			if q.doubleFlatFifth() {
+				number = 5
+			} else {
+				number = 4
+			}
+		case 6:
+			// sharp fourth when perfect fifth is present
+			if contains(7) {
+				number = 4
+			} else {
+				number = 5
+			}
+		case 7:
+			number = 5
+		case 8:
+			// flat sixth when perfect fifth is present
+			if contains(7) {
+				number = 6
+			} else {
+				number = 5
+			}
+		case 9:
+			switch {
+			case q.doubleSharpFifth():
+				number = 5
+			case q.diminishedSeventh():
+				number = 7
+			default:
+				number = 6
+			}
+		default:
+			number = 7
+		}
+
+		numbers = append(numbers, number)
+	}
+
+	return numbers
+}
+
+func (q Quality) doubleFlatFifth() bool {
+	switch q {
+	case MinorDoubleFlatFifth, MajorSuspendedSecondDoubleFlatFifth, MinorSeventhDoubleFlatFifth,
+		MinorMajorSeventhDoubleFlatFifthDoubleFlatSeventh, MajorAddSixthSuspendSecondDoubleFlatFifth:
+		return true
+	default:
+		return false
+	}
+}
+
+func (q Quality) doubleSharpFifth() bool {
+	switch q {
+	case MajorDoubleSharpFifth, MajorSuspendedFourthDoubleSharpFifth, MajorSeventhDoubleSharpFifth,
+		MajorSeventhSuspendedFourthDoubleSharpFifth:
+		return true
+	default:
+		return false
+	}
+}
+
+func (q Quality) diminishedSeventh() bool {
+	switch q {
+	case DiminishedSeventh, MinorMajorSeventhDoubleFlatFifthDoubleFlatSeventh:
+		return true
+	default:
+		return false
+	}
+}
+
+// FromString returns chord quality from its name, such as "DominantSeventh"
+func FromString(name string) Quality {
+	for _, v := range AllQualities() {
+		if v.String() == name {
+			return v
+		}
+	}
+
+	return Invalid
+}
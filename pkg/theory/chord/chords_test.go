@@ -113,3 +113,57 @@ func TestChordType_Values(t *testing.T) {
 		})
 	}
 }
+
+func TestQuality_Notes(t *testing.T) {
+	expected := []pitch.Note{
+		pitch.NewNote(pitch.ANatural, 4),
+		pitch.NewNote(pitch.CSharp, 5),
+		pitch.NewNote(pitch.ENatural, 5),
+		pitch.NewNote(pitch.GNatural, 5),
+	}
+	assert.Equal(t, expected, chord.DominantSeventh.Notes(pitch.ANatural, 4))
+}
+
+func TestQuality_GenericIntervals(t *testing.T) {
+	assert.Equal(t, []int{1, 3, 5}, chord.Major.GenericIntervals())
+	assert.Equal(t, []int{1, 3, 5, 7}, chord.DiminishedSeventh.GenericIntervals())
+	assert.Equal(t, []int{1, 2, 3, 5}, chord.MajorAddSharpNinth.GenericIntervals())
+	assert.Equal(t, []int{1, 4, 5}, chord.Lydian.GenericIntervals())
+
+	for _, v := range chord.AllQualities() {
+		assert.Len(t, v.GenericIntervals(), v.Cardinality())
+	}
+}
+
+func TestFromString(t *testing.T) {
+	assert.Equal(t, chord.DominantSeventh, chord.FromString("DominantSeventh"))
+	assert.Equal(t, chord.Invalid, chord.FromString("Unknown"))
+}
+
+func TestQuality_Intervals(t *testing.T) {
+	assert.Equal(t, []int{0, 4, 7}, chord.Major.Intervals())
+	assert.Equal(t, []int{0, 4, 7, 10, 14, 21}, chord.DominantThirteenth.Intervals())
+	assert.Equal(t, []int{0, 2, 4, 7, 9, 10}, chord.DominantThirteenth.PitchClass())
+	assert.False(t, chord.MajorAddNinth.Extended())
+	assert.True(t, chord.DominantNinth.Extended())
+
+	// tensions are voiced above the octave
+	assert.Equal(t, []pitch.Note{
+		pitch.NewNote(pitch.CNatural, 4),
+		pitch.NewNote(pitch.ENatural, 4),
+		pitch.NewNote(pitch.GNatural, 4),
+		pitch.NewNote(pitch.ASharp, 4),
+		pitch.NewNote(pitch.CSharp, 5),
+		pitch.NewNote(pitch.GSharp, 5),
+	}, chord.DominantSeventhFlatNinthFlatThirteenth.Notes(pitch.CNatural, 4))
+
+	// every quality has distinct pitch class set, so it maps onto a single quality for key membership
+	seen := make(map[int]chord.Quality)
+	for _, q := range chord.AllQualities() {
+		_, found := seen[q.ZeitlerNumber()]
+		assert.False(t, found, q.String())
+		assert.Equal(t, pitch.Slice(q.Pitches(pitch.CNatural)).ZeitlerSignature(), q.ZeitlerNumber(), q.String())
+		assert.Equal(t, len(q.Intervals()), q.Cardinality(), q.String())
+		seen[q.ZeitlerNumber()] = q
+	}
+}
@@ -0,0 +1,187 @@
+package chord
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+)
+
+// Inversion is a type for chord inversion, the index of chord pitch placed in the bass counted from the root
+type Inversion int
+
+// Chord inversion enumerations
+const (
+	RootPosition    Inversion = 0
+	FirstInversion  Inversion = 1
+	SecondInversion Inversion = 2
+	ThirdInversion  Inversion = 3
+	FourthInversion Inversion = 4
+	FifthInversion  Inversion = 5
+	SixthInversion  Inversion = 6
+)
+
+// String returns inversion name
+func (i Inversion) String() string {
+	if i < RootPosition || i > SixthInversion {
+		return "Invalid"
+	}
+
+	return [...]string{
+		"RootPosition",
+		"FirstInversion",
+		"SecondInversion",
+		"ThirdInversion",
+		"FourthInversion",
+		"FifthInversion",
+		"SixthInversion",
+	}[i]
+}
+
+// Chord is a chord quality built from a root with a bass pitch. The bass is the root in root position or another
+// chord pitch, making the chord an inversion written as slash chord such as C/E.
+type Chord struct {
+	Quality Quality
+	Root    pitch.Type
+	Bass    pitch.Type
+}
+
+// New returns chord in root position
+func New(q Quality, root pitch.Type) Chord {
+	return Chord{Quality: q, Root: root, Bass: root}
+}
+
+// NewInversion returns inverted chord, valid is false when the quality has no such inversion
+func NewInversion(q Quality, root pitch.Type, inversion Inversion) (Chord, bool) {
+	pitches := q.Pitches(root)
+	if inversion < RootPosition || int(inversion) >= len(pitches) {
+		return Chord{}, false
+	}
+
+	return Chord{Quality: q, Root: root, Bass: pitches[inversion]}, true
+}
+
+// NewSlash returns chord with given bass, such as C/E or Am7/G, valid is false when the bass is not a chord pitch
+func NewSlash(q Quality, root pitch.Type, bass pitch.Type) (Chord, bool) {
+	c := Chord{Quality: q, Root: root, Bass: bass}
+	if _, found := c.Inversion(); !found {
+		return Chord{}, false
+	}
+
+	return c, true
+}
+
+// Inversion returns chord inversion, found is false when the bass is not a chord pitch
+func (c Chord) Inversion() (Inversion, bool) {
+	if !c.IsSlash() {
+		return RootPosition, true
+	}
+
+	for i, v := range c.Quality.Pitches(c.Root) {
+		if v == c.Bass {
+			return Inversion(i), true
+		}
+	}
+
+	return 0, false
+}
+
+// IsSlash returns true when the bass is set to other pitch than the root
+func (c Chord) IsSlash() bool {
+	return c.Bass >= pitch.CNatural && c.Bass <= pitch.BNatural && c.Bass != c.Root
+}
+
+// Inversions returns the chord in root position followed by all of its inversions
+func (q Quality) Inversions(root pitch.Type) []Chord {
+	chords := make([]Chord, 0)
+	for i := range q.Pitches(root) {
+		if c, ok := NewInversion(q, root, Inversion(i)); ok {
+			chords = append(chords, c)
+		}
+	}
+
+	return chords
+}
+
+// Pitches returns chord pitches in ascending order starting from the bass. Chord without valid bass
+// is treated as root position.
+func (c Chord) Pitches() []pitch.Type {
+	chordPitches := c.Quality.Pitches(c.Root)
+	if c.Bass < pitch.CNatural || c.Bass > pitch.BNatural || len(chordPitches) == 0 {
+		return chordPitches
+	}
+
+	pitches := []pitch.Type{c.Bass}
+	for i := 1; i < 12; i++ {
+		p := c.Bass.Transpose(i)
+		for _, v := range chordPitches {
+			if v == p {
+				pitches = append(pitches, p)
+			}
+		}
+	}
+
+	return pitches
+}
+
+// Notes returns chord notes, where the bass is placed at given octave. The root position voicing is kept and notes
+// sounding at or below the bass are raised by octaves, so tensions of extended chords stay above the chord tones.
+func (c Chord) Notes(octave int) []pitch.Note {
+	if !c.IsSlash() {
+		return c.Quality.Notes(c.Root, octave)
+	}
+
+	bass := pitch.NewNote(c.Bass, octave)
+	notes := []pitch.Note{bass}
+	for _, v := range c.Quality.Notes(c.Root, octave) {
+		if v.Pitch == c.Bass {
+			continue
+		}
+
+		for v.Midi() <= bass.Midi() {
+			v.Octave++
+		}
+
+		notes = append(notes, v)
+	}
+
+	sort.SliceStable(notes, func(i, j int) bool {
+		return notes[i].Midi() < notes[j].Midi()
+	})
+
+	return notes
+}
+
+// Name returns chord name, such as "CNaturalMajor" for root position or "CNaturalMajorOverENatural" otherwise
+func (c Chord) Name() string {
+	if !c.IsSlash() {
+		return fmt.Sprintf("%s%s", c.Root.String(), c.Quality.String())
+	}
+
+	return fmt.Sprintf("%s%sOver%s", c.Root.String(), c.Quality.String(), c.Bass.String())
+}
+
+// FromName returns chord from its name, such as "CNaturalMajor" or "CNaturalMajorOverENatural", found is false when
+// the name does not refer to a chord or its bass is not a chord pitch
+func FromName(name string) (Chord, bool) {
+	name, bassName, slash := strings.Cut(name, "Over")
+	for _, root := range pitch.AllPitches() {
+		quality, found := strings.CutPrefix(name, root.String())
+		if !found || FromString(quality) == Invalid {
+			continue
+		}
+
+		if !slash {
+			return New(FromString(quality), root), true
+		}
+
+		for _, bass := range pitch.AllPitches() {
+			if bass.String() == bassName {
+				return NewSlash(FromString(quality), root, bass)
+			}
+		}
+	}
+
+	return Chord{}, false
+}
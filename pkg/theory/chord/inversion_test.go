@@ -0,0 +1,90 @@
+package chord_test
+
+import (
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChord_Inversions(t *testing.T) {
+	chords := chord.MajorSeventh.Inversions(pitch.CNatural)
+	require.Len(t, chords, 4)
+
+	expected := [][]pitch.Type{
+		{pitch.CNatural, pitch.ENatural, pitch.GNatural, pitch.BNatural},
+		{pitch.ENatural, pitch.GNatural, pitch.BNatural, pitch.CNatural},
+		{pitch.GNatural, pitch.BNatural, pitch.CNatural, pitch.ENatural},
+		{pitch.BNatural, pitch.CNatural, pitch.ENatural, pitch.GNatural},
+	}
+
+	for i, c := range chords {
+		inversion, ok := c.Inversion()
+		assert.True(t, ok)
+		assert.Equal(t, chord.Inversion(i), inversion)
+		assert.Equal(t, expected[i], c.Pitches())
+	}
+
+	assert.Equal(t, "CNaturalMajorSeventh", chords[0].Name())
+	assert.Equal(t, "CNaturalMajorSeventhOverENatural", chords[1].Name())
+	assert.Equal(t, "FirstInversion", chord.FirstInversion.String())
+
+	_, ok := chord.NewInversion(chord.Major, pitch.CNatural, chord.ThirdInversion)
+	assert.False(t, ok)
+}
+
+func TestChord_Slash(t *testing.T) {
+	// Am7/G is third inversion
+	c, ok := chord.NewSlash(chord.MinorSeventh, pitch.ANatural, pitch.GNatural)
+	require.True(t, ok)
+	inversion, ok := c.Inversion()
+	assert.True(t, ok)
+	assert.Equal(t, chord.ThirdInversion, inversion)
+	assert.Equal(t, []pitch.Note{
+		pitch.NewNote(pitch.GNatural, 3),
+		pitch.NewNote(pitch.ANatural, 3),
+		pitch.NewNote(pitch.CNatural, 4),
+		pitch.NewNote(pitch.ENatural, 4),
+	}, c.Notes(3))
+
+	// C/D has a bass outside the chord
+	_, ok = chord.NewSlash(chord.Major, pitch.CNatural, pitch.DNatural)
+	assert.False(t, ok)
+
+	// chord without bass is in root position
+	c = chord.Chord{Quality: chord.Major, Root: pitch.GNatural}
+	inversion, ok = c.Inversion()
+	assert.True(t, ok)
+	assert.Equal(t, chord.RootPosition, inversion)
+	assert.Equal(t, []pitch.Type{pitch.GNatural, pitch.BNatural, pitch.DNatural}, c.Pitches())
+}
+
+func TestChord_NotesOfExtendedChord(t *testing.T) {
+	// C9/E keeps the ninth above the root
+	c, ok := chord.NewSlash(chord.DominantNinth, pitch.CNatural, pitch.ENatural)
+	require.True(t, ok)
+	assert.Equal(t, []pitch.Note{
+		pitch.NewNote(pitch.ENatural, 3),
+		pitch.NewNote(pitch.GNatural, 3),
+		pitch.NewNote(pitch.ASharp, 3),
+		pitch.NewNote(pitch.CNatural, 4),
+		pitch.NewNote(pitch.DNatural, 4),
+	}, c.Notes(3))
+}
+
+func TestFromName(t *testing.T) {
+	for _, q := range chord.AllQualities() {
+		for _, c := range q.Inversions(pitch.FSharp) {
+			parsed, found := chord.FromName(c.Name())
+			require.True(t, found, c.Name())
+			assert.Equal(t, c, parsed)
+		}
+	}
+
+	for _, name := range []string{"", "CNatural", "HNaturalMajor", "CNaturalMajorOver", "CNaturalMajorOverHNatural", "CNaturalMajorOverDNatural"} {
+		_, found := chord.FromName(name)
+		assert.False(t, found, name)
+	}
+}
@@ -0,0 +1,64 @@
+package chord
+
+import "strings"
+
+// RomanNumeral returns Roman numeral of a chord built on given scale degree, starting from 1 for the tonic. Chords
+// with a minor third and no major third are written in lower case, such as "ii7", "viiø7" or "III+". Empty string is
+// returned for degrees outside 1 to 12 or invalid qualities.
+func (q Quality) RomanNumeral(degree int) string {
+	if degree < 1 || degree > 12 || q < Major || q > MinorNinthFlatFifth {
+		return ""
+	}
+
+	numeral := [...]string{"", "I", "II", "III", "IV", "V", "VI", "VII", "VIII", "IX", "X", "XI", "XII"}[degree]
+	if q.minorThird() {
+		numeral = strings.ToLower(numeral)
+	}
+
+	return numeral + q.numeralSuffix()
+}
+
+// minorThird returns true when chord has minor third without major third
+func (q Quality) minorThird() bool {
+	var minor, major bool
+	for _, v := range q.PitchClass() {
+		switch v {
+		case 3:
+			minor = true
+		case 4:
+			major = true
+		}
+	}
+
+	return minor && !major
+}
+
+// numeralSuffix returns quality suffix written after Roman numeral, the case of the numeral already tells whether
+// the third is major or minor
+func (q Quality) numeralSuffix() string {
+	switch q {
+	case Major, Minor:
+		return ""
+	case Diminished:
+		return "°"
+	case Augmented:
+		return "+"
+	case DiminishedSeventh:
+		return "°7"
+	case MinorSeventhFlatFifth:
+		return "ø7"
+	case MinorNinthFlatFifth:
+		return "ø9"
+	case AugmentedSeventh:
+		return "+7"
+	case AugmentedMajorSeventh:
+		return "+maj7"
+	}
+
+	symbol := q.Symbol()
+	if q.minorThird() && strings.HasPrefix(symbol, "m") && !strings.HasPrefix(symbol, "maj") {
+		return strings.TrimPrefix(symbol, "m")
+	}
+
+	return symbol
+}
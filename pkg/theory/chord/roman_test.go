@@ -0,0 +1,39 @@
+package chord_test
+
+import (
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuality_RomanNumeral(t *testing.T) {
+	type testCase struct {
+		Quality  chord.Quality
+		Degree   int
+		Expected string
+	}
+
+	testCases := []testCase{
+		{Quality: chord.Major, Degree: 1, Expected: "I"},
+		{Quality: chord.Minor, Degree: 2, Expected: "ii"},
+		{Quality: chord.Augmented, Degree: 3, Expected: "III+"},
+		{Quality: chord.Diminished, Degree: 7, Expected: "vii°"},
+		{Quality: chord.DominantSeventh, Degree: 5, Expected: "V7"},
+		{Quality: chord.MajorSeventh, Degree: 4, Expected: "IVmaj7"},
+		{Quality: chord.MinorSeventh, Degree: 6, Expected: "vi7"},
+		{Quality: chord.MinorSeventhFlatFifth, Degree: 7, Expected: "viiø7"},
+		{Quality: chord.DiminishedSeventh, Degree: 7, Expected: "vii°7"},
+		{Quality: chord.MinorMajorSeventh, Degree: 1, Expected: "i(maj7)"},
+		{Quality: chord.MinorNinth, Degree: 2, Expected: "ii9"},
+		{Quality: chord.MajorNinth, Degree: 1, Expected: "Imaj9"},
+		{Quality: chord.MajorSuspendedFourth, Degree: 5, Expected: "Vsus4"},
+		{Quality: chord.Major, Degree: 12, Expected: "XII"},
+		{Quality: chord.Major, Degree: 13, Expected: ""},
+		{Quality: chord.Invalid, Degree: 1, Expected: ""},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.Expected, tc.Quality.RomanNumeral(tc.Degree), "%s on degree %d", tc.Quality, tc.Degree)
+	}
+}
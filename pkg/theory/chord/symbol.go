@@ -0,0 +1,380 @@
+package chord
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+)
+
+// ErrInvalidSymbol is returned when a chord symbol can not be parsed
+var ErrInvalidSymbol = errors.New("invalid chord symbol")
+
+// ErrUnsupportedSymbol is returned when a chord symbol is well-formed but has no matching chord quality
+var ErrUnsupportedSymbol = errors.New("unsupported chord symbol")
+
+// ErrNonChordBass is returned when bass of a slash chord symbol is not a chord pitch, such as C/D
+var ErrNonChordBass = errors.New("slash chord bass is not a chord pitch")
+
+// Symbol returns chord quality symbol as written after the root in lead sheets, such as "m7b5"
+func (q Quality) Symbol() string {
+	if q < Major || q > MinorNinthFlatFifth {
+		return ""
+	}
+
+	return [...]string{
+		"",
+		"", "m", "5", "dim", "aug", "sus2", "sus4", "(b5)", "(##5)", "m#5",
+		"mbb5", "sus2b5", "sus2bb5", "sus2#5", "sus4b5", "sus4#5", "sus4(##5)", "(b5,#5,no3)", "sus2sus4", "susb2",
+		"sus#4", "susb2b5", "7sus4no5", "maj7sus4no5", "7", "m7", "maj7", "m(maj7)", "dim7", "m7b5",
+		"7#5", "maj7#5", "7b5", "7sus2", "7sus4", "7sus2b5", "7sus2#5", "7sus4b5", "7sus4#5", "9sus4",
+		"maj7sus2", "maj7sus2b5", "maj7sus4", "maj9sus4", "m7#5", "m7bb5", "m(maj7)#5", "mbb5bb7", "maj7b5", "maj7(##5)",
+		"dim(maj7)", "maj7sus4#5", "maj7sus4(##5)", "maj7susb2", "maj7sus#4", "add4", "madd4", "7add11", "maj7add11", "add#11",
+		"madd#11", "7#11", "maj7#11", "sus2b5#5", "6", "m6", "6b5", "6sus2", "6sus4", "6sus2b5",
+		"6sus2bb5", "add9", "madd9", "6/9", "m6/9", "addb9", "maddb9", "add#9",
+		"9", "maj9", "m9", "m(maj9)", "11", "m11", "13", "maj13", "m13", "7b9",
+		"7#9", "7b13", "7b9#9", "7b9b13", "7#9b13", "7alt", "9#11", "13b9", "13#11", "maj9#11",
+		"m9b5",
+	}[q]
+}
+
+// Format returns chord symbol, such as "C#m7b5" or "Am7/G". Roots and basses are written with sharps, spelling.Symbol
+// spells them as chord pitches instead.
+func Format(c Chord) string {
+	if c.Root < pitch.CNatural || c.Root > pitch.BNatural || c.Quality.ZeitlerNumber() == 0 {
+		return ""
+	}
+
+	symbol := symbolPitchName(c.Root) + c.Quality.Symbol()
+	if c.IsSlash() {
+		symbol += "/" + symbolPitchName(c.Bass)
+	}
+
+	return symbol
+}
+
+func symbolPitchName(p pitch.Type) string {
+	return [...]string{"", "C", "C#", "D", "D#", "E", "F", "F#", "G", "G#", "A", "A#", "B"}[p]
+}
+
+// Parse parses chord symbol such as "C7sus4b5", "Bbmaj7", "F#ø", "Eb°7", "G+", "Dadd9", "C6/9" or "Am7/G".
+//
+// The suffix after the root is read as a triad (major, "m", "dim", "aug", "5", "sus2", "sus4"), followed by an optional
+// extension ("6", "7", "9", "11", "13", "6/9" or "maj7") and alterations ("b5", "#5", "b9", "#9", "#11", "b13", "alt",
+// "add9", "no3" and so on), parentheses and commas are ignored. Altered tensions replace natural tensions implied by
+// the extension, so "13b9" has no natural ninth. The resulting pitch set is matched against chord qualities,
+// ErrUnsupportedSymbol is returned when none matches. Slash chords are inversions, ErrNonChordBass is returned when the
+// bass is not a chord pitch.
+func Parse(symbol string) (Chord, error) {
+	root, rest, ok := parseSymbolPitch(normalizeSymbol(symbol))
+	if !ok {
+		return Chord{}, ErrInvalidSymbol
+	}
+
+	// grouping is dropped only after the root is read, so "B(b5)" is not mistaken for "Bb5"
+	rest = strings.NewReplacer("(", "", ")", "", ",", "", " ", "").Replace(rest)
+
+	bass := root
+	if i := strings.LastIndex(rest, "/"); i >= 0 {
+		if p, remaining, ok := parseSymbolPitch(rest[i+1:]); ok && remaining == "" {
+			bass = p
+			rest = rest[:i]
+		}
+	}
+
+	s, err := parseSuffix(rest)
+	if err != nil {
+		return Chord{}, err
+	}
+
+	pitches := make(pitch.Slice, 0)
+	for _, v := range s.intervals() {
+		pitches = append(pitches, pitch.CNatural.Transpose(v))
+	}
+
+	signature := pitches.ZeitlerSignature()
+	for _, q := range AllQualities() {
+		if q.ZeitlerNumber() == signature {
+			c, ok := NewSlash(q, root, bass)
+			if !ok {
+				return Chord{}, ErrNonChordBass
+			}
+
+			return c, nil
+		}
+	}
+
+	return Chord{}, ErrUnsupportedSymbol
+}
+
+// normalizeSymbol replaces unicode accidentals and symbols with their ASCII counterparts
+func normalizeSymbol(symbol string) string {
+	return strings.NewReplacer(
+		"♭", "b",
+		"♯", "#",
+		"𝄪", "##",
+		"−", "-",
+		"Δ", "^",
+		"△", "^",
+		"°", "o",
+		"ø", "h",
+		"Ø", "h",
+	).Replace(strings.TrimSpace(symbol))
+}
+
+// parseSymbolPitch parses pitch name with at most one accidental at the beginning of given string
+func parseSymbolPitch(s string) (pitch.Type, string, bool) {
+	if s == "" {
+		return pitch.Invalid, s, false
+	}
+
+	semitone, found := map[byte]int{'C': 0, 'D': 2, 'E': 4, 'F': 5, 'G': 7, 'A': 9, 'B': 11}[s[0]]
+	if !found {
+		return pitch.Invalid, s, false
+	}
+
+	s = s[1:]
+	switch {
+	case strings.HasPrefix(s, "#"):
+		semitone++
+		s = s[1:]
+	case strings.HasPrefix(s, "b"):
+		semitone--
+		s = s[1:]
+	}
+
+	return pitch.CNatural.Transpose(semitone), s, true
+}
+
+// chordStructure holds chord tones as semitones above the root while a suffix is being parsed
+type chordStructure struct {
+	third       int   // third above the root, zero when omitted
+	fifths      []int // fifths above the root, empty when omitted
+	seventh     int   // seventh above the root, zero when omitted
+	ninths      []int // ninths above the root as compound intervals
+	elevenths   []int // elevenths above the root as compound intervals
+	thirteenths []int // thirteenths above the root as compound intervals
+	tones       []int // added tones
+}
+
+func (s chordStructure) intervals() []int {
+	set := map[int]struct{}{0: {}}
+	tones := []int{s.third, s.seventh}
+	for _, v := range [][]int{s.fifths, s.ninths, s.elevenths, s.thirteenths, s.tones} {
+		tones = append(tones, v...)
+	}
+
+	for _, v := range tones {
+		if v > 0 {
+			set[v%12] = struct{}{}
+		}
+	}
+
+	intervals := make([]int, 0)
+	for v := range set {
+		intervals = append(intervals, v)
+	}
+
+	sort.Ints(intervals)
+	return intervals
+}
+
+// consume returns the first of given prefixes s starts with, along with the remaining string
+func consume(s string, prefixes ...string) (string, string, bool) {
+	for _, v := range prefixes {
+		if strings.HasPrefix(s, v) {
+			return v, s[len(v):], true
+		}
+	}
+
+	return "", s, false
+}
+
+func parseSuffix(suffix string) (chordStructure, error) {
+	s := chordStructure{third: 4, fifths: []int{7}}
+	rest := suffix
+
+	// triad
+	majorSeventh := false
+	diminished := false
+	if token, remaining, ok := consume(rest, "maj", "Maj", "M", "^", "min", "mi", "m", "-", "dim", "o", "h", "aug", "+"); ok && !strings.HasPrefix(rest, "omit") {
+		rest = remaining
+		switch token {
+		case "maj", "Maj", "M":
+			majorSeventh = true
+		case "^":
+			majorSeventh = true
+			if !startsWithExtension(rest) {
+				s.seventh = 11
+			}
+		case "min", "mi", "m", "-":
+			s.third = 3
+		case "dim", "o":
+			s.third, s.fifths, diminished = 3, []int{6}, true
+		case "h":
+			s.third, s.fifths, s.seventh = 3, []int{6}, 10
+			_, rest, _ = consume(rest, "7")
+		case "aug", "+":
+			s.fifths = []int{8}
+		}
+	}
+
+	if rest == "5" && !majorSeventh {
+		return chordStructure{fifths: []int{7}}, nil
+	}
+
+	// major seventh written after minor or diminished triad, such as "mMaj7" or "dim(maj7)"
+	if !majorSeventh && s.seventh == 0 {
+		if _, remaining, ok := consume(rest, "maj", "Maj", "M", "^"); ok {
+			majorSeventh = true
+			rest = remaining
+		}
+	}
+
+	// extension
+	seventh := 10
+	switch {
+	case majorSeventh:
+		seventh = 11
+	case diminished:
+		seventh = 9
+	}
+
+	if token, remaining, ok := consume(rest, "6/9", "69", "6", "7", "9", "11", "13"); ok {
+		rest = remaining
+		switch token {
+		case "6/9", "69":
+			s.tones = append(s.tones, 9, 2)
+		case "6":
+			s.tones = append(s.tones, 9)
+		case "7":
+			s.seventh = seventh
+		case "9":
+			s.seventh = seventh
+			s.ninths = []int{14}
+		case "11":
+			s.seventh = seventh
+			s.ninths, s.elevenths = []int{14}, []int{17}
+		case "13":
+			// eleventh is omitted as it clashes with major third
+			s.seventh = seventh
+			s.ninths, s.thirteenths = []int{14}, []int{21}
+			if s.third == 3 {
+				s.elevenths = []int{17}
+			}
+		}
+	}
+
+	// modifiers
+	alteredFifths := make([]int, 0)
+	alteredNinths := make([]int, 0)
+	alteredElevenths := make([]int, 0)
+	alteredThirteenths := make([]int, 0)
+	for rest != "" {
+		token, remaining, ok := consume(rest,
+			"sus2sus4", "sus24", "susb2", "sus#4", "sus2", "sus4", "sus",
+			"no3", "no5", "omit3", "omit5",
+			"bb5", "##5", "x5", "b5", "#5", "-5", "+5",
+			"bb7",
+			"b9", "#9", "#11", "b13", "b6",
+			"add", "aug", "alt",
+		)
+		if !ok {
+			return chordStructure{}, ErrInvalidSymbol
+		}
+
+		rest = remaining
+		switch token {
+		case "sus2sus4", "sus24":
+			s.third = 0
+			s.tones = append(s.tones, 2, 5)
+		case "susb2":
+			s.third = 0
+			s.tones = append(s.tones, 1)
+		case "sus#4":
+			s.third = 0
+			s.tones = append(s.tones, 6)
+		case "sus2":
+			s.third = 0
+			s.tones = append(s.tones, 2)
+		case "sus4", "sus":
+			s.third = 0
+			s.tones = append(s.tones, 5)
+		case "no3", "omit3":
+			s.third = 0
+		case "no5", "omit5":
+			s.fifths = nil
+		case "bb5":
+			alteredFifths = append(alteredFifths, 5)
+		case "##5", "x5":
+			alteredFifths = append(alteredFifths, 9)
+		case "b5", "-5":
+			alteredFifths = append(alteredFifths, 6)
+		case "#5", "+5", "aug":
+			alteredFifths = append(alteredFifths, 8)
+		case "bb7":
+			s.seventh = 9
+		case "b9":
+			alteredNinths = append(alteredNinths, 13)
+		case "#9":
+			alteredNinths = append(alteredNinths, 15)
+		case "#11":
+			alteredElevenths = append(alteredElevenths, 18)
+		case "b13":
+			alteredThirteenths = append(alteredThirteenths, 20)
+		case "b6":
+			s.tones = append(s.tones, 8)
+		case "alt":
+			// altered dominant has both altered fifths and altered ninths
+			alteredFifths = append(alteredFifths, 6, 8)
+			alteredNinths = append(alteredNinths, 13, 15)
+		case "add":
+			tone, remaining, err := parseAddedTone(rest)
+			if err != nil {
+				return chordStructure{}, err
+			}
+
+			rest = remaining
+			s.tones = append(s.tones, tone)
+		}
+	}
+
+	// altered fifths replace the perfect fifth, unless the fifth is omitted
+	if len(alteredFifths) > 0 && len(s.fifths) > 0 {
+		s.fifths = alteredFifths
+	}
+
+	// altered tensions replace natural tensions implied by the extension
+	if len(alteredNinths) > 0 {
+		s.ninths = alteredNinths
+	}
+
+	if len(alteredElevenths) > 0 {
+		s.elevenths = alteredElevenths
+	}
+
+	if len(alteredThirteenths) > 0 {
+		s.thirteenths = alteredThirteenths
+	}
+
+	return s, nil
+}
+
+// parseAddedTone parses degree following "add", such as "9", "b9", "#11" or "13"
+func parseAddedTone(s string) (int, string, error) {
+	token, remaining, ok := consume(s, "b9", "#9", "#11", "#4", "b13", "b6", "11", "13", "2", "4", "6", "9")
+	if !ok {
+		return 0, s, ErrInvalidSymbol
+	}
+
+	return map[string]int{
+		"b9": 1, "#9": 3, "#11": 6, "#4": 6, "b13": 8, "b6": 8,
+		"11": 5, "13": 9, "2": 2, "4": 5, "6": 9, "9": 2,
+	}[token], remaining, nil
+}
+
+func startsWithExtension(s string) bool {
+	_, _, ok := consume(s, "6", "7", "9", "11", "13")
+	return ok
+}
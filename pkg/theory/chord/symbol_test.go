@@ -0,0 +1,114 @@
+package chord_test
+
+import (
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	type testCase struct {
+		Symbol  string
+		Quality chord.Quality
+		Root    pitch.Type
+		Bass    pitch.Type
+	}
+
+	testCases := []testCase{
+		{Symbol: "C", Quality: chord.Major, Root: pitch.CNatural},
+		{Symbol: "Am", Quality: chord.Minor, Root: pitch.ANatural},
+		{Symbol: "E-", Quality: chord.Minor, Root: pitch.ENatural},
+		{Symbol: "G5", Quality: chord.Power, Root: pitch.GNatural},
+		{Symbol: "Bdim", Quality: chord.Diminished, Root: pitch.BNatural},
+		{Symbol: "Ab+", Quality: chord.Augmented, Root: pitch.GSharp},
+		{Symbol: "Dsus", Quality: chord.MajorSuspendedFourth, Root: pitch.DNatural},
+		{Symbol: "Dsus2", Quality: chord.MajorSuspendedSecond, Root: pitch.DNatural},
+		{Symbol: "G7", Quality: chord.DominantSeventh, Root: pitch.GNatural},
+		{Symbol: "Bbmaj7", Quality: chord.MajorSeventh, Root: pitch.ASharp},
+		{Symbol: "FΔ", Quality: chord.MajorSeventh, Root: pitch.FNatural},
+		{Symbol: "C-7", Quality: chord.MinorSeventh, Root: pitch.CNatural},
+		{Symbol: "Cmi7", Quality: chord.MinorSeventh, Root: pitch.CNatural},
+		{Symbol: "Cm(maj7)", Quality: chord.MinorMajorSeventh, Root: pitch.CNatural},
+		{Symbol: "CmM7", Quality: chord.MinorMajorSeventh, Root: pitch.CNatural},
+		{Symbol: "F#m7b5", Quality: chord.MinorSeventhFlatFifth, Root: pitch.FSharp},
+		{Symbol: "F♯ø", Quality: chord.MinorSeventhFlatFifth, Root: pitch.FSharp},
+		{Symbol: "E♭°7", Quality: chord.DiminishedSeventh, Root: pitch.DSharp},
+		{Symbol: "Cdim(maj7)", Quality: chord.DiminishedMajorSeventh, Root: pitch.CNatural},
+		{Symbol: "C7#5", Quality: chord.AugmentedSeventh, Root: pitch.CNatural},
+		{Symbol: "C+7", Quality: chord.AugmentedSeventh, Root: pitch.CNatural},
+		{Symbol: "C7(b5)", Quality: chord.DominantSeventhFlatFifth, Root: pitch.CNatural},
+		{Symbol: "C7sus4b5", Quality: chord.DominantSeventhSuspendedFourthFlatFifth, Root: pitch.CNatural},
+		{Symbol: "C6", Quality: chord.MajorAddSixth, Root: pitch.CNatural},
+		{Symbol: "C6/9", Quality: chord.MajorAddSixthAddNinth, Root: pitch.CNatural},
+		{Symbol: "Cm69", Quality: chord.MinorAddSixthAddNinth, Root: pitch.CNatural},
+		{Symbol: "Cadd9", Quality: chord.MajorAddNinth, Root: pitch.CNatural},
+		{Symbol: "Cmadd9", Quality: chord.MinorAddNinth, Root: pitch.CNatural},
+		{Symbol: "Cadd#11", Quality: chord.MajorAddSharpFourth, Root: pitch.CNatural},
+		{Symbol: "C/E", Quality: chord.Major, Root: pitch.CNatural, Bass: pitch.ENatural},
+		{Symbol: "Am7/G", Quality: chord.MinorSeventh, Root: pitch.ANatural, Bass: pitch.GNatural},
+		{Symbol: "C6/9/E", Quality: chord.MajorAddSixthAddNinth, Root: pitch.CNatural, Bass: pitch.ENatural},
+		{Symbol: "C13", Quality: chord.DominantThirteenth, Root: pitch.CNatural},
+		{Symbol: "C7#9b13", Quality: chord.DominantSeventhSharpNinthFlatThirteenth, Root: pitch.CNatural},
+		{Symbol: "Cm11", Quality: chord.MinorEleventh, Root: pitch.CNatural},
+		{Symbol: "G7alt", Quality: chord.DominantSeventhAltered, Root: pitch.GNatural},
+		{Symbol: "G7(b9,#9,b5,#5)", Quality: chord.DominantSeventhAltered, Root: pitch.GNatural},
+		{Symbol: "F13(b9)", Quality: chord.DominantThirteenthFlatNinth, Root: pitch.FNatural},
+		{Symbol: "Dbmaj9#11", Quality: chord.MajorNinthSharpEleventh, Root: pitch.CSharp},
+		{Symbol: "C9/E", Quality: chord.DominantNinth, Root: pitch.CNatural, Bass: pitch.ENatural},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Symbol, func(t *testing.T) {
+			c, err := chord.Parse(tc.Symbol)
+			require.NoError(t, err)
+
+			bass := tc.Bass
+			if bass == pitch.Invalid {
+				bass = tc.Root
+			}
+
+			assert.Equal(t, chord.Chord{Quality: tc.Quality, Root: tc.Root, Bass: bass}, c)
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, symbol := range []string{"", "H7", "Cfoo", "C7/", "Cadd"} {
+		_, err := chord.Parse(symbol)
+		assert.ErrorIs(t, err, chord.ErrInvalidSymbol, symbol)
+	}
+
+	_, err := chord.Parse("Cmaj7b9#9")
+	assert.ErrorIs(t, err, chord.ErrUnsupportedSymbol)
+
+	// slash chords are inversions
+	for _, symbol := range []string{"C/D", "Am7/F", "G7/Ab"} {
+		_, err = chord.Parse(symbol)
+		assert.ErrorIs(t, err, chord.ErrNonChordBass, symbol)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	assert.Equal(t, "C#m7b5", chord.Format(chord.New(chord.MinorSeventhFlatFifth, pitch.CSharp)))
+	assert.Equal(t, "Am7/G", chord.Format(chord.Chord{Quality: chord.MinorSeventh, Root: pitch.ANatural, Bass: pitch.GNatural}))
+	assert.Equal(t, "", chord.Format(chord.New(chord.Invalid, pitch.CNatural)))
+
+	// extensions are written as on lead sheets
+	for _, symbol := range []string{"C9sus4", "C7#11", "Cmaj7#11", "Cmaj9sus4", "Cadd#11", "C7add11"} {
+		c, err := chord.Parse(symbol)
+		require.NoError(t, err)
+		assert.Equal(t, symbol, chord.Format(c))
+	}
+
+	// every quality symbol parses back into the same quality
+	for _, q := range chord.AllQualities() {
+		for _, root := range pitch.AllPitches() {
+			c, err := chord.Parse(chord.Format(chord.New(q, root)))
+			require.NoError(t, err, q.String())
+			assert.Equal(t, chord.New(q, root), c, chord.Format(chord.New(q, root)))
+		}
+	}
+}
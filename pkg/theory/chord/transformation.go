@@ -0,0 +1,189 @@
+package chord
+
+import (
+	"strings"
+
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+)
+
+// Transformation is a type for neo-Riemannian transformation, mapping a major or minor triad into another triad
+type Transformation int
+
+// Neo-Riemannian transformation enumerations
+const (
+	InvalidTransformation Transformation = iota
+	Parallel              Transformation = iota
+	LeadingToneExchange   Transformation = iota
+	Relative              Transformation = iota
+	Nebenverwandt         Transformation = iota
+	Slide                 Transformation = iota
+	HexatonicPole         Transformation = iota
+)
+
+// AllTransformations returns all transformations, starting from the primary P, L and R
+func AllTransformations() []Transformation {
+	return []Transformation{
+		Parallel,
+		LeadingToneExchange,
+		Relative,
+		Nebenverwandt,
+		Slide,
+		HexatonicPole,
+	}
+}
+
+// PrimaryTransformations returns P, L and R transformations, all other transformations are compounds of these
+func PrimaryTransformations() []Transformation {
+	return []Transformation{
+		Parallel,
+		LeadingToneExchange,
+		Relative,
+	}
+}
+
+// String returns transformation name
+func (t Transformation) String() string {
+	if t < Parallel || t > HexatonicPole {
+		return "Invalid"
+	}
+
+	return [...]string{
+		"Invalid",
+		"Parallel",
+		"LeadingToneExchange",
+		"Relative",
+		"Nebenverwandt",
+		"Slide",
+		"HexatonicPole",
+	}[t]
+}
+
+// Symbol returns transformation letter, such as "P" for parallel
+func (t Transformation) Symbol() string {
+	if t < Parallel || t > HexatonicPole {
+		return ""
+	}
+
+	return [...]string{
+		"",
+		"P",
+		"L",
+		"R",
+		"N",
+		"S",
+		"H",
+	}[t]
+}
+
+// Compound returns primary transformations making up the transformation, applied from left to right
+func (t Transformation) Compound() []Transformation {
+	switch t {
+	case Parallel, LeadingToneExchange, Relative:
+		return []Transformation{t}
+	case Nebenverwandt:
+		return []Transformation{Relative, LeadingToneExchange, Parallel}
+	case Slide:
+		return []Transformation{LeadingToneExchange, Parallel, Relative}
+	case HexatonicPole:
+		return []Transformation{LeadingToneExchange, Parallel, LeadingToneExchange}
+	default:
+		return nil
+	}
+}
+
+// TransformationFromString returns transformation from its letter or name, such as "P" or "Parallel"
+func TransformationFromString(name string) Transformation {
+	for _, v := range AllTransformations() {
+		if name == v.Symbol() || strings.EqualFold(name, v.String()) {
+			return v
+		}
+	}
+
+	return InvalidTransformation
+}
+
+// IsTriad returns true when the chord is a major or minor triad, the only chords neo-Riemannian transformations apply to
+func (c Chord) IsTriad() bool {
+	return (c.Quality == Major || c.Quality == Minor) && c.Root >= pitch.CNatural && c.Root <= pitch.BNatural
+}
+
+// Transform returns the chord transformed in root position, valid is false when the chord is not a major or minor triad.
+// Transformations are involutions, applying the same transformation twice returns the original triad.
+func (c Chord) Transform(t Transformation) (Chord, bool) {
+	if !c.IsTriad() {
+		return Chord{}, false
+	}
+
+	// root movements of major triads, minor triads move in opposite direction
+	amount := map[Transformation]int{
+		Parallel:            0,
+		LeadingToneExchange: 4,
+		Relative:            -3,
+		Nebenverwandt:       5,
+		Slide:               1,
+		HexatonicPole:       -4,
+	}
+
+	semitones, found := amount[t]
+	if !found {
+		return Chord{}, false
+	}
+
+	if c.Quality == Major {
+		return New(Minor, c.Root.Transpose(semitones)), true
+	}
+
+	return New(Major, c.Root.Transpose(-semitones)), true
+}
+
+// Step is a transformation step, holding the transformation and the resulting chord
+type Step struct {
+	Transformation Transformation
+	Chord          Chord
+}
+
+// ShortestPath returns the fewest transformation steps leading from a triad to another, using given transformations
+// or P, L and R when none given. Found is false when either chord is not a major or minor triad, or when the target
+// is not reachable using given transformations.
+func ShortestPath(from, to Chord, transformations ...Transformation) ([]Step, bool) {
+	if !from.IsTriad() || !to.IsTriad() {
+		return nil, false
+	}
+
+	if len(transformations) == 0 {
+		transformations = PrimaryTransformations()
+	}
+
+	from, to = New(from.Quality, from.Root), New(to.Quality, to.Root)
+
+	// breadth first search, visiting transformations in given order so results are deterministic
+	previous := map[Chord]Step{from: {}}
+	queue := []Chord{from}
+	for len(queue) > 0 && queue[0] != to {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, t := range transformations {
+			next, ok := current.Transform(t)
+			if !ok {
+				continue
+			}
+
+			if _, visited := previous[next]; !visited {
+				previous[next] = Step{Transformation: t, Chord: current}
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	if _, reached := previous[to]; !reached {
+		return nil, false
+	}
+
+	steps := make([]Step, 0)
+	for current := to; current != from; current = previous[current].Chord {
+		steps = append([]Step{{Transformation: previous[current].Transformation, Chord: current}}, steps...)
+	}
+
+	return steps, true
+}
@@ -0,0 +1,110 @@
+package chord_test
+
+import (
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChord_Transform(t *testing.T) {
+	type testCase struct {
+		Transformation chord.Transformation
+		Expected       chord.Chord
+	}
+
+	testCases := []testCase{
+		{Transformation: chord.Parallel, Expected: chord.New(chord.Minor, pitch.CNatural)},
+		{Transformation: chord.LeadingToneExchange, Expected: chord.New(chord.Minor, pitch.ENatural)},
+		{Transformation: chord.Relative, Expected: chord.New(chord.Minor, pitch.ANatural)},
+		{Transformation: chord.Nebenverwandt, Expected: chord.New(chord.Minor, pitch.FNatural)},
+		{Transformation: chord.Slide, Expected: chord.New(chord.Minor, pitch.CSharp)},
+		{Transformation: chord.HexatonicPole, Expected: chord.New(chord.Minor, pitch.GSharp)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Transformation.String(), func(t *testing.T) {
+			transformed, ok := chord.New(chord.Major, pitch.CNatural).Transform(tc.Transformation)
+			require.True(t, ok)
+			assert.Equal(t, tc.Expected, transformed)
+		})
+	}
+
+	_, ok := chord.New(chord.DominantSeventh, pitch.CNatural).Transform(chord.Parallel)
+	assert.False(t, ok)
+}
+
+func TestChord_TransformIsInvolutionAndCompound(t *testing.T) {
+	for _, q := range []chord.Quality{chord.Major, chord.Minor} {
+		for _, root := range pitch.AllPitches() {
+			triad := chord.New(q, root)
+			for _, transformation := range chord.AllTransformations() {
+				transformed, ok := triad.Transform(transformation)
+				require.True(t, ok)
+
+				// transformations always swap between major and minor
+				assert.NotEqual(t, triad.Quality, transformed.Quality)
+
+				original, ok := transformed.Transform(transformation)
+				require.True(t, ok)
+				assert.Equal(t, triad, original, "%s of %s", transformation, triad.Name())
+
+				compound := triad
+				for _, v := range transformation.Compound() {
+					compound, _ = compound.Transform(v)
+				}
+				assert.Equal(t, transformed, compound, "%s of %s", transformation, triad.Name())
+			}
+		}
+	}
+}
+
+func TestTransformationFromString(t *testing.T) {
+	assert.Equal(t, chord.Parallel, chord.TransformationFromString("P"))
+	assert.Equal(t, chord.Slide, chord.TransformationFromString("slide"))
+	assert.Equal(t, chord.HexatonicPole, chord.TransformationFromString("HexatonicPole"))
+	assert.Equal(t, chord.InvalidTransformation, chord.TransformationFromString("X"))
+}
+
+func TestShortestPath(t *testing.T) {
+	cMajor := chord.New(chord.Major, pitch.CNatural)
+
+	steps, found := chord.ShortestPath(cMajor, chord.New(chord.Major, pitch.ENatural))
+	require.True(t, found)
+	assert.Equal(t, []chord.Step{
+		{Transformation: chord.LeadingToneExchange, Chord: chord.New(chord.Minor, pitch.ENatural)},
+		{Transformation: chord.Parallel, Chord: chord.New(chord.Major, pitch.ENatural)},
+	}, steps)
+
+	steps, found = chord.ShortestPath(cMajor, chord.New(chord.Minor, pitch.GSharp), chord.AllTransformations()...)
+	require.True(t, found)
+	assert.Equal(t, []chord.Step{{Transformation: chord.HexatonicPole, Chord: chord.New(chord.Minor, pitch.GSharp)}}, steps)
+
+	steps, found = chord.ShortestPath(cMajor, cMajor)
+	require.True(t, found)
+	assert.Empty(t, steps)
+
+	_, found = chord.ShortestPath(cMajor, chord.New(chord.Major, pitch.DNatural), chord.Parallel)
+	assert.False(t, found)
+
+	_, found = chord.ShortestPath(cMajor, chord.New(chord.Diminished, pitch.BNatural))
+	assert.False(t, found)
+
+	// every triad is reachable using P, L and R
+	for _, q := range []chord.Quality{chord.Major, chord.Minor} {
+		for _, root := range pitch.AllPitches() {
+			target := chord.New(q, root)
+			steps, found := chord.ShortestPath(cMajor, target)
+			require.True(t, found)
+
+			current := cMajor
+			for _, step := range steps {
+				current, _ = current.Transform(step.Transformation)
+				assert.Equal(t, step.Chord, current)
+			}
+			assert.Equal(t, target, current)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package fretboard
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+)
+
+// Muted marks a string which is not played
+const Muted = -1
+
+// maxFingers is the number of fretting fingers, a barre across the lowest fret takes a single finger
+const maxFingers = 4
+
+// Fingering is a fret for each string in the order of Tuning.Strings, where zero is an open string and Muted is a
+// string which is not played
+type Fingering []int
+
+// Notes returns sounding notes of the fingering on given tuning, in the order of strings
+func (f Fingering) Notes(t Tuning) []pitch.Note {
+	notes := make([]pitch.Note, 0)
+	for i, open := range t.Strings() {
+		if i < len(f) && f[i] != Muted {
+			notes = append(notes, open.Transpose(f[i]))
+		}
+	}
+
+	return notes
+}
+
+// Position returns the lowest fretted fret, zero when every played string is open
+func (f Fingering) Position() int {
+	position := 0
+	for _, v := range f {
+		if v > 0 && (position == 0 || v < position) {
+			position = v
+		}
+	}
+
+	return position
+}
+
+// Span returns number of frets covered by fretting fingers, zero when every played string is open
+func (f Fingering) Span() int {
+	position := f.Position()
+	if position == 0 {
+		return 0
+	}
+
+	return slices.Max(f) - position + 1
+}
+
+// Fingers returns number of fretting fingers, where strings fretted at the lowest fret are held by a single barre
+// unless an open string lies between them
+func (f Fingering) Fingers() int {
+	position := f.Position()
+	first, last := -1, -1
+	for i, v := range f {
+		if v != position {
+			continue
+		}
+
+		if first < 0 {
+			first = i
+		}
+		last = i
+	}
+
+	barre := position > 0 && first != last && !slices.Contains(f[first:last+1], 0)
+
+	fingers := 0
+	for _, v := range f {
+		if v > 0 && (v != position || !barre) {
+			fingers++
+		}
+	}
+
+	if barre {
+		fingers++
+	}
+
+	return fingers
+}
+
+// Difficulty returns playability cost of the fingering, where each fret of stretch beyond a single fret and each muted
+// string from the low side weigh twice as much as a fretting finger or a fret away from the nut, and muting high
+// strings which usually carry the melody weighs even more
+func (f Fingering) Difficulty() int {
+	muted := 0
+	for i, v := range f {
+		switch {
+		case v != Muted:
+		case slices.ContainsFunc(f[:i], func(fret int) bool { return fret != Muted }):
+			muted += 4
+		default:
+			muted += 2
+		}
+	}
+
+	return 2*max(f.Span()-1, 0) + f.Fingers() + muted + f.Position()
+}
+
+// String returns fingering in chord chart notation, such as "x32010"
+func (f Fingering) String() string {
+	s := ""
+	for _, v := range f {
+		switch {
+		case v == Muted:
+			s += "x"
+		case v > 9:
+			s += fmt.Sprintf("(%d)", v)
+		default:
+			s += fmt.Sprintf("%d", v)
+		}
+	}
+
+	return s
+}
+
+// Fingerings returns playable fingerings of a chord on given tuning up to given fret, with fretting fingers spread over
+// at most given number of frets, ordered from the easiest one. The lowest sounding note is the chord bass unless the
+// tuning is reentrant, every chord pitch sounds except the fifth which may be omitted from chords of more than three
+// pitches or chords with more pitches than strings, at most four fingers are used and only the outermost strings may be
+// muted. Fingerings of the same difficulty are ordered from the nut.
+func Fingerings(c chord.Chord, t Tuning, maxFret int, maxSpan int) []Fingering {
+	strings := t.Strings()
+	pitches := c.Pitches()
+	if len(strings) == 0 || len(pitches) == 0 || maxFret < 1 || maxSpan < 1 {
+		return nil
+	}
+
+	required := slices.Clone(pitches)
+	if fifth := c.Root.Transpose(7); len(pitches) > 3 || len(pitches) > len(strings) {
+		required = slices.DeleteFunc(required, func(p pitch.Type) bool { return p == fifth && p != pitches[0] })
+	}
+
+	if len(required) > len(strings) {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	fingerings := make([]Fingering, 0)
+	for position := 1; position <= maxFret; position++ {
+		// frets each string may take within the window, muted and open strings included
+		options := make([][]int, len(strings))
+		for i, open := range strings {
+			options[i] = []int{Muted}
+			for fret := 0; fret <= min(position+maxSpan-1, maxFret); fret++ {
+				if (fret == 0 || fret >= position) && slices.Contains(pitches, open.Transpose(fret).Pitch) {
+					options[i] = append(options[i], fret)
+				}
+			}
+		}
+
+		var finger func(current Fingering)
+		finger = func(current Fingering) {
+			if len(current) == len(strings) {
+				if playable(current, strings, pitches[0], required, t.Reentrant()) && !seen[current.String()] {
+					seen[current.String()] = true
+					fingerings = append(fingerings, slices.Clone(current))
+				}
+				return
+			}
+
+			for _, v := range options[len(current)] {
+				// once a played string is followed by a muted one, remaining strings stay muted
+				if n := len(current); v != Muted && n > 0 && current[n-1] == Muted &&
+					slices.ContainsFunc(current, func(fret int) bool { return fret != Muted }) {
+					continue
+				}
+
+				if next := append(current, v); next.Fingers() <= maxFingers {
+					finger(next)
+				}
+			}
+		}
+		finger(Fingering{})
+	}
+
+	slices.SortStableFunc(fingerings, func(a, b Fingering) int {
+		if a.Difficulty() != b.Difficulty() {
+			return a.Difficulty() - b.Difficulty()
+		}
+
+		if a.Position() != b.Position() {
+			return a.Position() - b.Position()
+		}
+
+		return slices.Compare(a, b)
+	})
+
+	return fingerings
+}
+
+// playable returns true when every required pitch sounds and unless reentrant, the lowest sounding note is the bass
+func playable(f Fingering, strings []pitch.Note, bass pitch.Type, required []pitch.Type, reentrant bool) bool {
+	sounding := make([]pitch.Note, 0)
+	for i, v := range f {
+		if v != Muted {
+			sounding = append(sounding, strings[i].Transpose(v))
+		}
+	}
+
+	if len(sounding) == 0 {
+		return false
+	}
+
+	lowest := slices.MinFunc(sounding, func(a, b pitch.Note) int { return a.Midi() - b.Midi() })
+	if !reentrant && lowest.Pitch != bass {
+		return false
+	}
+
+	for _, p := range required {
+		if !slices.ContainsFunc(sounding, func(n pitch.Note) bool { return n.Pitch == p }) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,110 @@
+package fretboard_test
+
+import (
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/fretboard"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFingering(t *testing.T) {
+	open := fretboard.Fingering{fretboard.Muted, 3, 2, 0, 1, 0}
+	assert.Equal(t, "x32010", open.String())
+	assert.Equal(t, 1, open.Position())
+	assert.Equal(t, 3, open.Span())
+	assert.Equal(t, 3, open.Fingers())
+	assert.Equal(t, []string{"C3", "E3", "G3", "C4", "E4"}, noteNames(open, fretboard.Standard))
+
+	barre := fretboard.Fingering{1, 3, 3, 2, 1, 1}
+	assert.Equal(t, 4, barre.Fingers())
+	assert.Equal(t, 1, barre.Position())
+
+	// open A string between strings fretted at the first fret prevents a barre
+	broken := fretboard.Fingering{1, 0, 3, 2, 1, 1}
+	assert.Equal(t, 5, broken.Fingers())
+
+	high := fretboard.Fingering{8, 10, 10, 9, 8, 8}
+	assert.Equal(t, "8(10)(10)988", high.String())
+	assert.Less(t, barre.Difficulty(), high.Difficulty())
+	assert.Less(t, open.Difficulty(), fretboard.Fingering{fretboard.Muted, 3, 2, 0, fretboard.Muted, fretboard.Muted}.Difficulty())
+}
+
+func TestFingerings(t *testing.T) {
+	type testCase struct {
+		Tuning   fretboard.Tuning
+		Chord    string
+		Expected string
+	}
+
+	testCases := []testCase{
+		{Tuning: fretboard.Standard, Chord: "C", Expected: "x32010"},
+		{Tuning: fretboard.Standard, Chord: "G", Expected: "320003"},
+		{Tuning: fretboard.Standard, Chord: "Am", Expected: "x02210"},
+		{Tuning: fretboard.Standard, Chord: "E", Expected: "022100"},
+		{Tuning: fretboard.Standard, Chord: "F", Expected: "133211"},
+		{Tuning: fretboard.Standard, Chord: "D", Expected: "xx0232"},
+		{Tuning: fretboard.Standard, Chord: "Cmaj7", Expected: "x32000"},
+		{Tuning: fretboard.Standard, Chord: "C/E", Expected: "032010"},
+		{Tuning: fretboard.DropD, Chord: "D", Expected: "000232"},
+		{Tuning: fretboard.Bass, Chord: "G", Expected: "3200"},
+		{Tuning: fretboard.Ukulele, Chord: "G", Expected: "0232"},
+		{Tuning: fretboard.Ukulele, Chord: "F", Expected: "x010"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Tuning.String()+tc.Chord, func(t *testing.T) {
+			c, err := chord.Parse(tc.Chord)
+			require.NoError(t, err)
+
+			fingerings := fretboard.Fingerings(c, tc.Tuning, 12, 4)
+			require.NotEmpty(t, fingerings)
+			assert.Equal(t, tc.Expected, fingerings[0].String())
+
+			for i, v := range fingerings {
+				assert.LessOrEqual(t, v.Span(), 4)
+				assert.LessOrEqual(t, v.Fingers(), 4)
+				if i > 0 {
+					assert.LessOrEqual(t, fingerings[i-1].Difficulty(), v.Difficulty())
+				}
+
+				notes := v.Notes(tc.Tuning)
+				for _, p := range c.Pitches() {
+					if p != c.Root.Transpose(7) || len(c.Pitches()) <= 3 {
+						assert.True(t, containsPitch(notes, p), "%s lacks %s", v, p)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestFingerings_Unplayable(t *testing.T) {
+	c, err := chord.Parse("C13")
+	require.NoError(t, err)
+
+	assert.Empty(t, fretboard.Fingerings(c, fretboard.Ukulele, 12, 4))
+	assert.Empty(t, fretboard.Fingerings(c, fretboard.Invalid, 12, 4))
+	assert.Empty(t, fretboard.Fingerings(chord.Chord{}, fretboard.Standard, 12, 4))
+}
+
+func noteNames(f fretboard.Fingering, tuning fretboard.Tuning) []string {
+	names := make([]string, 0)
+	for _, v := range f.Notes(tuning) {
+		names = append(names, v.String())
+	}
+
+	return names
+}
+
+func containsPitch(notes []pitch.Note, p pitch.Type) bool {
+	for _, v := range notes {
+		if v.Pitch == p {
+			return true
+		}
+	}
+
+	return false
+}
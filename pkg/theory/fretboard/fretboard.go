@@ -0,0 +1,120 @@
+package fretboard
+
+import (
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+)
+
+// Tuning is a type for fretted instrument tuning
+type Tuning int
+
+// Instrument tuning enumerations
+const (
+	Invalid        Tuning = iota
+	Standard       Tuning = iota
+	DropD          Tuning = iota
+	DADGAD         Tuning = iota
+	SevenString    Tuning = iota
+	Bass           Tuning = iota
+	FiveStringBass Tuning = iota
+	Ukulele        Tuning = iota
+)
+
+// AllTunings returns all instrument tunings
+func AllTunings() []Tuning {
+	return []Tuning{
+		Standard,
+		DropD,
+		DADGAD,
+		SevenString,
+		Bass,
+		FiveStringBass,
+		Ukulele,
+	}
+}
+
+// String returns instrument tuning name
+func (t Tuning) String() string {
+	if t < Standard || t > Ukulele {
+		return "Invalid"
+	}
+
+	return [...]string{
+		"Invalid",
+		"Standard",
+		"DropD",
+		"DADGAD",
+		"SevenString",
+		"Bass",
+		"FiveStringBass",
+		"Ukulele",
+	}[t]
+}
+
+// Identifier returns instrument tuning identifier as used in query parameters, such as "drop_d"
+func (t Tuning) Identifier() string {
+	if t < Standard || t > Ukulele {
+		return "invalid"
+	}
+
+	return [...]string{
+		"invalid",
+		"standard",
+		"drop_d",
+		"dadgad",
+		"seven_string",
+		"bass",
+		"five_string_bass",
+		"ukulele",
+	}[t]
+}
+
+// FromString returns instrument tuning from its name or identifier, such as "DropD" or "drop_d"
+func FromString(name string) Tuning {
+	for _, v := range AllTunings() {
+		if v.String() == name || v.Identifier() == name {
+			return v
+		}
+	}
+
+	return Invalid
+}
+
+// Strings returns open string notes from the string closest to the player's head, which is the lowest string except
+// for re-entrant ukulele tuning
+func (t Tuning) Strings() []pitch.Note {
+	if t < Standard || t > Ukulele {
+		return nil
+	}
+
+	notes := [...][]string{
+		nil,
+		{"E2", "A2", "D3", "G3", "B3", "E4"},
+		{"D2", "A2", "D3", "G3", "B3", "E4"},
+		{"D2", "A2", "D3", "G3", "A3", "D4"},
+		{"B1", "E2", "A2", "D3", "G3", "B3", "E4"},
+		{"E1", "A1", "D2", "G2"},
+		{"B0", "E1", "A1", "D2", "G2"},
+		{"G4", "C4", "E4", "A4"},
+	}[t]
+
+	strings := make([]pitch.Note, 0)
+	for _, v := range notes {
+		note, _ := pitch.ParseNote(v)
+		strings = append(strings, note)
+	}
+
+	return strings
+}
+
+// Reentrant returns true when strings are not tuned from the lowest to the highest, such as ukulele with its high G
+// string, where chord shapes do not keep the bass at the lowest sounding note
+func (t Tuning) Reentrant() bool {
+	strings := t.Strings()
+	for i := 1; i < len(strings); i++ {
+		if strings[i].Midi() < strings[i-1].Midi() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,50 @@
+package fretboard_test
+
+import (
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/theory/fretboard"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromString(t *testing.T) {
+	for _, v := range fretboard.AllTunings() {
+		assert.Equal(t, v, fretboard.FromString(v.String()))
+		assert.Equal(t, v, fretboard.FromString(v.Identifier()))
+	}
+
+	assert.Equal(t, fretboard.Invalid, fretboard.FromString("open_g"))
+	assert.Equal(t, "Invalid", fretboard.Invalid.String())
+	assert.Equal(t, "invalid", fretboard.Invalid.Identifier())
+	assert.Empty(t, fretboard.Invalid.Strings())
+}
+
+func TestTuning_Strings(t *testing.T) {
+	type testCase struct {
+		Tuning    fretboard.Tuning
+		Expected  []string
+		Reentrant bool
+	}
+
+	testCases := []testCase{
+		{Tuning: fretboard.Standard, Expected: []string{"E2", "A2", "D3", "G3", "B3", "E4"}},
+		{Tuning: fretboard.DropD, Expected: []string{"D2", "A2", "D3", "G3", "B3", "E4"}},
+		{Tuning: fretboard.DADGAD, Expected: []string{"D2", "A2", "D3", "G3", "A3", "D4"}},
+		{Tuning: fretboard.SevenString, Expected: []string{"B1", "E2", "A2", "D3", "G3", "B3", "E4"}},
+		{Tuning: fretboard.Bass, Expected: []string{"E1", "A1", "D2", "G2"}},
+		{Tuning: fretboard.FiveStringBass, Expected: []string{"B0", "E1", "A1", "D2", "G2"}},
+		{Tuning: fretboard.Ukulele, Expected: []string{"G4", "C4", "E4", "A4"}, Reentrant: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Tuning.String(), func(t *testing.T) {
+			strings := make([]string, 0)
+			for _, v := range tc.Tuning.Strings() {
+				strings = append(strings, v.String())
+			}
+
+			assert.Equal(t, tc.Expected, strings)
+			assert.Equal(t, tc.Reentrant, tc.Tuning.Reentrant())
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package identify
+
+import (
+	"math/bits"
+	"sort"
+
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/scale"
+)
+
+// Kind is a type for match kind
+type Kind int
+
+// Match kind enumerations
+const (
+	Invalid  Kind = iota
+	Exact    Kind = iota
+	Superset Kind = iota
+	NearMiss Kind = iota
+)
+
+// String returns match kind name
+func (k Kind) String() string {
+	if k < Exact || k > NearMiss {
+		return "Invalid"
+	}
+
+	return [...]string{
+		"Invalid",
+		"Exact",
+		"Superset",
+		"NearMiss",
+	}[k]
+}
+
+// KeyMatch represents a key matching given pitches
+type KeyMatch struct {
+	Scale   scale.Type
+	Tonic   pitch.Type
+	Kind    Kind
+	Missing pitch.Slice // given pitches which are absent from the key
+	Extra   pitch.Slice // key pitches which are absent from given pitches
+}
+
+// Distance returns count of differing pitches
+func (m KeyMatch) Distance() int {
+	return len(m.Missing) + len(m.Extra)
+}
+
+// ChordMatch represents a chord matching given pitches
+type ChordMatch struct {
+	Quality chord.Quality
+	Root    pitch.Type
+	Kind    Kind
+	Missing pitch.Slice // given pitches which are absent from the chord
+	Extra   pitch.Slice // chord pitches which are absent from given pitches
+}
+
+// Distance returns count of differing pitches
+func (m ChordMatch) Distance() int {
+	return len(m.Missing) + len(m.Extra)
+}
+
+// Result stores identification result, each slice is ranked by distance
+type Result struct {
+	Signature      int
+	ExactKeys      []KeyMatch
+	SupersetKeys   []KeyMatch
+	NearMissKeys   []KeyMatch
+	ExactChords    []ChordMatch
+	SupersetChords []ChordMatch
+	NearMissChords []ChordMatch
+}
+
+// Pitches identifies keys and chords from given pitches.
+// Near misses are keys and chords which neither exactly match nor contain all given pitches,
+// but differ by no more than maxDistance pitches.
+func Pitches(pitches pitch.Slice, maxDistance int) Result {
+	signature := pitches.ZeitlerSignature()
+	result := Result{
+		Signature:      signature,
+		ExactKeys:      make([]KeyMatch, 0),
+		SupersetKeys:   make([]KeyMatch, 0),
+		NearMissKeys:   make([]KeyMatch, 0),
+		ExactChords:    make([]ChordMatch, 0),
+		SupersetChords: make([]ChordMatch, 0),
+		NearMissChords: make([]ChordMatch, 0),
+	}
+
+	if signature == 0 {
+		return result
+	}
+
+	for _, s := range scale.AllScales() {
+		for _, tonic := range pitch.AllPitches() {
+			number := pitch.Slice(s.Pitches(tonic)).ZeitlerSignature()
+			kind := classify(signature, number, maxDistance)
+			if kind == Invalid {
+				continue
+			}
+
+			match := KeyMatch{
+				Scale:   s,
+				Tonic:   tonic,
+				Kind:    kind,
+				Missing: pitch.FromZeitlerSignature(signature &^ number),
+				Extra:   pitch.FromZeitlerSignature(number &^ signature),
+			}
+
+			switch kind {
+			case Exact:
+				result.ExactKeys = append(result.ExactKeys, match)
+			case Superset:
+				result.SupersetKeys = append(result.SupersetKeys, match)
+			default:
+				result.NearMissKeys = append(result.NearMissKeys, match)
+			}
+		}
+	}
+
+	for _, q := range chord.AllQualities() {
+		for _, root := range pitch.AllPitches() {
+			number := pitch.Slice(q.Pitches(root)).ZeitlerSignature()
+			kind := classify(signature, number, maxDistance)
+			if kind == Invalid {
+				continue
+			}
+
+			match := ChordMatch{
+				Quality: q,
+				Root:    root,
+				Kind:    kind,
+				Missing: pitch.FromZeitlerSignature(signature &^ number),
+				Extra:   pitch.FromZeitlerSignature(number &^ signature),
+			}
+
+			switch kind {
+			case Exact:
+				result.ExactChords = append(result.ExactChords, match)
+			case Superset:
+				result.SupersetChords = append(result.SupersetChords, match)
+			default:
+				result.NearMissChords = append(result.NearMissChords, match)
+			}
+		}
+	}
+
+	sortKeyMatches(result.SupersetKeys)
+	sortKeyMatches(result.NearMissKeys)
+	sortChordMatches(result.SupersetChords)
+	sortChordMatches(result.NearMissChords)
+
+	return result
+}
+
+func classify(signature, number, maxDistance int) Kind {
+	switch {
+	case number == signature:
+		return Exact
+	case number&signature == signature:
+		return Superset
+	case bits.OnesCount(uint(number^signature)) <= maxDistance:
+		return NearMiss
+	default:
+		return Invalid
+	}
+}
+
+func sortKeyMatches(matches []KeyMatch) {
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Distance() < matches[j].Distance()
+	})
+}
+
+func sortChordMatches(matches []ChordMatch) {
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Distance() < matches[j].Distance()
+	})
+}
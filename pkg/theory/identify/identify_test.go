@@ -0,0 +1,70 @@
+package identify_test
+
+import (
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/identify"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/scale"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPitches_Key(t *testing.T) {
+	result := identify.Pitches(scale.Ionian.Pitches(pitch.CNatural), 1)
+	require.Equal(t, pitch.Slice(scale.Ionian.Pitches(pitch.CNatural)).ZeitlerSignature(), result.Signature)
+
+	// every mode of c major is an exact match
+	require.Len(t, result.ExactKeys, 7)
+	assert.Contains(t, result.ExactKeys, identify.KeyMatch{Scale: scale.Ionian, Tonic: pitch.CNatural, Kind: identify.Exact, Missing: pitch.Slice{}, Extra: pitch.Slice{}})
+	assert.Contains(t, result.ExactKeys, identify.KeyMatch{Scale: scale.Aeolian, Tonic: pitch.ANatural, Kind: identify.Exact, Missing: pitch.Slice{}, Extra: pitch.Slice{}})
+
+	for _, v := range result.SupersetKeys {
+		assert.Equal(t, identify.Superset, v.Kind)
+		assert.Empty(t, v.Missing)
+		assert.NotEmpty(t, v.Extra)
+	}
+
+	for i, v := range result.NearMissKeys {
+		assert.Equal(t, identify.NearMiss, v.Kind)
+		assert.NotEmpty(t, v.Missing)
+		assert.LessOrEqual(t, v.Distance(), 1)
+		if i > 0 {
+			assert.LessOrEqual(t, result.NearMissKeys[i-1].Distance(), v.Distance())
+		}
+	}
+}
+
+func TestPitches_Chord(t *testing.T) {
+	result := identify.Pitches(pitch.Slice{pitch.ENatural, pitch.GNatural, pitch.CNatural}, 1)
+
+	// c major shares its pitches with e minor sharp fifth and g suspended fourth double sharp fifth
+	require.Len(t, result.ExactChords, 3)
+	assert.Equal(t, chord.Major, result.ExactChords[0].Quality)
+	assert.Equal(t, pitch.CNatural, result.ExactChords[0].Root)
+
+	assert.Contains(t, result.SupersetChords, identify.ChordMatch{Quality: chord.MajorSeventh, Root: pitch.CNatural, Kind: identify.Superset, Missing: pitch.Slice{}, Extra: pitch.Slice{pitch.BNatural}})
+	assert.Contains(t, result.NearMissChords, identify.ChordMatch{Quality: chord.Power, Root: pitch.CNatural, Kind: identify.NearMiss, Missing: pitch.Slice{pitch.ENatural}, Extra: pitch.Slice{}})
+	for i := 1; i < len(result.SupersetChords); i++ {
+		assert.LessOrEqual(t, result.SupersetChords[i-1].Distance(), result.SupersetChords[i].Distance())
+	}
+}
+
+func TestPitches_Empty(t *testing.T) {
+	result := identify.Pitches(pitch.Slice{}, 3)
+	assert.Zero(t, result.Signature)
+	assert.Empty(t, result.ExactKeys)
+	assert.Empty(t, result.SupersetKeys)
+	assert.Empty(t, result.NearMissKeys)
+	assert.Empty(t, result.ExactChords)
+	assert.Empty(t, result.SupersetChords)
+	assert.Empty(t, result.NearMissChords)
+}
+
+func TestKind_String(t *testing.T) {
+	assert.Equal(t, "Invalid", identify.Invalid.String())
+	assert.Equal(t, "Exact", identify.Exact.String())
+	assert.Equal(t, "Superset", identify.Superset.String())
+	assert.Equal(t, "NearMiss", identify.NearMiss.String())
+}
@@ -0,0 +1,123 @@
+package identify
+
+import (
+	"sort"
+
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/scale"
+)
+
+// epsilon is tolerance of comparing weights
+const epsilon = 1e-9
+
+// Chord template penalties, relative to normalized weight
+const (
+	absentChordPitchPenalty = 0.25
+	chordPitchPenalty       = 0.05
+)
+
+// WeightedKeyMatch represents a key explaining weighted pitches
+type WeightedKeyMatch struct {
+	Scale    scale.Type
+	Tonic    pitch.Type
+	Coverage float64     // ratio of weight belonging to the key
+	Extra    pitch.Slice // key pitches without weight
+}
+
+// Keys ranks keys by ratio of weight belonging to the key, ties are broken by fewer key pitches without weight
+// and then by heavier tonic. At most limit keys are returned, empty distribution yields no keys.
+func Keys(d pitch.Distribution, limit int) []WeightedKeyMatch {
+	matches := make([]WeightedKeyMatch, 0)
+	total := d.Total()
+	if total <= 0 {
+		return matches
+	}
+
+	signature := d.Pitches().ZeitlerSignature()
+	for _, s := range scale.AllScales() {
+		for _, tonic := range pitch.AllPitches() {
+			pitches := pitch.Slice(s.Pitches(tonic))
+
+			var covered float64
+			for _, v := range pitches {
+				covered += d.Weight(v)
+			}
+
+			matches = append(matches, WeightedKeyMatch{
+				Scale:    s,
+				Tonic:    tonic,
+				Coverage: covered / total,
+				Extra:    pitch.FromZeitlerSignature(pitches.ZeitlerSignature() &^ signature),
+			})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		switch {
+		case a.Coverage > b.Coverage+epsilon:
+			return true
+		case b.Coverage > a.Coverage+epsilon:
+			return false
+		case len(a.Extra) != len(b.Extra):
+			return len(a.Extra) < len(b.Extra)
+		default:
+			return d.Weight(a.Tonic) > d.Weight(b.Tonic)+epsilon
+		}
+	})
+
+	if limit > 0 && len(matches) > limit {
+		return matches[:limit]
+	}
+
+	return matches
+}
+
+// Chord returns chord best explaining weighted pitches, ok is false for empty distribution.
+// Each chord is scored by weight inside the chord minus weight outside of it, penalized for every chord pitch
+// without weight and slightly for every chord pitch, so smaller chords are preferred on ties.
+func Chord(d pitch.Distribution) (ChordMatch, bool) {
+	if d.Total() <= 0 {
+		return ChordMatch{}, false
+	}
+
+	d = d.Normalized()
+	signature := d.Pitches().ZeitlerSignature()
+
+	var best ChordMatch
+	var bestScore float64
+	found := false
+	for _, q := range chord.AllQualities() {
+		for _, root := range pitch.AllPitches() {
+			pitches := pitch.Slice(q.Pitches(root))
+
+			var covered float64
+			var absent int
+			for _, v := range pitches {
+				covered += d.Weight(v)
+				if d.Weight(v) <= 0 {
+					absent++
+				}
+			}
+
+			score := 2*covered - 1 - absentChordPitchPenalty*float64(absent) - chordPitchPenalty*float64(len(pitches))
+			if found && score <= bestScore+epsilon {
+				continue
+			}
+
+			number := pitches.ZeitlerSignature()
+			best = ChordMatch{
+				Quality: q,
+				Root:    root,
+				Kind:    classify(signature, number, 12),
+				Missing: pitch.FromZeitlerSignature(signature &^ number),
+				Extra:   pitch.FromZeitlerSignature(number &^ signature),
+			}
+			bestScore = score
+			found = true
+		}
+	}
+
+	return best, found
+}
@@ -0,0 +1,81 @@
+package identify_test
+
+import (
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/identify"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/scale"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeys(t *testing.T) {
+	// c major scale with emphasized tonic
+	var d pitch.Distribution
+	for _, v := range scale.Ionian.Pitches(pitch.CNatural) {
+		d.Add(v, 1)
+	}
+	d.Add(pitch.CNatural, 2)
+
+	matches := identify.Keys(d, 5)
+	require.Len(t, matches, 5)
+	assert.Equal(t, scale.Ionian, matches[0].Scale)
+	assert.Equal(t, pitch.CNatural, matches[0].Tonic)
+	assert.InDelta(t, 1, matches[0].Coverage, 1e-9)
+	assert.Empty(t, matches[0].Extra)
+
+	for i := 1; i < len(matches); i++ {
+		assert.GreaterOrEqual(t, matches[i-1].Coverage, matches[i].Coverage)
+	}
+
+	assert.Empty(t, identify.Keys(pitch.Distribution{}, 5))
+}
+
+func TestChord(t *testing.T) {
+	type testCase struct {
+		Title           string
+		Weights         map[pitch.Type]float64
+		ExpectedQuality chord.Quality
+		ExpectedRoot    pitch.Type
+	}
+
+	testCases := []testCase{
+		{
+			Title:           "MajorTriad",
+			Weights:         map[pitch.Type]float64{pitch.CNatural: 1, pitch.ENatural: 1, pitch.GNatural: 1},
+			ExpectedQuality: chord.Major,
+			ExpectedRoot:    pitch.CNatural,
+		},
+		{
+			Title:           "MinorSeventh",
+			Weights:         map[pitch.Type]float64{pitch.ANatural: 1, pitch.CNatural: 1, pitch.ENatural: 1, pitch.GNatural: 1},
+			ExpectedQuality: chord.MinorSeventh,
+			ExpectedRoot:    pitch.ANatural,
+		},
+		{
+			Title:           "MinorTriadWithShortPassingTone",
+			Weights:         map[pitch.Type]float64{pitch.DNatural: 2, pitch.FNatural: 2, pitch.ANatural: 2, pitch.BNatural: 0.1},
+			ExpectedQuality: chord.Minor,
+			ExpectedRoot:    pitch.DNatural,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			var d pitch.Distribution
+			for p, w := range tc.Weights {
+				d.Add(p, w)
+			}
+
+			match, ok := identify.Chord(d)
+			require.True(t, ok)
+			assert.Equal(t, tc.ExpectedQuality, match.Quality)
+			assert.Equal(t, tc.ExpectedRoot, match.Root)
+		})
+	}
+
+	_, ok := identify.Chord(pitch.Distribution{})
+	assert.False(t, ok)
+}
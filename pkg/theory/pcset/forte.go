@@ -0,0 +1,193 @@
+package pcset
+
+import (
+	"fmt"
+	"strings"
+)
+
+type setClass struct {
+	name      string
+	primeForm Set
+}
+
+// forteCatalog lists set classes up to cardinality 6 as numbered by Allen Forte in "The Structure of Atonal Music".
+// Set classes of cardinality 7 to 12 are numbered after their complements.
+var forteCatalog = []setClass{
+	{"0-1", Set{}},
+	{"1-1", Set{0}},
+
+	{"2-1", Set{0, 1}},
+	{"2-2", Set{0, 2}},
+	{"2-3", Set{0, 3}},
+	{"2-4", Set{0, 4}},
+	{"2-5", Set{0, 5}},
+	{"2-6", Set{0, 6}},
+
+	{"3-1", Set{0, 1, 2}},
+	{"3-2", Set{0, 1, 3}},
+	{"3-3", Set{0, 1, 4}},
+	{"3-4", Set{0, 1, 5}},
+	{"3-5", Set{0, 1, 6}},
+	{"3-6", Set{0, 2, 4}},
+	{"3-7", Set{0, 2, 5}},
+	{"3-8", Set{0, 2, 6}},
+	{"3-9", Set{0, 2, 7}},
+	{"3-10", Set{0, 3, 6}},
+	{"3-11", Set{0, 3, 7}},
+	{"3-12", Set{0, 4, 8}},
+
+	{"4-1", Set{0, 1, 2, 3}},
+	{"4-2", Set{0, 1, 2, 4}},
+	{"4-3", Set{0, 1, 3, 4}},
+	{"4-4", Set{0, 1, 2, 5}},
+	{"4-5", Set{0, 1, 2, 6}},
+	{"4-6", Set{0, 1, 2, 7}},
+	{"4-7", Set{0, 1, 4, 5}},
+	{"4-8", Set{0, 1, 5, 6}},
+	{"4-9", Set{0, 1, 6, 7}},
+	{"4-10", Set{0, 2, 3, 5}},
+	{"4-11", Set{0, 1, 3, 5}},
+	{"4-12", Set{0, 2, 3, 6}},
+	{"4-13", Set{0, 1, 3, 6}},
+	{"4-14", Set{0, 2, 3, 7}},
+	{"4-Z15", Set{0, 1, 4, 6}},
+	{"4-16", Set{0, 1, 5, 7}},
+	{"4-17", Set{0, 3, 4, 7}},
+	{"4-18", Set{0, 1, 4, 7}},
+	{"4-19", Set{0, 1, 4, 8}},
+	{"4-20", Set{0, 1, 5, 8}},
+	{"4-21", Set{0, 2, 4, 6}},
+	{"4-22", Set{0, 2, 4, 7}},
+	{"4-23", Set{0, 2, 5, 7}},
+	{"4-24", Set{0, 2, 4, 8}},
+	{"4-25", Set{0, 2, 6, 8}},
+	{"4-26", Set{0, 3, 5, 8}},
+	{"4-27", Set{0, 2, 5, 8}},
+	{"4-28", Set{0, 3, 6, 9}},
+	{"4-Z29", Set{0, 1, 3, 7}},
+
+	{"5-1", Set{0, 1, 2, 3, 4}},
+	{"5-2", Set{0, 1, 2, 3, 5}},
+	{"5-3", Set{0, 1, 2, 4, 5}},
+	{"5-4", Set{0, 1, 2, 3, 6}},
+	{"5-5", Set{0, 1, 2, 3, 7}},
+	{"5-6", Set{0, 1, 2, 5, 6}},
+	{"5-7", Set{0, 1, 2, 6, 7}},
+	{"5-8", Set{0, 2, 3, 4, 6}},
+	{"5-9", Set{0, 1, 2, 4, 6}},
+	{"5-10", Set{0, 1, 3, 4, 6}},
+	{"5-11", Set{0, 2, 3, 4, 7}},
+	{"5-Z12", Set{0, 1, 3, 5, 6}},
+	{"5-13", Set{0, 1, 2, 4, 8}},
+	{"5-14", Set{0, 1, 2, 5, 7}},
+	{"5-15", Set{0, 1, 2, 6, 8}},
+	{"5-16", Set{0, 1, 3, 4, 7}},
+	{"5-Z17", Set{0, 1, 3, 4, 8}},
+	{"5-Z18", Set{0, 1, 4, 5, 7}},
+	{"5-19", Set{0, 1, 3, 6, 7}},
+	{"5-20", Set{0, 1, 3, 7, 8}},
+	{"5-21", Set{0, 1, 4, 5, 8}},
+	{"5-22", Set{0, 1, 4, 7, 8}},
+	{"5-23", Set{0, 2, 3, 5, 7}},
+	{"5-24", Set{0, 1, 3, 5, 7}},
+	{"5-25", Set{0, 2, 3, 5, 8}},
+	{"5-26", Set{0, 2, 4, 5, 8}},
+	{"5-27", Set{0, 1, 3, 5, 8}},
+	{"5-28", Set{0, 2, 3, 6, 8}},
+	{"5-29", Set{0, 1, 3, 6, 8}},
+	{"5-30", Set{0, 1, 4, 6, 8}},
+	{"5-31", Set{0, 1, 3, 6, 9}},
+	{"5-32", Set{0, 1, 4, 6, 9}},
+	{"5-33", Set{0, 2, 4, 6, 8}},
+	{"5-34", Set{0, 2, 4, 6, 9}},
+	{"5-35", Set{0, 2, 4, 7, 9}},
+	{"5-Z36", Set{0, 1, 2, 4, 7}},
+	{"5-Z37", Set{0, 3, 4, 5, 8}},
+	{"5-Z38", Set{0, 1, 2, 5, 8}},
+
+	{"6-1", Set{0, 1, 2, 3, 4, 5}},
+	{"6-2", Set{0, 1, 2, 3, 4, 6}},
+	{"6-Z3", Set{0, 1, 2, 3, 5, 6}},
+	{"6-Z4", Set{0, 1, 2, 4, 5, 6}},
+	{"6-5", Set{0, 1, 2, 3, 6, 7}},
+	{"6-Z6", Set{0, 1, 2, 5, 6, 7}},
+	{"6-7", Set{0, 1, 2, 6, 7, 8}},
+	{"6-8", Set{0, 2, 3, 4, 5, 7}},
+	{"6-9", Set{0, 1, 2, 3, 5, 7}},
+	{"6-Z10", Set{0, 1, 3, 4, 5, 7}},
+	{"6-Z11", Set{0, 1, 2, 4, 5, 7}},
+	{"6-Z12", Set{0, 1, 2, 4, 6, 7}},
+	{"6-Z13", Set{0, 1, 3, 4, 6, 7}},
+	{"6-14", Set{0, 1, 3, 4, 5, 8}},
+	{"6-15", Set{0, 1, 2, 4, 5, 8}},
+	{"6-16", Set{0, 1, 4, 5, 6, 8}},
+	{"6-Z17", Set{0, 1, 2, 4, 7, 8}},
+	{"6-18", Set{0, 1, 2, 5, 7, 8}},
+	{"6-Z19", Set{0, 1, 3, 4, 7, 8}},
+	{"6-20", Set{0, 1, 4, 5, 8, 9}},
+	{"6-21", Set{0, 2, 3, 4, 6, 8}},
+	{"6-22", Set{0, 1, 2, 4, 6, 8}},
+	{"6-Z23", Set{0, 2, 3, 5, 6, 8}},
+	{"6-Z24", Set{0, 1, 3, 4, 6, 8}},
+	{"6-Z25", Set{0, 1, 3, 5, 6, 8}},
+	{"6-Z26", Set{0, 1, 3, 5, 7, 8}},
+	{"6-27", Set{0, 1, 3, 4, 6, 9}},
+	{"6-Z28", Set{0, 1, 3, 5, 6, 9}},
+	{"6-Z29", Set{0, 1, 3, 6, 8, 9}},
+	{"6-30", Set{0, 1, 3, 6, 7, 9}},
+	{"6-31", Set{0, 1, 3, 5, 8, 9}},
+	{"6-32", Set{0, 2, 4, 5, 7, 9}},
+	{"6-33", Set{0, 2, 3, 5, 7, 9}},
+	{"6-34", Set{0, 1, 3, 5, 7, 9}},
+	{"6-35", Set{0, 2, 4, 6, 8, 10}},
+	{"6-Z36", Set{0, 1, 2, 3, 4, 7}},
+	{"6-Z37", Set{0, 1, 2, 3, 4, 8}},
+	{"6-Z38", Set{0, 1, 2, 3, 7, 8}},
+	{"6-Z39", Set{0, 2, 3, 4, 5, 8}},
+	{"6-Z40", Set{0, 1, 2, 3, 5, 8}},
+	{"6-Z41", Set{0, 1, 2, 3, 6, 8}},
+	{"6-Z42", Set{0, 1, 2, 3, 6, 9}},
+	{"6-Z43", Set{0, 1, 2, 5, 6, 8}},
+	{"6-Z44", Set{0, 1, 2, 5, 6, 9}},
+	{"6-Z45", Set{0, 2, 3, 4, 6, 9}},
+	{"6-Z46", Set{0, 1, 2, 4, 6, 9}},
+	{"6-Z47", Set{0, 1, 2, 4, 7, 9}},
+	{"6-Z48", Set{0, 1, 2, 5, 7, 9}},
+	{"6-Z49", Set{0, 1, 3, 4, 7, 9}},
+	{"6-Z50", Set{0, 1, 4, 6, 7, 9}},
+}
+
+// setClasses holds all 224 set classes with Forte prime forms, complements of the catalog are added here
+var setClasses = buildSetClasses()
+
+var setClassBySignature = func() map[int]setClass {
+	entries := make(map[int]setClass)
+	for _, v := range setClasses {
+		entries[v.primeForm.signature()] = v
+	}
+
+	return entries
+}()
+
+func buildSetClasses() []setClass {
+	classes := make([]setClass, 0)
+	for _, v := range forteCatalog {
+		classes = append(classes, setClass{name: v.name, primeForm: v.primeForm.PrimeForm(Forte)})
+	}
+
+	for cardinality := 5; cardinality >= 0; cardinality-- {
+		for _, v := range forteCatalog {
+			if len(v.primeForm) != cardinality {
+				continue
+			}
+
+			_, ordinal, _ := strings.Cut(v.name, "-")
+			classes = append(classes, setClass{
+				name:      fmt.Sprintf("%d-%s", 12-cardinality, ordinal),
+				primeForm: v.primeForm.Complement().PrimeForm(Forte),
+			})
+		}
+	}
+
+	return classes
+}
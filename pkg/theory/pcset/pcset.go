@@ -0,0 +1,241 @@
+package pcset
+
+import "github.com/edipermadi/music-db/pkg/theory/pitch"
+
+// Algorithm is a type for normal order algorithm, deciding between rotations of equal span
+type Algorithm int
+
+// Normal order algorithm enumerations
+const (
+	// Rahn picks the rotation most packed to the right, as described by John Rahn
+	Rahn Algorithm = iota
+	// Forte picks the rotation most packed to the left, as described by Allen Forte
+	Forte Algorithm = iota
+)
+
+// String returns algorithm name
+func (a Algorithm) String() string {
+	if a < Rahn || a > Forte {
+		return "Invalid"
+	}
+
+	return [...]string{
+		"Rahn",
+		"Forte",
+	}[a]
+}
+
+// Set is a pitch class set, where pitch classes are numbered from 0 (C) to 11 (B)
+type Set []int
+
+// New returns pitch class set of given pitch classes, reduced modulo 12, sorted and without duplicates
+func New(pitchClasses ...int) Set {
+	var signature int
+	for _, v := range pitchClasses {
+		signature |= 1 << mod12(v)
+	}
+
+	return fromSignature(signature)
+}
+
+// FromPitches returns pitch class set of given pitches
+func FromPitches(pitches pitch.Slice) Set {
+	pitchClasses := make([]int, 0)
+	for _, v := range pitches {
+		if v >= pitch.CNatural && v <= pitch.BNatural {
+			pitchClasses = append(pitchClasses, int(v-pitch.CNatural))
+		}
+	}
+
+	return New(pitchClasses...)
+}
+
+// Pitches returns pitches of pitch class set, following set order
+func (s Set) Pitches() pitch.Slice {
+	pitches := make(pitch.Slice, 0)
+	for _, v := range s {
+		pitches = append(pitches, pitch.CNatural.Transpose(v))
+	}
+
+	return pitches
+}
+
+// Cardinality returns number of distinct pitch classes
+func (s Set) Cardinality() int {
+	return len(New(s...))
+}
+
+// Transpose returns set transposed by given semitones, keeping set order
+func (s Set) Transpose(amount int) Set {
+	transposed := make(Set, 0)
+	for _, v := range s {
+		transposed = append(transposed, mod12(v+amount))
+	}
+
+	return transposed
+}
+
+// Invert returns set inverted around pitch class 0, keeping set order
+func (s Set) Invert() Set {
+	inverted := make(Set, 0)
+	for _, v := range s {
+		inverted = append(inverted, mod12(-v))
+	}
+
+	return inverted
+}
+
+// Complement returns pitch classes not contained in the set
+func (s Set) Complement() Set {
+	return fromSignature(4095 &^ New(s...).signature())
+}
+
+// Equal returns true when both sets contain the same pitch classes regardless of order
+func (s Set) Equal(v Set) bool {
+	return New(s...).signature() == New(v...).signature()
+}
+
+// NormalOrder returns the most compact rotation of the set, ties between rotations of equal span are resolved using
+// given algorithm. Remaining ties, as found in transpositionally symmetric sets, pick the lowest starting pitch class.
+func (s Set) NormalOrder(algorithm Algorithm) Set {
+	set := New(s...)
+	if len(set) == 0 {
+		return set
+	}
+
+	var normal Set
+	for i := range set {
+		rotation := append(append(Set{}, set[i:]...), set[:i]...)
+		if normal == nil || algorithm.less(rotation, normal) {
+			normal = rotation
+		}
+	}
+
+	return normal
+}
+
+// PrimeForm returns the most compact form of the set and its inversion transposed to start at 0, using given algorithm
+func (s Set) PrimeForm(algorithm Algorithm) Set {
+	set := New(s...)
+	if len(set) == 0 {
+		return set
+	}
+
+	normal := set.NormalOrder(algorithm)
+	normal = normal.Transpose(-normal[0])
+
+	inverted := set.Invert().NormalOrder(algorithm)
+	inverted = inverted.Transpose(-inverted[0])
+
+	if algorithm.less(inverted, normal) {
+		return inverted
+	}
+
+	return normal
+}
+
+// IntervalVector returns interval class vector, the number of occurrences of interval classes 1 to 6
+func (s Set) IntervalVector() [6]int {
+	var vector [6]int
+
+	set := New(s...)
+	for i := range set {
+		for j := i + 1; j < len(set); j++ {
+			interval := set[j] - set[i]
+			if interval > 6 {
+				interval = 12 - interval
+			}
+			vector[interval-1]++
+		}
+	}
+
+	return vector
+}
+
+// ForteNumber returns Forte number of set class the set belongs to, such as "7-35" for diatonic collection
+func (s Set) ForteNumber() string {
+	if entry, found := setClassBySignature[s.PrimeForm(Forte).signature()]; found {
+		return entry.name
+	}
+
+	return ""
+}
+
+// ZRelated returns true when both sets share interval vector without being in the same set class
+func (s Set) ZRelated(v Set) bool {
+	return s.IntervalVector() == v.IntervalVector() && !s.PrimeForm(Forte).Equal(v.PrimeForm(Forte))
+}
+
+// ZCorrespondent returns Forte prime form of Z-related set class, found is false when the set is not Z-related
+func (s Set) ZCorrespondent() (Set, bool) {
+	primeForm := s.PrimeForm(Forte)
+	for _, v := range setClasses {
+		if v.primeForm.Cardinality() == primeForm.Cardinality() && primeForm.ZRelated(v.primeForm) {
+			return v.primeForm, true
+		}
+	}
+
+	return nil, false
+}
+
+// FromForteNumber returns Forte prime form of given Forte number, such as "4-Z15"
+func FromForteNumber(name string) (Set, bool) {
+	for _, v := range setClasses {
+		if v.name == name {
+			return v.primeForm, true
+		}
+	}
+
+	return nil, false
+}
+
+// less returns true when x is more packed than y according to the algorithm, assuming equal cardinality.
+// Spans are compared first, then intervals from the first pitch class moving inward from the right (Rahn) or
+// outward from the left (Forte), then the starting pitch class.
+func (a Algorithm) less(x, y Set) bool {
+	n := len(x)
+	interval := func(s Set, i int) int { return mod12(s[i] - s[0]) }
+
+	indexes := []int{n - 1}
+	if a == Forte {
+		for i := 1; i < n-1; i++ {
+			indexes = append(indexes, i)
+		}
+	} else {
+		for i := n - 2; i > 0; i-- {
+			indexes = append(indexes, i)
+		}
+	}
+
+	for _, i := range indexes {
+		if interval(x, i) != interval(y, i) {
+			return interval(x, i) < interval(y, i)
+		}
+	}
+
+	return x[0] < y[0]
+}
+
+func (s Set) signature() int {
+	var signature int
+	for _, v := range s {
+		signature |= 1 << mod12(v)
+	}
+
+	return signature
+}
+
+func fromSignature(signature int) Set {
+	set := make(Set, 0)
+	for i := 0; i < 12; i++ {
+		if signature&(1<<i) != 0 {
+			set = append(set, i)
+		}
+	}
+
+	return set
+}
+
+func mod12(v int) int {
+	return ((v % 12) + 12) % 12
+}
@@ -0,0 +1,156 @@
+package pcset_test
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/theory/pcset"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromPitches(t *testing.T) {
+	given := pitch.Slice{pitch.GNatural, pitch.CNatural, pitch.ENatural, pitch.CNatural}
+	set := pcset.FromPitches(given)
+	assert.Equal(t, pcset.Set{0, 4, 7}, set)
+	assert.Equal(t, pitch.Slice{pitch.CNatural, pitch.ENatural, pitch.GNatural}, set.Pitches())
+}
+
+func TestSet_NormalOrder(t *testing.T) {
+	type testCase struct {
+		Given     pcset.Set
+		Algorithm pcset.Algorithm
+		Expected  pcset.Set
+	}
+
+	testCases := []testCase{
+		{Given: pcset.Set{7, 0, 4}, Algorithm: pcset.Rahn, Expected: pcset.Set{0, 4, 7}},
+		{Given: pcset.Set{11, 2, 5, 8}, Algorithm: pcset.Rahn, Expected: pcset.Set{2, 5, 8, 11}},
+		{Given: pcset.Set{0, 1, 5, 6, 8}, Algorithm: pcset.Rahn, Expected: pcset.Set{0, 1, 5, 6, 8}},
+		{Given: pcset.Set{0, 1, 5, 6, 8}, Algorithm: pcset.Forte, Expected: pcset.Set{5, 6, 8, 0, 1}},
+		{Given: pcset.Set{}, Algorithm: pcset.Forte, Expected: pcset.Set{}},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.Expected, tc.Given.NormalOrder(tc.Algorithm), "normal order of %v using %s", tc.Given, tc.Algorithm)
+	}
+}
+
+func TestSet_PrimeForm(t *testing.T) {
+	type testCase struct {
+		Given     pcset.Set
+		Algorithm pcset.Algorithm
+		Expected  pcset.Set
+	}
+
+	testCases := []testCase{
+		{Given: pcset.Set{0, 4, 7}, Algorithm: pcset.Rahn, Expected: pcset.Set{0, 3, 7}},
+		{Given: pcset.Set{0, 4, 7}, Algorithm: pcset.Forte, Expected: pcset.Set{0, 3, 7}},
+		{Given: pcset.Set{0, 2, 4, 5, 7, 9, 11}, Algorithm: pcset.Forte, Expected: pcset.Set{0, 1, 3, 5, 6, 8, 10}},
+		{Given: pcset.Set{0, 1, 5, 6, 8}, Algorithm: pcset.Rahn, Expected: pcset.Set{0, 1, 5, 6, 8}},
+		{Given: pcset.Set{0, 1, 5, 6, 8}, Algorithm: pcset.Forte, Expected: pcset.Set{0, 1, 3, 7, 8}},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.Expected, tc.Given.PrimeForm(tc.Algorithm), "prime form of %v using %s", tc.Given, tc.Algorithm)
+	}
+}
+
+func TestSet_IntervalVector(t *testing.T) {
+	assert.Equal(t, [6]int{0, 0, 1, 1, 1, 0}, pcset.Set{0, 4, 7}.IntervalVector())
+	assert.Equal(t, [6]int{2, 5, 4, 3, 6, 1}, pcset.Set{0, 2, 4, 5, 7, 9, 11}.IntervalVector())
+	assert.Equal(t, [6]int{12, 12, 12, 12, 12, 6}, pcset.New(0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11).IntervalVector())
+}
+
+func TestSet_ForteNumber(t *testing.T) {
+	type testCase struct {
+		Given    pcset.Set
+		Expected string
+	}
+
+	testCases := []testCase{
+		{Given: pcset.Set{}, Expected: "0-1"},
+		{Given: pcset.Set{0, 4, 7}, Expected: "3-11"},
+		{Given: pcset.Set{0, 3, 6, 9}, Expected: "4-28"},
+		{Given: pcset.Set{1, 3, 6, 8, 10}, Expected: "5-35"},
+		{Given: pcset.Set{0, 2, 4, 6, 8, 10}, Expected: "6-35"},
+		{Given: pcset.Set{0, 2, 4, 5, 7, 9, 11}, Expected: "7-35"},
+		{Given: pcset.Set{0, 1, 3, 4, 6, 7, 9, 10}, Expected: "8-28"},
+		{Given: pcset.Set{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}, Expected: "12-1"},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.Expected, tc.Given.ForteNumber(), "forte number of %v", tc.Given)
+	}
+}
+
+func TestSet_ForteNumberCoversAllSetClasses(t *testing.T) {
+	setClasses := make(map[string]string)
+	for signature := 0; signature < 4096; signature++ {
+		set := make(pcset.Set, 0)
+		for i := 0; i < 12; i++ {
+			if signature&(1<<i) != 0 {
+				set = append(set, i)
+			}
+		}
+
+		name := set.ForteNumber()
+		require.NotEmpty(t, name, "forte number of %v", set)
+		assert.Equal(t, strconv.Itoa(len(set)), strings.Split(name, "-")[0])
+
+		primeForm, found := pcset.FromForteNumber(name)
+		require.True(t, found)
+		assert.Equal(t, primeForm, set.PrimeForm(pcset.Forte))
+		setClasses[name] = name
+	}
+
+	assert.Len(t, setClasses, 224)
+}
+
+func TestSet_ZRelated(t *testing.T) {
+	assert.True(t, pcset.Set{0, 1, 4, 6}.ZRelated(pcset.Set{0, 1, 3, 7}))
+	assert.False(t, pcset.Set{0, 4, 7}.ZRelated(pcset.Set{0, 3, 7}))
+	assert.False(t, pcset.Set{0, 4, 7}.ZRelated(pcset.Set{0, 2, 7}))
+
+	for signature := 0; signature < 4096; signature++ {
+		set := make(pcset.Set, 0)
+		for i := 0; i < 12; i++ {
+			if signature&(1<<i) != 0 {
+				set = append(set, i)
+			}
+		}
+
+		name := set.ForteNumber()
+		correspondent, found := set.ZCorrespondent()
+		assert.Equal(t, strings.Contains(name, "Z"), found, "z correspondent of %s", name)
+		if found {
+			assert.True(t, strings.Contains(correspondent.ForteNumber(), "Z"))
+			assert.Equal(t, set.IntervalVector(), correspondent.IntervalVector())
+		}
+	}
+}
+
+func TestSet_Complement(t *testing.T) {
+	diatonic := pcset.Set{0, 2, 4, 5, 7, 9, 11}
+	assert.Equal(t, pcset.Set{1, 3, 6, 8, 10}, diatonic.Complement())
+
+	for i := 1; i <= 50; i++ {
+		name := fmt.Sprintf("6-%d", i)
+		primeForm, found := pcset.FromForteNumber(name)
+		if !found {
+			name = fmt.Sprintf("6-Z%d", i)
+			primeForm, found = pcset.FromForteNumber(name)
+		}
+		require.True(t, found, "hexachord %d", i)
+
+		complement := primeForm.Complement()
+		if correspondent, found := primeForm.ZCorrespondent(); found {
+			assert.Equal(t, correspondent.ForteNumber(), complement.ForteNumber(), "complement of %s", name)
+		} else {
+			assert.Equal(t, name, complement.ForteNumber(), "complement of %s", name)
+		}
+	}
+}
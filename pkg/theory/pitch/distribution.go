@@ -0,0 +1,58 @@
+package pitch
+
+// Distribution represents weight of each pitch class, such as accumulated note durations, indexed from C
+type Distribution [12]float64
+
+// Add adds weight to given pitch, invalid pitches are ignored
+func (d *Distribution) Add(p Type, weight float64) {
+	if p < CNatural || p > BNatural {
+		return
+	}
+
+	d[p-CNatural] += weight
+}
+
+// Weight returns weight of given pitch
+func (d Distribution) Weight(p Type) float64 {
+	if p < CNatural || p > BNatural {
+		return 0
+	}
+
+	return d[p-CNatural]
+}
+
+// Total returns sum of all weights
+func (d Distribution) Total() float64 {
+	var total float64
+	for _, v := range d {
+		total += v
+	}
+
+	return total
+}
+
+// Normalized returns distribution whose weights sum up to one, empty distribution is returned as is
+func (d Distribution) Normalized() Distribution {
+	total := d.Total()
+	if total <= 0 {
+		return d
+	}
+
+	for i := range d {
+		d[i] /= total
+	}
+
+	return d
+}
+
+// Pitches returns pitches having positive weight in ascending order from C
+func (d Distribution) Pitches() Slice {
+	pitches := make(Slice, 0)
+	for _, v := range AllPitches() {
+		if d.Weight(v) > 0 {
+			pitches = append(pitches, v)
+		}
+	}
+
+	return pitches
+}
@@ -0,0 +1,27 @@
+package pitch_test
+
+import (
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistribution(t *testing.T) {
+	var d pitch.Distribution
+	d.Add(pitch.CNatural, 3)
+	d.Add(pitch.GNatural, 1)
+	d.Add(pitch.Invalid, 5)
+
+	assert.Equal(t, 4.0, d.Total())
+	assert.Equal(t, 3.0, d.Weight(pitch.CNatural))
+	assert.Equal(t, 0.0, d.Weight(pitch.Invalid))
+	assert.Equal(t, pitch.Slice{pitch.CNatural, pitch.GNatural}, d.Pitches())
+
+	normalized := d.Normalized()
+	assert.Equal(t, 0.75, normalized.Weight(pitch.CNatural))
+	assert.Equal(t, 0.25, normalized.Weight(pitch.GNatural))
+	assert.Equal(t, 3.0, d.Weight(pitch.CNatural))
+
+	assert.Equal(t, pitch.Distribution{}, pitch.Distribution{}.Normalized())
+}
@@ -0,0 +1,171 @@
+package pitch
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidNote is returned when a note can not be parsed
+var ErrInvalidNote = errors.New("invalid note")
+
+// Note represents a pitch class at a given octave in scientific pitch notation, where C4 is the middle C
+type Note struct {
+	Pitch  Type
+	Octave int
+}
+
+// NewNote returns a note of given pitch class and octave
+func NewNote(p Type, octave int) Note {
+	return Note{Pitch: p, Octave: octave}
+}
+
+// NoteFromMidi returns note from MIDI note number, where 60 is C4 and 69 is A4
+func NoteFromMidi(number int) Note {
+	octave := int(math.Floor(float64(number)/12)) - 1
+	class := ((number % 12) + 12) % 12
+	return Note{Pitch: FromInt(class + 1), Octave: octave}
+}
+
+// ParseNote parses note in scientific pitch notation such as "C4", "C#4", "Bb2" or "F♯3".
+// Accidentals may be "#", "♯", "b", "♭", "x" or "𝄪" and can be repeated,
+// the resulting note is normalized, so "Cb4" yields B3.
+func ParseNote(s string) (Note, error) {
+	runes := []rune(strings.TrimSpace(s))
+	if len(runes) < 2 {
+		return Note{}, ErrInvalidNote
+	}
+
+	semitone, found := map[rune]int{'C': 0, 'D': 2, 'E': 4, 'F': 5, 'G': 7, 'A': 9, 'B': 11}[toUpper(runes[0])]
+	if !found {
+		return Note{}, ErrInvalidNote
+	}
+
+	i := 1
+accidentals:
+	for ; i < len(runes); i++ {
+		switch runes[i] {
+		case '#', '♯':
+			semitone++
+		case 'b', '♭':
+			semitone--
+		case 'x', '𝄪':
+			semitone += 2
+		case '𝄫':
+			semitone -= 2
+		default:
+			break accidentals
+		}
+	}
+
+	octave, err := strconv.Atoi(string(runes[i:]))
+	if err != nil {
+		return Note{}, ErrInvalidNote
+	}
+
+	return NoteFromMidi((octave+1)*12 + semitone), nil
+}
+
+func toUpper(r rune) rune {
+	if r >= 'a' && r <= 'g' {
+		return r - 'a' + 'A'
+	}
+
+	return r
+}
+
+// Valid returns true when note has a valid pitch class
+func (n Note) Valid() bool {
+	return n.Pitch >= CNatural && n.Pitch <= BNatural
+}
+
+// Midi returns MIDI note number, where 60 is C4 and 69 is A4
+func (n Note) Midi() int {
+	if !n.Valid() {
+		return 0
+	}
+
+	return (n.Octave+1)*12 + int(n.Pitch-CNatural)
+}
+
+// Frequency returns note frequency in twelve tone equal temperament with A4 tuned to 440Hz
+func (n Note) Frequency() float64 {
+	if !n.Valid() {
+		return 0
+	}
+
+	return 440 * math.Pow(2, float64(n.Midi()-69)/12)
+}
+
+// Transpose returns note transposed by given amount of semitones
+func (n Note) Transpose(amount int) Note {
+	if !n.Valid() {
+		return n
+	}
+
+	return NoteFromMidi(n.Midi() + amount)
+}
+
+// String returns note in scientific pitch notation using sharps, such as "C#4"
+func (n Note) String() string {
+	if !n.Valid() {
+		return "Invalid"
+	}
+
+	return fmt.Sprintf("%s%d", [...]string{"", "C", "C#", "D", "D#", "E", "F", "F#", "G", "G#", "A", "A#", "B"}[n.Pitch], n.Octave)
+}
+
+// MarshalText encodes note in scientific pitch notation
+func (n Note) MarshalText() ([]byte, error) {
+	return []byte(n.String()), nil
+}
+
+// UnmarshalText decodes note from scientific pitch notation
+func (n *Note) UnmarshalText(text []byte) error {
+	parsed, err := ParseNote(string(text))
+	if err != nil {
+		return err
+	}
+
+	*n = parsed
+	return nil
+}
+
+// NoteRange returns notes from lowest to highest note inclusively, in ascending order
+func NoteRange(from, to Note) []Note {
+	notes := make([]Note, 0)
+	if !from.Valid() || !to.Valid() {
+		return notes
+	}
+
+	for i := from.Midi(); i <= to.Midi(); i++ {
+		notes = append(notes, NoteFromMidi(i))
+	}
+
+	return notes
+}
+
+// Notes returns ascending notes of the pitch slice, where the first pitch is placed at given octave
+// and each next pitch is placed at the closest position above the previous one
+func (s Slice) Notes(octave int) []Note {
+	notes := make([]Note, 0)
+	for _, v := range s {
+		if v < CNatural || v > BNatural {
+			continue
+		}
+
+		current := NewNote(v, octave)
+		if len(notes) > 0 {
+			previous := notes[len(notes)-1]
+			for current.Midi() <= previous.Midi() {
+				current.Octave++
+			}
+		}
+
+		notes = append(notes, current)
+	}
+
+	return notes
+}
@@ -0,0 +1,101 @@
+package pitch_test
+
+import (
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNote(t *testing.T) {
+	type testCase struct {
+		Given    string
+		Expected pitch.Note
+		Midi     int
+	}
+
+	testCases := []testCase{
+		{Given: "C4", Expected: pitch.NewNote(pitch.CNatural, 4), Midi: 60},
+		{Given: "A4", Expected: pitch.NewNote(pitch.ANatural, 4), Midi: 69},
+		{Given: "C#4", Expected: pitch.NewNote(pitch.CSharp, 4), Midi: 61},
+		{Given: "F♯3", Expected: pitch.NewNote(pitch.FSharp, 3), Midi: 54},
+		{Given: "Bb2", Expected: pitch.NewNote(pitch.ASharp, 2), Midi: 46},
+		{Given: "E♭5", Expected: pitch.NewNote(pitch.DSharp, 5), Midi: 75},
+		{Given: "Cb4", Expected: pitch.NewNote(pitch.BNatural, 3), Midi: 59},
+		{Given: "B#3", Expected: pitch.NewNote(pitch.CNatural, 4), Midi: 60},
+		{Given: "Fx4", Expected: pitch.NewNote(pitch.GNatural, 4), Midi: 67},
+		{Given: "Dbb4", Expected: pitch.NewNote(pitch.CNatural, 4), Midi: 60},
+		{Given: "g9", Expected: pitch.NewNote(pitch.GNatural, 9), Midi: 127},
+		{Given: "C-1", Expected: pitch.NewNote(pitch.CNatural, -1), Midi: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Given, func(t *testing.T) {
+			note, err := pitch.ParseNote(tc.Given)
+			require.NoError(t, err)
+			assert.Equal(t, tc.Expected, note)
+			assert.Equal(t, tc.Midi, note.Midi())
+			assert.Equal(t, tc.Expected, pitch.NoteFromMidi(tc.Midi))
+		})
+	}
+}
+
+func TestParseNote_Invalid(t *testing.T) {
+	for _, given := range []string{"", "C", "H4", "C#", "C4x", "4C"} {
+		t.Run(given, func(t *testing.T) {
+			_, err := pitch.ParseNote(given)
+			require.ErrorIs(t, err, pitch.ErrInvalidNote)
+		})
+	}
+}
+
+func TestNote_String(t *testing.T) {
+	assert.Equal(t, "C4", pitch.NewNote(pitch.CNatural, 4).String())
+	assert.Equal(t, "A#2", pitch.NewNote(pitch.ASharp, 2).String())
+	assert.Equal(t, "Invalid", pitch.Note{}.String())
+
+	text, err := pitch.NewNote(pitch.FSharp, 3).MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "F#3", string(text))
+
+	var note pitch.Note
+	require.NoError(t, note.UnmarshalText([]byte("Bb2")))
+	assert.Equal(t, pitch.NewNote(pitch.ASharp, 2), note)
+}
+
+func TestNote_Frequency(t *testing.T) {
+	assert.InDelta(t, 440.0, pitch.NewNote(pitch.ANatural, 4).Frequency(), 0.001)
+	assert.InDelta(t, 220.0, pitch.NewNote(pitch.ANatural, 3).Frequency(), 0.001)
+	assert.InDelta(t, 27.5, pitch.NewNote(pitch.ANatural, 0).Frequency(), 0.001)
+
+	// matches the fourth octave frequency table of pitch classes
+	for _, v := range pitch.AllPitches() {
+		assert.InDelta(t, v.Frequency(), pitch.NewNote(v, 4).Frequency(), 0.01)
+	}
+}
+
+func TestNote_Transpose(t *testing.T) {
+	assert.Equal(t, pitch.NewNote(pitch.CNatural, 5), pitch.NewNote(pitch.BNatural, 4).Transpose(1))
+	assert.Equal(t, pitch.NewNote(pitch.BNatural, 3), pitch.NewNote(pitch.CNatural, 4).Transpose(-1))
+	assert.Equal(t, pitch.NewNote(pitch.ENatural, 2), pitch.NewNote(pitch.ENatural, 4).Transpose(-24))
+}
+
+func TestNoteRange(t *testing.T) {
+	notes := pitch.NoteRange(pitch.NewNote(pitch.ANatural, 0), pitch.NewNote(pitch.CNatural, 8))
+	require.Len(t, notes, 88)
+	assert.Equal(t, pitch.NewNote(pitch.ANatural, 0), notes[0])
+	assert.Equal(t, pitch.NewNote(pitch.CNatural, 8), notes[87])
+	assert.Empty(t, pitch.NoteRange(pitch.NewNote(pitch.CNatural, 5), pitch.NewNote(pitch.CNatural, 4)))
+}
+
+func TestSlice_Notes(t *testing.T) {
+	given := pitch.Slice{pitch.ANatural, pitch.CSharp, pitch.ENatural, pitch.ANatural}
+	expected := []pitch.Note{
+		pitch.NewNote(pitch.ANatural, 3),
+		pitch.NewNote(pitch.CSharp, 4),
+		pitch.NewNote(pitch.ENatural, 4),
+		pitch.NewNote(pitch.ANatural, 4),
+	}
+	require.Equal(t, expected, given.Notes(3))
+}
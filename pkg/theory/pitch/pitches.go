@@ -71,3 +71,15 @@ func (s Slice) Transpose(amount int) Slice {
 func (s Slice) Equal(v Slice) bool {
 	return s.Signature() == v.Signature()
 }
+
+// FromZeitlerSignature returns pitch slice from given signature according to William Zeitler's system
+func FromZeitlerSignature(signature int) Slice {
+	pitches := make([]Type, 0)
+	for _, v := range AllPitches() {
+		if signature&v.ZeitlerNumber() == v.ZeitlerNumber() {
+			pitches = append(pitches, v)
+		}
+	}
+
+	return pitches
+}
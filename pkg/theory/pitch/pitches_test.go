@@ -30,3 +30,10 @@ func TestSlice_Unique(t *testing.T) {
 	expected := pitch.Slice{pitch.CNatural, pitch.ENatural, pitch.GNatural}
 	require.Equal(t, expected, given.Unique())
 }
+
+func TestFromZeitlerSignature(t *testing.T) {
+	given := pitch.Slice{pitch.GNatural, pitch.CNatural, pitch.ENatural}
+	expected := pitch.Slice{pitch.CNatural, pitch.ENatural, pitch.GNatural}
+	require.Equal(t, expected, pitch.FromZeitlerSignature(given.ZeitlerSignature()))
+	require.Empty(t, pitch.FromZeitlerSignature(0))
+}
@@ -0,0 +1,200 @@
+package progression
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/degree"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/scale"
+)
+
+// Function is a type for harmonic function of a chord within a key
+type Function int
+
+// Harmonic function enumerations
+const (
+	Invalid              Function = iota
+	Diatonic             Function = iota
+	SecondaryDominant    Function = iota
+	SecondaryLeadingTone Function = iota
+	Borrowed             Function = iota
+	Neapolitan           Function = iota
+	ItalianSixth         Function = iota
+	FrenchSixth          Function = iota
+	GermanSixth          Function = iota
+	Chromatic            Function = iota
+)
+
+// String returns harmonic function name
+func (f Function) String() string {
+	if f < Diatonic || f > Chromatic {
+		return "Invalid"
+	}
+
+	return [...]string{
+		"Invalid",
+		"Diatonic",
+		"SecondaryDominant",
+		"SecondaryLeadingTone",
+		"Borrowed",
+		"Neapolitan",
+		"ItalianSixth",
+		"FrenchSixth",
+		"GermanSixth",
+		"Chromatic",
+	}[f]
+}
+
+// Numeral is Roman numeral analysis of a chord within a key. Degree is the scale degree of chord root, or of the
+// tonicized chord for secondary dominants and leading tones.
+type Numeral struct {
+	Numeral  string
+	Degree   degree.Type
+	Function Function
+	Diatonic bool
+}
+
+// Analyze returns Roman numeral of each chord within a key of given scale and tonic
+func Analyze(s scale.Type, tonic pitch.Type, chords []chord.Chord) []Numeral {
+	numerals := make([]Numeral, 0)
+	for _, v := range chords {
+		numerals = append(numerals, AnalyzeChord(s, tonic, v))
+	}
+
+	return numerals
+}
+
+// AnalyzeChord returns Roman numeral of a chord within a key of given scale and tonic. Chords made up of key pitches
+// are diatonic, minor keys also take pitches of harmonic and melodic minor. Other chords are looked up as augmented
+// sixths, Neapolitan, chords borrowed from the parallel key, such as bVI of C major borrowed from C minor, then
+// secondary dominants and leading tones. The rest are named after their root relative to the tonic.
+func AnalyzeChord(s scale.Type, tonic pitch.Type, c chord.Chord) Numeral {
+	if s == scale.Invalid || tonic < pitch.CNatural || tonic > pitch.BNatural || c.Quality.RomanNumeral(1) == "" {
+		return Numeral{}
+	}
+
+	for _, v := range diatonicScales(s) {
+		if index := slices.Index(v.Pitches(tonic), c.Root); index >= 0 && contains(v.Pitches(tonic), c.Pitches()) {
+			return Numeral{Numeral: figured(c, index+1), Degree: degree.FromInt(index + 1), Function: Diatonic, Diatonic: true}
+		}
+	}
+
+	// augmented sixths are told by pitches above the tonic regardless of their spelled root
+	switch classes := relativeClasses(tonic, c.Pitches()); {
+	case slices.Equal(classes, []int{0, 6, 8}):
+		return Numeral{Numeral: "It+6", Degree: degree.Subtonic, Function: ItalianSixth}
+	case slices.Equal(classes, []int{0, 2, 6, 8}):
+		return Numeral{Numeral: "Fr+6", Degree: degree.Subtonic, Function: FrenchSixth}
+	case slices.Equal(classes, []int{0, 3, 6, 8}):
+		return Numeral{Numeral: "Ger+6", Degree: degree.Subtonic, Function: GermanSixth}
+	}
+
+	if c.Quality == chord.Major && c.Root == tonic.Transpose(1) {
+		return Numeral{Numeral: strings.Replace(figured(c, 1), "I", "N", 1), Degree: degree.Supertonic, Function: Neapolitan}
+	}
+
+	if contains(parallelScale(s).Pitches(tonic), c.Pitches()) {
+		numeral := chromaticNumeral(tonic, c)
+		numeral.Function = Borrowed
+		return numeral
+	}
+
+	keyPitches := s.Pitches(tonic)
+	harmonies := s.Harmonize(tonic)
+	tonicized := func(target pitch.Type) (string, int, bool) {
+		index := slices.Index(keyPitches, target)
+		if index <= 0 {
+			return "", 0, false
+		}
+
+		switch triad := harmonies[index].Triad; triad {
+		case chord.Major, chord.Minor:
+			return triad.RomanNumeral(index + 1), index + 1, true
+		default:
+			return "", 0, false
+		}
+	}
+
+	switch c.Quality {
+	case chord.Major, chord.DominantSeventh, chord.DominantNinth, chord.DominantSeventhFlatNinth:
+		if target, targetDegree, ok := tonicized(c.Root.Transpose(5)); ok {
+			return Numeral{Numeral: figured(c, 5) + "/" + target, Degree: degree.FromInt(targetDegree), Function: SecondaryDominant}
+		}
+	case chord.Diminished, chord.DiminishedSeventh, chord.MinorSeventhFlatFifth:
+		if target, targetDegree, ok := tonicized(c.Root.Transpose(1)); ok {
+			return Numeral{Numeral: figured(c, 7) + "/" + target, Degree: degree.FromInt(targetDegree), Function: SecondaryLeadingTone}
+		}
+	}
+
+	numeral := chromaticNumeral(tonic, c)
+	numeral.Function = Chromatic
+	return numeral
+}
+
+// chromaticNumeral returns Roman numeral named after chord root relative to major scale of the tonic, such as bVI
+func chromaticNumeral(tonic pitch.Type, c chord.Chord) Numeral {
+	prefixes := [...]string{"", "b", "", "b", "", "", "#", "", "b", "", "b", ""}
+	degrees := [...]int{1, 2, 2, 3, 3, 4, 4, 5, 6, 6, 7, 7}
+	interval := (int(c.Root-tonic) + 12) % 12
+	return Numeral{Numeral: prefixes[interval] + figured(c, degrees[interval]), Degree: degree.FromInt(degrees[interval])}
+}
+
+// diatonicScales returns scales whose chords are diatonic to the key, minor keys include harmonic and melodic minor
+func diatonicScales(s scale.Type) []scale.Type {
+	if s == scale.Aeolian {
+		return []scale.Type{scale.Aeolian, scale.Mydian, scale.Bocrian}
+	}
+
+	return []scale.Type{s}
+}
+
+// parallelScale returns scale where chords are borrowed from, parallel minor for keys with major third and parallel
+// major otherwise
+func parallelScale(s scale.Type) scale.Type {
+	if slices.Contains(s.PitchClass(), 4) {
+		return scale.Aeolian
+	}
+
+	return scale.Ionian
+}
+
+// figured returns Roman numeral with figured bass of inverted triads and seventh chords, such as "V65" or "ii6"
+func figured(c chord.Chord, degree int) string {
+	numeral := c.Quality.RomanNumeral(degree)
+	inversion, found := c.Inversion()
+	if !found || inversion == chord.RootPosition {
+		return numeral
+	}
+
+	switch {
+	case c.Quality.Cardinality() == 3:
+		return numeral + [...]string{"", "6", "64"}[inversion]
+	case c.Quality.Cardinality() == 4 && strings.HasSuffix(numeral, "7"):
+		return strings.TrimSuffix(numeral, "7") + [...]string{"7", "65", "43", "42"}[inversion]
+	default:
+		return numeral
+	}
+}
+
+// relativeClasses returns sorted pitch classes relative to the tonic
+func relativeClasses(tonic pitch.Type, pitches []pitch.Type) []int {
+	classes := make([]int, 0)
+	for _, v := range pitches {
+		classes = append(classes, (int(v-tonic)+12)%12)
+	}
+	slices.Sort(classes)
+
+	return classes
+}
+
+func contains(pitches []pitch.Type, subset []pitch.Type) bool {
+	for _, v := range subset {
+		if !slices.Contains(pitches, v) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,87 @@
+package progression_test
+
+import (
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/degree"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/progression"
+	"github.com/edipermadi/music-db/pkg/theory/scale"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzeChord(t *testing.T) {
+	type testCase struct {
+		Title    string
+		Scale    scale.Type
+		Chord    string
+		Numeral  string
+		Function progression.Function
+	}
+
+	testCases := []testCase{
+		{Title: "Tonic", Scale: scale.Ionian, Chord: "C", Numeral: "I", Function: progression.Diatonic},
+		{Title: "Supertonic", Scale: scale.Ionian, Chord: "Dm7", Numeral: "ii7", Function: progression.Diatonic},
+		{Title: "DominantSeventhFirstInversion", Scale: scale.Ionian, Chord: "G7/B", Numeral: "V65", Function: progression.Diatonic},
+		{Title: "TonicSecondInversion", Scale: scale.Ionian, Chord: "C/G", Numeral: "I64", Function: progression.Diatonic},
+		{Title: "LeadingTone", Scale: scale.Ionian, Chord: "Bm7b5", Numeral: "viiø7", Function: progression.Diatonic},
+		{Title: "SecondaryDominantOfDominant", Scale: scale.Ionian, Chord: "D7", Numeral: "V7/V", Function: progression.SecondaryDominant},
+		{Title: "SecondaryDominantFirstInversion", Scale: scale.Ionian, Chord: "D7/F#", Numeral: "V65/V", Function: progression.SecondaryDominant},
+		{Title: "SecondaryDominantOfSubmediant", Scale: scale.Ionian, Chord: "E", Numeral: "V/vi", Function: progression.SecondaryDominant},
+		{Title: "SecondaryLeadingTone", Scale: scale.Ionian, Chord: "F#dim7", Numeral: "vii°7/V", Function: progression.SecondaryLeadingTone},
+		{Title: "BorrowedSubmediant", Scale: scale.Ionian, Chord: "Ab", Numeral: "bVI", Function: progression.Borrowed},
+		{Title: "BorrowedSubdominant", Scale: scale.Ionian, Chord: "Fm", Numeral: "iv", Function: progression.Borrowed},
+		{Title: "BorrowedSubtonic", Scale: scale.Ionian, Chord: "Bb", Numeral: "bVII", Function: progression.Borrowed},
+		{Title: "Neapolitan", Scale: scale.Ionian, Chord: "Db/F", Numeral: "N6", Function: progression.Neapolitan},
+		{Title: "GermanSixth", Scale: scale.Ionian, Chord: "Ab7", Numeral: "Ger+6", Function: progression.GermanSixth},
+		{Title: "FrenchSixth", Scale: scale.Ionian, Chord: "Ab7b5", Numeral: "Fr+6", Function: progression.FrenchSixth},
+		{Title: "Chromatic", Scale: scale.Ionian, Chord: "F#", Numeral: "#IV", Function: progression.Chromatic},
+		{Title: "MinorDominant", Scale: scale.Aeolian, Chord: "G7", Numeral: "V7", Function: progression.Diatonic},
+		{Title: "MinorMediant", Scale: scale.Aeolian, Chord: "Eb", Numeral: "III", Function: progression.Diatonic},
+		{Title: "PicardyThird", Scale: scale.Aeolian, Chord: "C", Numeral: "I", Function: progression.Borrowed},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			c, err := chord.Parse(tc.Chord)
+			require.NoError(t, err)
+
+			numeral := progression.AnalyzeChord(tc.Scale, pitch.CNatural, c)
+			assert.Equal(t, tc.Numeral, numeral.Numeral)
+			assert.Equal(t, tc.Function, numeral.Function)
+			assert.Equal(t, tc.Function == progression.Diatonic, numeral.Diatonic)
+		})
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	chords := []chord.Chord{
+		chord.New(chord.Major, pitch.GNatural),
+		chord.New(chord.Minor, pitch.ENatural),
+		chord.New(chord.DominantSeventh, pitch.ENatural),
+		chord.New(chord.Major, pitch.DNatural),
+	}
+
+	numerals := progression.Analyze(scale.Ionian, pitch.GNatural, chords)
+	require.Len(t, numerals, 4)
+	assert.Equal(t, "I", numerals[0].Numeral)
+	assert.Equal(t, "vi", numerals[1].Numeral)
+	assert.Equal(t, "V7/ii", numerals[2].Numeral)
+	assert.Equal(t, "V", numerals[3].Numeral)
+
+	assert.Equal(t, progression.Numeral{}, progression.AnalyzeChord(scale.Invalid, pitch.CNatural, chords[0]))
+	assert.Equal(t, "Invalid", progression.Invalid.String())
+}
+
+func TestAnalyzeChord_Degree(t *testing.T) {
+	secondary := progression.AnalyzeChord(scale.Ionian, pitch.CNatural, chord.New(chord.DominantSeventh, pitch.DNatural))
+	assert.Equal(t, degree.Dominant, secondary.Degree)
+
+	borrowed := progression.AnalyzeChord(scale.Ionian, pitch.CNatural, chord.New(chord.Major, pitch.GSharp))
+	assert.Equal(t, degree.Subtonic, borrowed.Degree)
+
+	diatonic := progression.AnalyzeChord(scale.Ionian, pitch.CNatural, chord.New(chord.Minor, pitch.DNatural))
+	assert.Equal(t, degree.Supertonic, diatonic.Degree)
+}
@@ -0,0 +1,202 @@
+package progression
+
+import (
+	"math/rand"
+	"slices"
+
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/scale"
+)
+
+// Style is a type for progression style
+type Style int
+
+// Progression style enumerations
+const (
+	InvalidStyle Style = iota
+	Pop          Style = iota
+	Jazz         Style = iota
+	ModalVamp    Style = iota
+	Blues        Style = iota
+)
+
+// AllStyles returns all progression styles
+func AllStyles() []Style {
+	return []Style{
+		Pop,
+		Jazz,
+		ModalVamp,
+		Blues,
+	}
+}
+
+// String returns progression style name
+func (s Style) String() string {
+	if s < Pop || s > Blues {
+		return "Invalid"
+	}
+
+	return [...]string{
+		"Invalid",
+		"Pop",
+		"Jazz",
+		"ModalVamp",
+		"Blues",
+	}[s]
+}
+
+// Identifier returns progression style identifier as used in query parameters, such as "modal_vamp"
+func (s Style) Identifier() string {
+	if s < Pop || s > Blues {
+		return "invalid"
+	}
+
+	return [...]string{
+		"invalid",
+		"pop",
+		"jazz",
+		"modal_vamp",
+		"blues",
+	}[s]
+}
+
+// StyleFromString returns progression style from its name or identifier, such as "ModalVamp" or "modal_vamp"
+func StyleFromString(name string) Style {
+	for _, v := range AllStyles() {
+		if v.String() == name || v.Identifier() == name {
+			return v
+		}
+	}
+
+	return InvalidStyle
+}
+
+// popMoves lists degrees commonly following each degree in pop progressions
+var popMoves = map[int][]int{
+	1: {4, 5, 6, 2},
+	2: {5, 4},
+	3: {6, 4},
+	4: {1, 5, 2, 6},
+	5: {1, 6, 4},
+	6: {4, 2, 5},
+	7: {1, 3},
+}
+
+// jazzApproaches lists degrees commonly preceding each degree in jazz progressions, mostly by descending fifths
+var jazzApproaches = map[int][]int{
+	1: {5},
+	2: {6, 4, 1},
+	3: {7, 1},
+	4: {1},
+	5: {2, 4},
+	6: {3, 1},
+	7: {4},
+}
+
+// bluesDegrees is degree of each bar of twelve bar blues
+var bluesDegrees = []int{1, 1, 1, 1, 4, 4, 1, 1, 5, 4, 1, 5}
+
+// Generate returns chord progression of given length in a key of given scale and tonic, using only available chords
+// in root position. Same random source seed returns the same progression. Pop progressions wander between degrees
+// starting from the tonic, jazz progressions are chains of seventh chords mostly by fifths ending with V-I, modal vamps
+// alternate the tonic with a neighbouring degree and blues progressions follow twelve bar blues with dominant seventh
+// chords where available. Found is false when the key has not enough available chords for the style.
+func Generate(s scale.Type, tonic pitch.Type, style Style, length int, available []chord.Chord, random *rand.Rand) ([]chord.Chord, bool) {
+	harmonies := s.Harmonize(tonic)
+	if length < 1 || len(harmonies) == 0 {
+		return nil, false
+	}
+
+	// chords of a degree using the first available quality
+	voicing := func(qualities func(h scale.Harmony) []chord.Quality) func(int) (chord.Chord, bool) {
+		return func(degree int) (chord.Chord, bool) {
+			if degree < 1 || degree > len(harmonies) {
+				return chord.Chord{}, false
+			}
+
+			harmony := harmonies[degree-1]
+			for _, q := range qualities(harmony) {
+				c := chord.New(q, harmony.Root)
+				if q != chord.Invalid && slices.Contains(available, c) {
+					return c, true
+				}
+			}
+
+			return chord.Chord{}, false
+		}
+	}
+	triad := voicing(func(h scale.Harmony) []chord.Quality { return []chord.Quality{h.Triad} })
+	seventh := voicing(func(h scale.Harmony) []chord.Quality { return []chord.Quality{h.Seventh, h.Triad} })
+	dominant := voicing(func(h scale.Harmony) []chord.Quality { return []chord.Quality{chord.DominantSeventh, h.Triad} })
+
+	// random degree having a chord, falls back to the tonic
+	pick := func(degrees []int, voiced func(int) (chord.Chord, bool)) int {
+		candidates := make([]int, 0)
+		for _, v := range degrees {
+			if _, ok := voiced(v); ok {
+				candidates = append(candidates, v)
+			}
+		}
+
+		if len(candidates) == 0 {
+			return 1
+		}
+
+		return candidates[random.Intn(len(candidates))]
+	}
+
+	switch style {
+	case Pop:
+		return walk(length, 1, triad, func(previous int) int {
+			return pick(popMoves[previous], triad)
+		})
+	case Jazz:
+		chords, found := walk(length, 1, seventh, func(next int) int {
+			return pick(jazzApproaches[next], seventh)
+		})
+		slices.Reverse(chords)
+		return chords, found
+	case ModalVamp:
+		neighbour := pick([]int{2, 4, 7}, triad)
+		if neighbour == 1 {
+			return nil, false
+		}
+
+		return walk(length, 1, triad, func(previous int) int {
+			if previous == 1 {
+				return neighbour
+			}
+
+			return 1
+		})
+	case Blues:
+		// quick change moves to the fourth degree on the second bar
+		degrees := slices.Clone(bluesDegrees)
+		if random.Intn(2) == 1 {
+			degrees[1] = 4
+		}
+
+		bar := 0
+		return walk(length, degrees[0], dominant, func(int) int {
+			bar++
+			return degrees[bar%len(degrees)]
+		})
+	default:
+		return nil, false
+	}
+}
+
+// walk returns chords of degrees visited from the first degree, found is false when a degree has no chord
+func walk(length int, first int, voiced func(int) (chord.Chord, bool), next func(int) int) ([]chord.Chord, bool) {
+	chords := make([]chord.Chord, 0)
+	for degree := first; len(chords) < length; degree = next(degree) {
+		c, ok := voiced(degree)
+		if !ok {
+			return nil, false
+		}
+		chords = append(chords, c)
+	}
+
+	return chords, true
+}
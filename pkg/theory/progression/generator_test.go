@@ -0,0 +1,95 @@
+package progression_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/progression"
+	"github.com/edipermadi/music-db/pkg/theory/scale"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// keyChords returns root position chords made up of key pitches, as linked to the key
+func keyChords(s scale.Type, tonic pitch.Type) []chord.Chord {
+	chords := make([]chord.Chord, 0)
+	for _, q := range chord.AllQualities() {
+		for _, root := range s.Pitches(tonic) {
+			c := chord.New(q, root)
+			if numeral := progression.AnalyzeChord(s, tonic, c); numeral.Diatonic {
+				chords = append(chords, c)
+			}
+		}
+	}
+
+	return chords
+}
+
+func TestStyleFromString(t *testing.T) {
+	for _, v := range progression.AllStyles() {
+		assert.Equal(t, v, progression.StyleFromString(v.String()))
+		assert.Equal(t, v, progression.StyleFromString(v.Identifier()))
+	}
+
+	assert.Equal(t, progression.InvalidStyle, progression.StyleFromString("polka"))
+}
+
+func TestGenerate(t *testing.T) {
+	available := keyChords(scale.Ionian, pitch.CNatural)
+	for _, style := range progression.AllStyles() {
+		t.Run(style.String(), func(t *testing.T) {
+			chords, found := progression.Generate(scale.Ionian, pitch.CNatural, style, 8, available, rand.New(rand.NewSource(1)))
+			require.True(t, found)
+			require.Len(t, chords, 8)
+			for _, v := range chords {
+				assert.Contains(t, available, v)
+			}
+
+			// same seed returns the same progression
+			again, _ := progression.Generate(scale.Ionian, pitch.CNatural, style, 8, available, rand.New(rand.NewSource(1)))
+			assert.Equal(t, chords, again)
+		})
+	}
+
+	_, found := progression.Generate(scale.Ionian, pitch.CNatural, progression.Pop, 4, nil, rand.New(rand.NewSource(1)))
+	assert.False(t, found)
+
+	_, found = progression.Generate(scale.Ionian, pitch.CNatural, progression.InvalidStyle, 4, available, rand.New(rand.NewSource(1)))
+	assert.False(t, found)
+}
+
+func TestGenerate_Styles(t *testing.T) {
+	available := keyChords(scale.Ionian, pitch.CNatural)
+	numerals := func(chords []chord.Chord) []string {
+		entries := make([]string, 0)
+		for _, v := range progression.Analyze(scale.Ionian, pitch.CNatural, chords) {
+			entries = append(entries, v.Numeral)
+		}
+		return entries
+	}
+
+	for seed := int64(0); seed < 20; seed++ {
+		chords, _ := progression.Generate(scale.Ionian, pitch.CNatural, progression.Pop, 4, available, rand.New(rand.NewSource(seed)))
+		assert.Equal(t, "I", numerals(chords)[0])
+
+		chords, _ = progression.Generate(scale.Ionian, pitch.CNatural, progression.Jazz, 4, available, rand.New(rand.NewSource(seed)))
+		assert.Equal(t, []string{"V7", "Imaj7"}, numerals(chords)[2:])
+
+		chords, _ = progression.Generate(scale.Ionian, pitch.CNatural, progression.ModalVamp, 4, available, rand.New(rand.NewSource(seed)))
+		vamp := numerals(chords)
+		assert.Equal(t, "I", vamp[0])
+		assert.Equal(t, vamp[:2], vamp[2:])
+
+		chords, _ = progression.Generate(scale.Ionian, pitch.CNatural, progression.Blues, 12, available, rand.New(rand.NewSource(seed)))
+		blues := numerals(chords)
+		assert.Equal(t, []string{"I", "I", "IV", "IV", "I", "I", "V7", "IV", "I", "V7"}, blues[2:])
+	}
+
+	// dominant seventh chords are used when linked to the key
+	available = keyChords(scale.Mixolydian, pitch.CNatural)
+	chords, found := progression.Generate(scale.Mixolydian, pitch.CNatural, progression.Blues, 1, available, rand.New(rand.NewSource(1)))
+	require.True(t, found)
+	assert.Equal(t, []chord.Chord{chord.New(chord.DominantSeventh, pitch.CNatural)}, chords)
+}
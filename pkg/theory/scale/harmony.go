@@ -0,0 +1,74 @@
+package scale
+
+import (
+	"slices"
+
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+)
+
+// Harmony is stacked thirds harmony built on a scale degree. Chord qualities are chord.Invalid when stacked pitches
+// do not make up a known chord quality.
+type Harmony struct {
+	Degree  int
+	Root    pitch.Type
+	Triad   chord.Quality
+	Seventh chord.Quality
+	Ninth   chord.Quality
+}
+
+// Harmonize returns triad, seventh and ninth chords built on each scale degree with given tonic, by stacking every
+// other scale step. For heptatonic scales this is the usual diatonic harmony, such as I, ii, iii, IV, V, vi and vii°
+// of major scale. Chords are not made up when stacking wraps into pitches already taken, such as ninths of hexatonic
+// scales.
+func (s Type) Harmonize(tonic pitch.Type) []Harmony {
+	harmonies := make([]Harmony, 0)
+	pitches := s.Pitches(tonic)
+	for i, root := range pitches {
+		harmonies = append(harmonies, Harmony{
+			Degree:  i + 1,
+			Root:    root,
+			Triad:   stackedQuality(pitches, i, 3),
+			Seventh: stackedQuality(pitches, i, 4),
+			Ninth:   stackedQuality(pitches, i, 5),
+		})
+	}
+
+	return harmonies
+}
+
+// RomanNumerals returns Roman numerals of triad, seventh and ninth, empty when the chord is not made up
+func (h Harmony) RomanNumerals() (string, string, string) {
+	return h.Triad.RomanNumeral(h.Degree), h.Seventh.RomanNumeral(h.Degree), h.Ninth.RomanNumeral(h.Degree)
+}
+
+// stackedQuality returns chord quality made up of given count of pitches, taking every other pitch starting from root
+func stackedQuality(pitches []pitch.Type, root int, count int) chord.Quality {
+	intervals := make([]int, 0)
+	for i := 0; i < count; i++ {
+		semitones := (int(pitches[(root+2*i)%len(pitches)]-pitches[root]) + 12) % 12
+		if slices.Contains(intervals, semitones) {
+			return chord.Invalid
+		}
+		intervals = append(intervals, semitones)
+	}
+
+	// ninth chords keep their tension above the octave, other chords are matched by pitch class
+	extended := count > 4
+	if extended {
+		intervals[len(intervals)-1] += 12
+	}
+	slices.Sort(intervals)
+
+	for _, v := range chord.AllQualities() {
+		if v.Extended() != extended {
+			continue
+		}
+
+		if extended && slices.Equal(v.Intervals(), intervals) || !extended && slices.Equal(v.PitchClass(), intervals) {
+			return v
+		}
+	}
+
+	return chord.Invalid
+}
@@ -0,0 +1,63 @@
+package scale_test
+
+import (
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/scale"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScale_Harmonize(t *testing.T) {
+	type testCase struct {
+		Triad   string
+		Seventh string
+		Ninth   string
+	}
+
+	testCases := []testCase{
+		{Triad: "I", Seventh: "Imaj7", Ninth: "Imaj9"},
+		{Triad: "ii", Seventh: "ii7", Ninth: "ii9"},
+		{Triad: "iii", Seventh: "iii7", Ninth: ""},
+		{Triad: "IV", Seventh: "IVmaj7", Ninth: "IVmaj9"},
+		{Triad: "V", Seventh: "V7", Ninth: "V9"},
+		{Triad: "vi", Seventh: "vi7", Ninth: "vi9"},
+		{Triad: "vii°", Seventh: "viiø7", Ninth: ""},
+	}
+
+	harmonies := scale.Ionian.Harmonize(pitch.DNatural)
+	require.Len(t, harmonies, len(testCases))
+	assert.Equal(t, scale.Harmony{
+		Degree:  5,
+		Root:    pitch.ANatural,
+		Triad:   chord.Major,
+		Seventh: chord.DominantSeventh,
+		Ninth:   chord.DominantNinth,
+	}, harmonies[4])
+
+	for i, tc := range testCases {
+		triad, seventh, ninth := harmonies[i].RomanNumerals()
+		assert.Equal(t, tc.Triad, triad, "triad of degree %d", i+1)
+		assert.Equal(t, tc.Seventh, seventh, "seventh of degree %d", i+1)
+		assert.Equal(t, tc.Ninth, ninth, "ninth of degree %d", i+1)
+	}
+}
+
+func TestScale_HarmonizeOtherCardinalities(t *testing.T) {
+	// harmonic minor has augmented mediant and diminished seventh on the leading tone
+	harmonies := scale.Mydian.Harmonize(pitch.ANatural)
+	require.Len(t, harmonies, 7)
+	assert.Equal(t, chord.Augmented, harmonies[2].Triad)
+	assert.Equal(t, chord.DiminishedSeventh, harmonies[6].Seventh)
+
+	// whole tone scale stacks into augmented triads, its ninth wraps into the root
+	harmonies = scale.WholeTone.Harmonize(pitch.CNatural)
+	for _, v := range harmonies {
+		assert.Equal(t, chord.Augmented, v.Triad)
+		assert.Equal(t, chord.Invalid, v.Ninth)
+	}
+
+	assert.Empty(t, scale.Invalid.Harmonize(pitch.CNatural))
+}
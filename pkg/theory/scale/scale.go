@@ -1772,6 +1772,11 @@ func (s Type) Pitches(tonic pitch.Type) []pitch.Type {
 	return pitches
 }
 
+// Notes returns ascending scale notes with given tonic, where the tonic is placed at given octave
+func (s Type) Notes(tonic pitch.Type, octave int) []pitch.Note {
+	return pitch.Slice(s.Pitches(tonic)).Notes(octave)
+}
+
 // PitchClass returns scale pitch class where 0 is CNatural all the way to 11 (BNatural)
 func (s Type) PitchClass() []int {
 	class := make([]int, 0)
@@ -1994,6 +1999,17 @@ func FromInt(num int) Type {
 	return Type(num)
 }
 
+// FromString returns scale from its name, such as "Ionian"
+func FromString(name string) Type {
+	for _, v := range AllScales() {
+		if v.String() == name {
+			return v
+		}
+	}
+
+	return Invalid
+}
+
 // Generator is scale generator components
 type Generator struct {
 	Orphan []pitch.WithDegree
@@ -304,3 +304,16 @@ func TestType_FifthGeneratorRootWithTonic(t *testing.T) {
 		})
 	}
 }
+
+func TestType_Notes(t *testing.T) {
+	notes := scale.Ionian.Notes(pitch.GNatural, 3)
+	assert.Len(t, notes, 7)
+	assert.Equal(t, pitch.NewNote(pitch.GNatural, 3), notes[0])
+	assert.Equal(t, pitch.NewNote(pitch.CNatural, 4), notes[3])
+	assert.Equal(t, pitch.NewNote(pitch.FSharp, 4), notes[6])
+}
+
+func TestFromString(t *testing.T) {
+	assert.Equal(t, scale.Ionian, scale.FromString("Ionian"))
+	assert.Equal(t, scale.Invalid, scale.FromString("Unknown"))
+}
@@ -0,0 +1,470 @@
+package spelling
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/scale"
+)
+
+// Letter is a type for note letter
+type Letter int
+
+// Note letter enumerations
+const (
+	Invalid Letter = iota
+	C       Letter = iota
+	D       Letter = iota
+	E       Letter = iota
+	F       Letter = iota
+	G       Letter = iota
+	A       Letter = iota
+	B       Letter = iota
+)
+
+// String returns letter name
+func (l Letter) String() string {
+	if l < C || l > B {
+		return "Invalid"
+	}
+
+	return [...]string{"Invalid", "C", "D", "E", "F", "G", "A", "B"}[l]
+}
+
+// Semitones returns count of semitones from C to the natural letter
+func (l Letter) Semitones() int {
+	if l < C || l > B {
+		return 0
+	}
+
+	return [...]int{0, 0, 2, 4, 5, 7, 9, 11}[l]
+}
+
+// Next returns letter which is given amount of steps above
+func (l Letter) Next(steps int) Letter {
+	if l < C || l > B {
+		return Invalid
+	}
+
+	return Letter((((int(l)-1)+steps)%7+7)%7 + 1)
+}
+
+// Accidental is a type for accidental, expressed as semitones alteration of the natural letter
+type Accidental int
+
+// Accidental enumerations
+const (
+	DoubleFlat  Accidental = -2
+	Flat        Accidental = -1
+	Natural     Accidental = 0
+	Sharp       Accidental = 1
+	DoubleSharp Accidental = 2
+)
+
+// String returns accidental symbol
+func (a Accidental) String() string {
+	switch a {
+	case DoubleFlat:
+		return "𝄫"
+	case Flat:
+		return "♭"
+	case Sharp:
+		return "♯"
+	case DoubleSharp:
+		return "𝄪"
+	default:
+		return ""
+	}
+}
+
+// Name represents spelled pitch name, a letter with accidental
+type Name struct {
+	Letter     Letter
+	Accidental Accidental
+}
+
+// Valid returns true when name has valid letter and accidental
+func (n Name) Valid() bool {
+	return n.Letter >= C && n.Letter <= B && n.Accidental >= DoubleFlat && n.Accidental <= DoubleSharp
+}
+
+// String returns spelled pitch name, such as "B♭" or "F𝄪"
+func (n Name) String() string {
+	if !n.Valid() {
+		return "Invalid"
+	}
+
+	return n.Letter.String() + n.Accidental.String()
+}
+
+// Pitch returns pitch class of the name
+func (n Name) Pitch() pitch.Type {
+	if !n.Valid() {
+		return pitch.Invalid
+	}
+
+	return pitch.CNatural.Transpose(n.Letter.Semitones() + int(n.Accidental))
+}
+
+// Default returns default spelling of pitch, using sharps for black keys
+func Default(p pitch.Type) Name {
+	if p < pitch.CNatural || p > pitch.BNatural {
+		return Name{}
+	}
+
+	return [...]Name{
+		{},
+		{Letter: C},
+		{Letter: C, Accidental: Sharp},
+		{Letter: D},
+		{Letter: D, Accidental: Sharp},
+		{Letter: E},
+		{Letter: F},
+		{Letter: F, Accidental: Sharp},
+		{Letter: G},
+		{Letter: G, Accidental: Sharp},
+		{Letter: A},
+		{Letter: A, Accidental: Sharp},
+		{Letter: B},
+	}[p]
+}
+
+// Spell returns name of pitch using given letter, valid is false when more than double accidental is needed
+func Spell(p pitch.Type, letter Letter) (Name, bool) {
+	if p < pitch.CNatural || p > pitch.BNatural || letter < C || letter > B {
+		return Name{}, false
+	}
+
+	// alteration in range of -6 to 5 semitones
+	alteration := ((int(p-pitch.CNatural)-letter.Semitones())%12 + 18) % 12
+	alteration -= 6
+
+	name := Name{Letter: letter, Accidental: Accidental(alteration)}
+	return name, name.Valid()
+}
+
+// candidates returns possible spellings of tonic or root, natural pitches are always spelled as natural
+// while the others are spelled as either sharp or flat
+func candidates(p pitch.Type) []Name {
+	names := make([]Name, 0)
+	for _, accidental := range []Accidental{Natural, Sharp, Flat} {
+		for letter := C; letter <= B; letter++ {
+			if name, valid := Spell(p, letter); valid && name.Accidental == accidental {
+				names = append(names, name)
+			}
+		}
+
+		if accidental == Natural && len(names) > 0 {
+			break
+		}
+	}
+
+	return names
+}
+
+// Key returns spelled pitches of a key in ascending order starting from the tonic
+func Key(s scale.Type, tonic pitch.Type) []Name {
+	return Pitches(s.Pitches(tonic))
+}
+
+// Chord returns spelled pitches of a chord in ascending order starting from the root.
+// Letters are assigned according to generic interval of each chord pitch, so a diminished seventh
+// on C is spelled as C, E♭, G♭ and B𝄫. Flats are preferred when sharp and flat spellings need as many accidentals.
+func Chord(q chord.Quality, root pitch.Type) []Name {
+	roots := candidates(root)
+	sort.SliceStable(roots, func(i, j int) bool { return roots[i].Accidental < roots[j].Accidental })
+	if names := spellChord(q, roots); names != nil {
+		return names
+	}
+
+	return Pitches(q.Pitches(root))
+}
+
+// ChordFromRoot returns spelled pitches of a chord as Chord does, keeping given spelling of the root whenever
+// the chord can be spelled from it
+func ChordFromRoot(q chord.Quality, root Name) []Name {
+	if names := spellChord(q, []Name{root}); names != nil {
+		return names
+	}
+
+	return Chord(q, root.Pitch())
+}
+
+// spellChord returns chord spelling with fewest accidentals among given root spellings, first one wins on ties.
+// Result is nil when none of the roots spells the chord.
+func spellChord(q chord.Quality, roots []Name) []Name {
+	numbers := q.GenericIntervals()
+
+	var best []Name
+	bestCost := -1
+	for _, candidate := range roots {
+		pitches := q.Pitches(candidate.Pitch())
+		if !candidate.Valid() || len(pitches) == 0 || len(pitches) != len(numbers) {
+			continue
+		}
+
+		names := make([]Name, 0)
+		cost := 0
+		for i, v := range pitches {
+			name, valid := Spell(v, candidate.Letter.Next(numbers[i]-1))
+			if !valid {
+				names = nil
+				break
+			}
+
+			cost += accidentalCost(name.Accidental)
+			names = append(names, name)
+		}
+
+		if names != nil && (bestCost < 0 || cost < bestCost) {
+			best = names
+			bestCost = cost
+		}
+	}
+
+	return best
+}
+
+// Symbol returns chord symbol with root and bass spelled as chord pitches, such as "Bb7" or "Ebmaj7/G". Accidentals are
+// written as "#" and "b" as on lead sheets, a bass whose chord spelling needs a double accidental is spelled with flats
+// when the root is.
+func Symbol(c chord.Chord) string {
+	return spellSymbol(c, Chord(c.Quality, c.Root))
+}
+
+// SymbolFromRoot returns chord symbol as Symbol does, keeping given spelling of the root, such as the one written
+// in a parsed symbol. Symbol is used when the name does not spell the chord root.
+func SymbolFromRoot(c chord.Chord, root Name) string {
+	if !root.Valid() || root.Pitch() != c.Root {
+		return Symbol(c)
+	}
+
+	return spellSymbol(c, ChordFromRoot(c.Quality, root))
+}
+
+// SymbolRoot returns spelling of the root written at the start of a chord symbol, such as D♭ for "Db9".
+// Found is false when the symbol does not start with a pitch name.
+func SymbolRoot(symbol string) (Name, bool) {
+	symbol = strings.TrimSpace(symbol)
+	if symbol == "" {
+		return Name{}, false
+	}
+
+	name := Name{Letter: Letter(strings.IndexByte("CDEFGAB", symbol[0]) + 1)}
+	if !name.Valid() {
+		return Name{}, false
+	}
+
+	switch rest := symbol[1:]; {
+	case strings.HasPrefix(rest, "#"), strings.HasPrefix(rest, "♯"):
+		name.Accidental = Sharp
+	case strings.HasPrefix(rest, "b"), strings.HasPrefix(rest, "♭"):
+		name.Accidental = Flat
+	}
+
+	return name, true
+}
+
+// spellSymbol returns chord symbol using given chord spelling for the root and the bass
+func spellSymbol(c chord.Chord, names []Name) string {
+	if chord.Format(c) == "" {
+		return ""
+	}
+
+	root := Default(c.Root)
+	if len(names) > 0 && names[0].Pitch() == c.Root {
+		root = names[0]
+	}
+
+	symbol := symbolName(root) + c.Quality.Symbol()
+	if !c.IsSlash() {
+		return symbol
+	}
+
+	bass := Default(c.Bass)
+	for _, v := range candidates(c.Bass) {
+		if v.Accidental == Natural || (v.Accidental < Natural) == (root.Accidental < Natural) {
+			bass = v
+			break
+		}
+	}
+
+	for _, v := range names {
+		if v.Pitch() == c.Bass && v.Accidental >= Flat && v.Accidental <= Sharp {
+			bass = v
+			break
+		}
+	}
+
+	return symbol + "/" + symbolName(bass)
+}
+
+// symbolName returns spelled pitch name as written in chord symbols, such as "Bb" or "F##"
+func symbolName(n Name) string {
+	if n.Accidental < Natural {
+		return n.Letter.String() + strings.Repeat("b", int(-n.Accidental))
+	}
+
+	return n.Letter.String() + strings.Repeat("#", int(n.Accidental))
+}
+
+// Pitches returns spelled pitches in ascending order starting from the first pitch, which is treated as tonic.
+// Tonic spelling and letters are chosen to minimize accidentals while using each letter once whenever possible,
+// so heptatonic scales always get seven distinct letters.
+func Pitches(pitches pitch.Slice) []Name {
+	pitches = pitches.Unique()
+	if len(pitches) == 0 {
+		return make([]Name, 0)
+	}
+
+	// sort pitches ascending from the tonic
+	tonic := pitches[0]
+	offsets := make([]int, 0)
+	for _, v := range pitches {
+		if v < pitch.CNatural || v > pitch.BNatural {
+			continue
+		}
+
+		offsets = append(offsets, ((int(v)-int(tonic))%12+12)%12)
+	}
+	sort.Ints(offsets)
+
+	var best []Name
+	bestCost := -1
+	for _, candidate := range candidates(tonic) {
+		s := speller{tonic: candidate, offsets: offsets, memo: make(map[[2]int]solution)}
+		solved := s.solve(1, 0)
+		if !solved.valid {
+			continue
+		}
+
+		cost := solved.cost + accidentalCost(candidate.Accidental)
+		if bestCost < 0 || cost < bestCost {
+			best = append([]Name{candidate}, solved.names...)
+			bestCost = cost
+		}
+	}
+
+	if best == nil {
+		best = make([]Name, 0)
+		for _, v := range offsets {
+			best = append(best, Default(tonic.Transpose(v)))
+		}
+	}
+
+	return best
+}
+
+// Signature is a key signature, expressed as count of sharps or negated count of flats
+type Signature int
+
+// Letters returns letters altered by the signature in the order they are written
+func (s Signature) Letters() []Letter {
+	if s > 0 {
+		return append(make([]Letter, 0), []Letter{F, C, G, D, A, E, B}[:min(int(s), 7)]...)
+	}
+
+	return append(make([]Letter, 0), []Letter{B, E, A, D, G, C, F}[:min(int(-s), 7)]...)
+}
+
+// Accidental returns accidental of given letter under the signature
+func (s Signature) Accidental(letter Letter) Accidental {
+	for _, v := range s.Letters() {
+		if v == letter && s > 0 {
+			return Sharp
+		}
+
+		if v == letter {
+			return Flat
+		}
+	}
+
+	return Natural
+}
+
+// KeySignature returns key signature of spelled pitches, which needs the fewest accidentals written on the notes.
+// Signatures of fewer accidentals are preferred on a tie, so C harmonic minor gets three flats with a natural on B and
+// A harmonic minor gets no signature with a sharp on G.
+func KeySignature(names []Name) Signature {
+	best := Signature(0)
+	bestCost := -1
+	for _, s := range []Signature{0, 1, -1, 2, -2, 3, -3, 4, -4, 5, -5, 6, -6, 7, -7} {
+		cost := 0
+		for _, v := range names {
+			if v.Valid() && v.Accidental != s.Accidental(v.Letter) {
+				cost++
+			}
+		}
+
+		if bestCost < 0 || cost < bestCost {
+			best = s
+			bestCost = cost
+		}
+	}
+
+	return best
+}
+
+// duplicateLetterCost is the cost of reusing previous letter, equals to the cost of a double accidental
+const duplicateLetterCost = 2
+
+func accidentalCost(a Accidental) int {
+	if a < 0 {
+		return int(-a)
+	}
+
+	return int(a)
+}
+
+type solution struct {
+	valid bool
+	cost  int
+	names []Name
+}
+
+// speller assigns letters to ascending pitch offsets, letters never descend so each letter is either
+// the same as the previous one or above it
+type speller struct {
+	tonic   Name
+	offsets []int
+	memo    map[[2]int]solution
+}
+
+func (s speller) solve(index, previousStep int) solution {
+	if index >= len(s.offsets) {
+		return solution{valid: true, names: make([]Name, 0)}
+	}
+
+	key := [2]int{index, previousStep}
+	if cached, found := s.memo[key]; found {
+		return cached
+	}
+
+	best := solution{}
+	p := s.tonic.Pitch().Transpose(s.offsets[index])
+	for step := previousStep; step < 7; step++ {
+		name, valid := Spell(p, s.tonic.Letter.Next(step))
+		if !valid {
+			continue
+		}
+
+		rest := s.solve(index+1, step)
+		if !rest.valid {
+			continue
+		}
+
+		cost := rest.cost + accidentalCost(name.Accidental)
+		if step == previousStep {
+			cost += duplicateLetterCost
+		}
+
+		if !best.valid || cost < best.cost {
+			best = solution{valid: true, cost: cost, names: append([]Name{name}, rest.names...)}
+		}
+	}
+
+	s.memo[key] = best
+	return best
+}
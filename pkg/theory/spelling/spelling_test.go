@@ -0,0 +1,241 @@
+package spelling_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/scale"
+	"github.com/edipermadi/music-db/pkg/theory/spelling"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func names(values []spelling.Name) []string {
+	result := make([]string, 0)
+	for _, v := range values {
+		result = append(result, v.String())
+	}
+
+	return result
+}
+
+func TestName_String(t *testing.T) {
+	assert.Equal(t, "C", spelling.Name{Letter: spelling.C}.String())
+	assert.Equal(t, "B♭", spelling.Name{Letter: spelling.B, Accidental: spelling.Flat}.String())
+	assert.Equal(t, "F𝄪", spelling.Name{Letter: spelling.F, Accidental: spelling.DoubleSharp}.String())
+	assert.Equal(t, "B𝄫", spelling.Name{Letter: spelling.B, Accidental: spelling.DoubleFlat}.String())
+	assert.Equal(t, "Invalid", spelling.Name{}.String())
+}
+
+func TestName_Pitch(t *testing.T) {
+	assert.Equal(t, pitch.ASharp, spelling.Name{Letter: spelling.B, Accidental: spelling.Flat}.Pitch())
+	assert.Equal(t, pitch.BNatural, spelling.Name{Letter: spelling.C, Accidental: spelling.Flat}.Pitch())
+	assert.Equal(t, pitch.CNatural, spelling.Name{Letter: spelling.B, Accidental: spelling.Sharp}.Pitch())
+	assert.Equal(t, pitch.GNatural, spelling.Name{Letter: spelling.F, Accidental: spelling.DoubleSharp}.Pitch())
+
+	for _, v := range pitch.AllPitches() {
+		assert.Equal(t, v, spelling.Default(v).Pitch())
+	}
+}
+
+func TestSpell(t *testing.T) {
+	name, valid := spelling.Spell(pitch.ASharp, spelling.B)
+	require.True(t, valid)
+	assert.Equal(t, "B♭", name.String())
+
+	_, valid = spelling.Spell(pitch.CNatural, spelling.G)
+	assert.False(t, valid)
+}
+
+func TestKey(t *testing.T) {
+	type testCase struct {
+		Scale    scale.Type
+		Tonic    pitch.Type
+		Expected []string
+	}
+
+	testCases := []testCase{
+		{Scale: scale.Ionian, Tonic: pitch.CNatural, Expected: []string{"C", "D", "E", "F", "G", "A", "B"}},
+		{Scale: scale.Ionian, Tonic: pitch.FNatural, Expected: []string{"F", "G", "A", "B♭", "C", "D", "E"}},
+		{Scale: scale.Ionian, Tonic: pitch.ASharp, Expected: []string{"B♭", "C", "D", "E♭", "F", "G", "A"}},
+		{Scale: scale.Ionian, Tonic: pitch.CSharp, Expected: []string{"D♭", "E♭", "F", "G♭", "A♭", "B♭", "C"}},
+		{Scale: scale.Ionian, Tonic: pitch.BNatural, Expected: []string{"B", "C♯", "D♯", "E", "F♯", "G♯", "A♯"}},
+		{Scale: scale.Ionian, Tonic: pitch.FSharp, Expected: []string{"F♯", "G♯", "A♯", "B", "C♯", "D♯", "E♯"}},
+		{Scale: scale.Aeolian, Tonic: pitch.GSharp, Expected: []string{"G♯", "A♯", "B", "C♯", "D♯", "E", "F♯"}},
+		{Scale: scale.Aeolian, Tonic: pitch.FNatural, Expected: []string{"F", "G", "A♭", "B♭", "C", "D♭", "E♭"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s%s", tc.Tonic, tc.Scale), func(t *testing.T) {
+			assert.Equal(t, tc.Expected, names(spelling.Key(tc.Scale, tc.Tonic)))
+		})
+	}
+}
+
+func TestKey_Heptatonic(t *testing.T) {
+	for _, s := range scale.AllScales() {
+		if s.Cardinality() != 7 {
+			continue
+		}
+
+		for _, tonic := range pitch.AllPitches() {
+			spelled := spelling.Key(s, tonic)
+			require.Len(t, spelled, 7)
+
+			// spelling preserves the pitches of the key
+			pitches := make([]pitch.Type, 0)
+			for _, v := range spelled {
+				pitches = append(pitches, v.Pitch())
+			}
+			assert.ElementsMatch(t, s.Pitches(tonic), pitches)
+		}
+	}
+}
+
+func TestKeySignature(t *testing.T) {
+	type testCase struct {
+		Scale    scale.Type
+		Tonic    pitch.Type
+		Expected spelling.Signature
+	}
+
+	testCases := []testCase{
+		{Scale: scale.Ionian, Tonic: pitch.CNatural, Expected: 0},
+		{Scale: scale.Ionian, Tonic: pitch.DNatural, Expected: 2},
+		{Scale: scale.Ionian, Tonic: pitch.FSharp, Expected: 6},
+		{Scale: scale.Ionian, Tonic: pitch.CSharp, Expected: -5},
+		{Scale: scale.Aeolian, Tonic: pitch.FNatural, Expected: -4},
+		{Scale: scale.Dorian, Tonic: pitch.DNatural, Expected: 0},
+		{Scale: scale.Mydian, Tonic: pitch.ANatural, Expected: 0},
+		{Scale: scale.Mydian, Tonic: pitch.CNatural, Expected: -3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s%s", tc.Tonic, tc.Scale), func(t *testing.T) {
+			assert.Equal(t, tc.Expected, spelling.KeySignature(spelling.Key(tc.Scale, tc.Tonic)))
+		})
+	}
+}
+
+func TestSignature_Accidental(t *testing.T) {
+	assert.Equal(t, []spelling.Letter{spelling.F, spelling.C}, spelling.Signature(2).Letters())
+	assert.Equal(t, []spelling.Letter{spelling.B, spelling.E, spelling.A}, spelling.Signature(-3).Letters())
+	assert.Empty(t, spelling.Signature(0).Letters())
+
+	assert.Equal(t, spelling.Sharp, spelling.Signature(2).Accidental(spelling.C))
+	assert.Equal(t, spelling.Natural, spelling.Signature(2).Accidental(spelling.G))
+	assert.Equal(t, spelling.Flat, spelling.Signature(-3).Accidental(spelling.A))
+}
+
+func TestChord(t *testing.T) {
+	type testCase struct {
+		Quality  chord.Quality
+		Root     pitch.Type
+		Expected []string
+	}
+
+	testCases := []testCase{
+		{Quality: chord.Major, Root: pitch.CNatural, Expected: []string{"C", "E", "G"}},
+		{Quality: chord.Major, Root: pitch.ASharp, Expected: []string{"B♭", "D", "F"}},
+		{Quality: chord.Minor, Root: pitch.GSharp, Expected: []string{"G♯", "B", "D♯"}},
+		{Quality: chord.Augmented, Root: pitch.CNatural, Expected: []string{"C", "E", "G♯"}},
+		{Quality: chord.DiminishedSeventh, Root: pitch.CNatural, Expected: []string{"C", "E♭", "G♭", "B𝄫"}},
+		{Quality: chord.DominantSeventh, Root: pitch.DNatural, Expected: []string{"D", "F♯", "A", "C"}},
+		{Quality: chord.MajorDoubleSharpFifth, Root: pitch.CNatural, Expected: []string{"C", "E", "G𝄪"}},
+		{Quality: chord.MajorAddSharpNinth, Root: pitch.CNatural, Expected: []string{"C", "D♯", "E", "G"}},
+		{Quality: chord.Lydian, Root: pitch.CNatural, Expected: []string{"C", "F♯", "G"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s%s", tc.Root, tc.Quality), func(t *testing.T) {
+			assert.Equal(t, tc.Expected, names(spelling.Chord(tc.Quality, tc.Root)))
+		})
+	}
+}
+
+func TestChord_PreservesPitches(t *testing.T) {
+	for _, q := range chord.AllQualities() {
+		for _, root := range pitch.AllPitches() {
+			pitches := make([]pitch.Type, 0)
+			for _, v := range spelling.Chord(q, root) {
+				pitches = append(pitches, v.Pitch())
+			}
+			assert.Equal(t, q.Pitches(root), pitches)
+		}
+	}
+}
+
+func TestSymbol(t *testing.T) {
+	// common symbols are written back as they are
+	for _, symbol := range []string{
+		"C", "Bb7", "Ebmaj7", "G#m", "Db/F", "F#m7b5", "Am7/G", "C9sus4", "C7#11", "Cmaj7#11", "Dbmaj9#11",
+		"Bb13", "Eb7alt", "Gm6/9", "Cadd#11", "C7sus4", "Bbmaj7/D", "C/E", "Eb/G", "Db9", "Ab7",
+	} {
+		t.Run(symbol, func(t *testing.T) {
+			c, err := chord.Parse(symbol)
+			require.NoError(t, err)
+			assert.Equal(t, symbol, spelling.Symbol(c))
+		})
+	}
+
+	assert.Equal(t, "", spelling.Symbol(chord.New(chord.Invalid, pitch.CNatural)))
+}
+
+func TestSymbol_RoundTrip(t *testing.T) {
+	for _, q := range chord.AllQualities() {
+		for _, c := range q.Inversions(pitch.ASharp) {
+			parsed, err := chord.Parse(spelling.Symbol(c))
+			require.NoError(t, err, spelling.Symbol(c))
+			assert.Equal(t, c, parsed, spelling.Symbol(c))
+		}
+	}
+}
+
+func TestSymbolFromRoot(t *testing.T) {
+	// supplied root spelling is kept, even when the other spelling needs fewer accidentals
+	for _, symbol := range []string{
+		"Db9", "Gb7", "Ebm7b5", "Abm", "Gbmaj7/Bb", "Cb", "C#9", "F#7", "D#m7b5", "G#m", "Am7/G",
+	} {
+		t.Run(symbol, func(t *testing.T) {
+			c, err := chord.Parse(symbol)
+			require.NoError(t, err)
+
+			root, found := spelling.SymbolRoot(symbol)
+			require.True(t, found)
+			assert.Equal(t, symbol, spelling.SymbolFromRoot(c, root))
+		})
+	}
+
+	// root spelling of another pitch is ignored
+	c := chord.New(chord.DominantSeventh, pitch.FSharp)
+	assert.Equal(t, spelling.Symbol(c), spelling.SymbolFromRoot(c, spelling.Name{Letter: spelling.A, Accidental: spelling.Flat}))
+}
+
+func TestSymbolRoot(t *testing.T) {
+	type testCase struct {
+		Symbol   string
+		Expected string
+		Found    bool
+	}
+
+	testCases := []testCase{
+		{Symbol: "Db9", Expected: "D♭", Found: true},
+		{Symbol: "F#m7b5", Expected: "F♯", Found: true},
+		{Symbol: "E♭maj7", Expected: "E♭", Found: true},
+		{Symbol: "Bmaj7/D#", Expected: "B", Found: true},
+		{Symbol: "H7", Found: false},
+		{Symbol: "", Found: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Symbol, func(t *testing.T) {
+			root, found := spelling.SymbolRoot(tc.Symbol)
+			assert.Equal(t, tc.Found, found)
+			if tc.Found {
+				assert.Equal(t, tc.Expected, root.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package tuning
+
+import (
+	"math"
+
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+)
+
+// System is a type for tuning system
+type System int
+
+// Tuning system enumerations
+const (
+	Invalid              System = iota
+	EqualTemperament     System = iota
+	Pythagorean          System = iota
+	QuarterCommaMeantone System = iota
+	JustIntonation       System = iota
+	WerckmeisterIII      System = iota
+)
+
+// DefaultReference is the default frequency of A4 in Hz
+const DefaultReference = 440.0
+
+// AllSystems returns all tuning systems
+func AllSystems() []System {
+	return []System{
+		EqualTemperament,
+		Pythagorean,
+		QuarterCommaMeantone,
+		JustIntonation,
+		WerckmeisterIII,
+	}
+}
+
+// String returns tuning system name
+func (s System) String() string {
+	if s < EqualTemperament || s > WerckmeisterIII {
+		return "Invalid"
+	}
+
+	return [...]string{
+		"Invalid",
+		"EqualTemperament",
+		"Pythagorean",
+		"QuarterCommaMeantone",
+		"JustIntonation",
+		"WerckmeisterIII",
+	}[s]
+}
+
+// Identifier returns tuning system identifier as used in query parameters, such as "equal_temperament"
+func (s System) Identifier() string {
+	if s < EqualTemperament || s > WerckmeisterIII {
+		return "invalid"
+	}
+
+	return [...]string{
+		"invalid",
+		"equal_temperament",
+		"pythagorean",
+		"quarter_comma_meantone",
+		"just_intonation",
+		"werckmeister_iii",
+	}[s]
+}
+
+// FromString returns tuning system from its name or identifier, such as "JustIntonation" or "just_intonation"
+func FromString(name string) System {
+	for _, v := range AllSystems() {
+		if v.String() == name || v.Identifier() == name {
+			return v
+		}
+	}
+
+	return Invalid
+}
+
+// Cents returns size of each semitone step above the tonic in cents, from unison to major seventh
+func (s System) Cents() []float64 {
+	cents := make([]float64, 12)
+	switch s {
+	case EqualTemperament:
+		for i := range cents {
+			cents[i] = float64(i) * 100
+		}
+	case Pythagorean:
+		// pure fifths from minor second (5 fifths below) to augmented fourth (6 fifths above)
+		cents = chainOfFifths(ratioToCents(3.0/2), -5)
+	case QuarterCommaMeantone:
+		// fifths narrowed by a quarter of syntonic comma, from minor third to augmented fifth
+		cents = chainOfFifths(ratioToCents(math.Pow(5, 0.25)), -3)
+	case JustIntonation:
+		// asymmetric five-limit scale
+		for i, v := range []float64{1, 16.0 / 15, 9.0 / 8, 6.0 / 5, 5.0 / 4, 4.0 / 3, 45.0 / 32, 3.0 / 2, 8.0 / 5, 5.0 / 3, 9.0 / 5, 15.0 / 8} {
+			cents[i] = ratioToCents(v)
+		}
+	case WerckmeisterIII:
+		copy(cents, []float64{0, 90.225, 192.180, 294.135, 390.225, 498.045, 588.270, 696.090, 792.180, 888.270, 996.090, 1092.180})
+	default:
+		return make([]float64, 0)
+	}
+
+	return cents
+}
+
+// chainOfFifths returns cents of semitone steps generated by stacking fifths of given size,
+// starting from given amount of fifths below the tonic
+func chainOfFifths(fifth float64, lowest int) []float64 {
+	cents := make([]float64, 12)
+	for i := lowest; i < lowest+12; i++ {
+		step := ((i*7)%12 + 12) % 12
+		value := math.Mod(float64(i)*fifth, 1200)
+		if value < 0 {
+			value += 1200
+		}
+
+		cents[step] = value
+	}
+
+	return cents
+}
+
+func ratioToCents(ratio float64) float64 {
+	return 1200 * math.Log2(ratio)
+}
+
+// Tuning represents a tuning system with reference pitch and tonic
+type Tuning struct {
+	System    System
+	Reference float64    // frequency of A4 in Hz
+	Tonic     pitch.Type // pitch where non equal tuning systems are built from
+}
+
+// New returns tuning of given system, reference frequency of A4 and tonic.
+// Non positive reference defaults to 440Hz and invalid tonic defaults to C.
+func New(system System, reference float64, tonic pitch.Type) Tuning {
+	if reference <= 0 {
+		reference = DefaultReference
+	}
+
+	if tonic < pitch.CNatural || tonic > pitch.BNatural {
+		tonic = pitch.CNatural
+	}
+
+	return Tuning{System: system, Reference: reference, Tonic: tonic}
+}
+
+// Default returns twelve tone equal temperament with A4 tuned to 440Hz
+func Default() Tuning {
+	return New(EqualTemperament, DefaultReference, pitch.CNatural)
+}
+
+// Frequency returns note frequency in Hz.
+// Notes are tuned relative to the tonic, which is placed so that A4 sounds at the reference frequency.
+func (t Tuning) Frequency(note pitch.Note) float64 {
+	if !note.Valid() {
+		return 0
+	}
+
+	cents := t.System.Cents()
+	if len(cents) == 0 {
+		return 0
+	}
+
+	step := ((int(note.Pitch)-int(t.Tonic))%12 + 12) % 12
+	tonicMidi := note.Midi() - step
+
+	return t.Reference * math.Pow(2, (float64(tonicMidi-69)*100+cents[step]-t.referenceDeviation(cents))/1200)
+}
+
+// PitchFrequency returns frequency of the pitch at the fourth octave in Hz
+func (t Tuning) PitchFrequency(p pitch.Type) float64 {
+	return t.Frequency(pitch.NewNote(p, 4))
+}
+
+// Deviation returns deviation of the pitch from twelve tone equal temperament with the same reference, in cents
+func (t Tuning) Deviation(p pitch.Type) float64 {
+	cents := t.System.Cents()
+	if len(cents) == 0 || p < pitch.CNatural || p > pitch.BNatural {
+		return 0
+	}
+
+	step := ((int(p)-int(t.Tonic))%12 + 12) % 12
+	return cents[step] - float64(step)*100 - t.referenceDeviation(cents)
+}
+
+// referenceDeviation returns deviation of A from equal temperament in the system built from the tonic, in cents
+func (t Tuning) referenceDeviation(cents []float64) float64 {
+	step := ((int(pitch.ANatural)-int(t.Tonic))%12 + 12) % 12
+	return cents[step] - float64(step)*100
+}
@@ -0,0 +1,93 @@
+package tuning_test
+
+import (
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/tuning"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSystem_Cents(t *testing.T) {
+	type testCase struct {
+		System   tuning.System
+		Step     int
+		Expected float64
+	}
+
+	testCases := []testCase{
+		{System: tuning.EqualTemperament, Step: 7, Expected: 700},
+		{System: tuning.Pythagorean, Step: 7, Expected: 701.955},
+		{System: tuning.Pythagorean, Step: 4, Expected: 407.820},
+		{System: tuning.Pythagorean, Step: 1, Expected: 90.225},
+		{System: tuning.Pythagorean, Step: 6, Expected: 611.730},
+		{System: tuning.QuarterCommaMeantone, Step: 4, Expected: 386.314},
+		{System: tuning.QuarterCommaMeantone, Step: 7, Expected: 696.578},
+		{System: tuning.QuarterCommaMeantone, Step: 3, Expected: 310.265},
+		{System: tuning.JustIntonation, Step: 4, Expected: 386.314},
+		{System: tuning.JustIntonation, Step: 9, Expected: 884.359},
+		{System: tuning.WerckmeisterIII, Step: 1, Expected: 90.225},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.System.String(), func(t *testing.T) {
+			cents := tc.System.Cents()
+			assert.Len(t, cents, 12)
+			assert.Zero(t, cents[0])
+			assert.InDelta(t, tc.Expected, cents[tc.Step], 0.001)
+		})
+	}
+
+	assert.Empty(t, tuning.Invalid.Cents())
+}
+
+func TestTuning_Frequency(t *testing.T) {
+	assert.InDelta(t, 440, tuning.Default().Frequency(pitch.NewNote(pitch.ANatural, 4)), 0.001)
+	assert.InDelta(t, 261.626, tuning.Default().Frequency(pitch.NewNote(pitch.CNatural, 4)), 0.001)
+	assert.InDelta(t, 415, tuning.New(tuning.EqualTemperament, 415, pitch.CNatural).Frequency(pitch.NewNote(pitch.ANatural, 4)), 0.001)
+	assert.InDelta(t, 216, tuning.New(tuning.EqualTemperament, 432, pitch.CNatural).Frequency(pitch.NewNote(pitch.ANatural, 3)), 0.001)
+
+	// just major sixth below A4 and major third above C4
+	just := tuning.New(tuning.JustIntonation, 440, pitch.CNatural)
+	assert.InDelta(t, 264, just.Frequency(pitch.NewNote(pitch.CNatural, 4)), 0.001)
+	assert.InDelta(t, 330, just.Frequency(pitch.NewNote(pitch.ENatural, 4)), 0.001)
+
+	// baroque pitch on a well temperament built from C
+	werckmeister := tuning.New(tuning.WerckmeisterIII, 415, pitch.CNatural)
+	assert.InDelta(t, 415, werckmeister.Frequency(pitch.NewNote(pitch.ANatural, 4)), 0.001)
+
+	// pure fifth above A4 when tonic is A
+	pythagorean := tuning.New(tuning.Pythagorean, 440, pitch.ANatural)
+	assert.InDelta(t, 660, pythagorean.Frequency(pitch.NewNote(pitch.ENatural, 5)), 0.001)
+	assert.InDelta(t, 440, pythagorean.PitchFrequency(pitch.ANatural), 0.001)
+	assert.InDelta(t, 330, pythagorean.Frequency(pitch.NewNote(pitch.ENatural, 4)), 0.001)
+}
+
+func TestTuning_ReferenceIsA4(t *testing.T) {
+	for _, system := range tuning.AllSystems() {
+		for _, tonic := range []pitch.Type{pitch.CNatural, pitch.DNatural, pitch.DSharp, pitch.FSharp, pitch.ANatural, pitch.BNatural} {
+			for _, reference := range []float64{415, 440} {
+				instance := tuning.New(system, reference, tonic)
+				assert.InDelta(t, reference, instance.PitchFrequency(pitch.ANatural), 1e-9, "%s on %s", system, tonic)
+				assert.InDelta(t, 0, instance.Deviation(pitch.ANatural), 1e-9, "%s on %s", system, tonic)
+			}
+		}
+	}
+}
+
+func TestTuning_Deviation(t *testing.T) {
+	just := tuning.New(tuning.JustIntonation, 440, pitch.DNatural)
+	assert.InDelta(t, -15.641, just.Deviation(pitch.FSharp), 0.001)
+	assert.InDelta(t, -1.955, just.Deviation(pitch.DNatural), 0.001)
+	assert.Zero(t, tuning.Default().Deviation(pitch.FSharp))
+}
+
+func TestFromString(t *testing.T) {
+	assert.Equal(t, tuning.JustIntonation, tuning.FromString("just_intonation"))
+	assert.Equal(t, tuning.WerckmeisterIII, tuning.FromString("WerckmeisterIII"))
+	assert.Equal(t, tuning.Invalid, tuning.FromString("unknown"))
+}
+
+func TestNew(t *testing.T) {
+	assert.Equal(t, tuning.Tuning{System: tuning.Pythagorean, Reference: 440, Tonic: pitch.CNatural}, tuning.New(tuning.Pythagorean, 0, pitch.Invalid))
+}
@@ -0,0 +1,248 @@
+package voicing
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+)
+
+// Voice leading errors
+var (
+	ErrVoicingNotFound = errors.New("voicing not found")
+	ErrTooManyVoicings = errors.New("too many voicings")
+)
+
+// MaxVoicings is maximum count of voicings of each chord, voicings of consecutive chords are compared pairwise
+const MaxVoicings = 384
+
+// MaxSpan is the widest range in semitones voices may take, four octaves
+const MaxSpan = 48
+
+// MaxBassGap is the widest interval in semitones between the lowest voice and the voice above it, two octaves
+const MaxBassGap = 24
+
+// Range is an inclusive range of notes voices may take
+type Range struct {
+	Low  pitch.Note
+	High pitch.Note
+}
+
+// DefaultRange spans from E2 to G5, roughly from the lowest bass note to the highest soprano note
+var DefaultRange = Range{Low: pitch.NewNote(pitch.ENatural, 2), High: pitch.NewNote(pitch.GNatural, 5)}
+
+// Valid returns true when both ends are valid notes within MIDI note numbers and the lowest note is below the highest
+// one by at most four octaves
+func (r Range) Valid() bool {
+	return r.Low.Valid() && r.High.Valid() && r.Low.Midi() >= 0 && r.High.Midi() <= 127 &&
+		r.Low.Midi() < r.High.Midi() && r.High.Midi()-r.Low.Midi() <= MaxSpan
+}
+
+// Voicing is chord notes assigned to voices, from the lowest voice to the highest one
+type Voicing []pitch.Note
+
+// Movement returns total semitones moved by voices from previous voicing of the same voice count
+func (v Voicing) Movement(previous Voicing) int {
+	total := 0
+	for i := range min(len(v), len(previous)) {
+		total += abs(v[i].Midi() - previous[i].Midi())
+	}
+
+	return total
+}
+
+// Lead returns voicing of each chord with given voice count within range, minimizing total semitone movement between
+// consecutive chords. The lowest voice takes the chord bass at most two octaves below the voice above it, upper voices
+// are at most an octave apart and every chord
+// tone is voiced, when there are fewer voices than chord tones the fifth is omitted first. Consecutive voicings
+// avoid parallel fifths and octaves and voices do not cross or overlap the previous position of their neighbours.
+// ErrVoicingNotFound is returned when chords can not be voiced under these rules and ErrTooManyVoicings when a chord
+// has more than MaxVoicings voicings within range.
+func Lead(chords []chord.Chord, voices int, r Range) ([]Voicing, error) {
+	if len(chords) == 0 || voices < 1 || !r.Valid() {
+		return nil, ErrVoicingNotFound
+	}
+
+	// cheapest movement to reach each candidate of current chord, with candidate of previous chord it came from
+	candidates := make([][]Voicing, 0)
+	costs := make([][]int, 0)
+	origins := make([][]int, 0)
+	for i, c := range chords {
+		current := candidateVoicings(c, voices, r)
+		switch {
+		case len(current) == 0:
+			return nil, ErrVoicingNotFound
+		case len(current) > MaxVoicings:
+			return nil, ErrTooManyVoicings
+		}
+
+		cost := make([]int, len(current))
+		origin := make([]int, len(current))
+		for j, v := range current {
+			if i == 0 {
+				continue
+			}
+
+			cost[j], origin[j] = -1, -1
+			for k, previous := range candidates[i-1] {
+				if costs[i-1][k] < 0 || !smooth(previous, v) {
+					continue
+				}
+
+				if total := costs[i-1][k] + v.Movement(previous); cost[j] < 0 || total < cost[j] {
+					cost[j], origin[j] = total, k
+				}
+			}
+		}
+
+		candidates = append(candidates, current)
+		costs = append(costs, cost)
+		origins = append(origins, origin)
+	}
+
+	// trace back from the cheapest voicing of the last chord
+	last := len(chords) - 1
+	best := -1
+	for j, v := range costs[last] {
+		if v >= 0 && (best < 0 || v < costs[last][best]) {
+			best = j
+		}
+	}
+
+	if best < 0 {
+		return nil, ErrVoicingNotFound
+	}
+
+	voicings := make([]Voicing, len(chords))
+	for i := last; i >= 0; i-- {
+		voicings[i] = candidates[i][best]
+		best = origins[i][best]
+	}
+
+	return voicings, nil
+}
+
+// candidateVoicings returns voicings of a chord within range ordered from the lowest, it stops once there are more than
+// MaxVoicings of them
+func candidateVoicings(c chord.Chord, voices int, r Range) []Voicing {
+	pitches := c.Pitches()
+	if len(pitches) == 0 {
+		return nil
+	}
+
+	notes := make([]pitch.Note, 0)
+	for _, v := range pitch.NoteRange(r.Low, r.High) {
+		if slices.Contains(pitches, v.Pitch) {
+			notes = append(notes, v)
+		}
+	}
+
+	required := requiredPitches(c, voices)
+	candidates := make([]Voicing, 0)
+
+	var voice func(current Voicing, from int)
+	voice = func(current Voicing, from int) {
+		if len(candidates) > MaxVoicings {
+			return
+		}
+
+		if len(current) == voices {
+			for _, v := range required {
+				if !slices.ContainsFunc(current, func(n pitch.Note) bool { return n.Pitch == v }) {
+					return
+				}
+			}
+
+			candidates = append(candidates, slices.Clone(current))
+			return
+		}
+
+		for i := from; i < len(notes); i++ {
+			switch {
+			case len(current) == 0 && notes[i].Pitch != pitches[0]:
+				continue
+			case len(current) == 1 && notes[i].Midi()-current[0].Midi() > MaxBassGap:
+				return
+			case len(current) > 1 && notes[i].Midi()-current[len(current)-1].Midi() > 12:
+				return
+			}
+
+			voice(append(current, notes[i]), i+1)
+		}
+	}
+	voice(Voicing{}, 0)
+
+	return candidates
+}
+
+// requiredPitches returns pitches every voicing must take, the bass followed by the root, thirds, sevenths, tensions
+// from the highest and finally the fifth, cut to voice count
+func requiredPitches(c chord.Chord, voices int) []pitch.Type {
+	rank := func(interval int) int {
+		switch interval % 12 {
+		case 0:
+			return 0
+		case 3, 4:
+			return 1
+		case 10, 11:
+			return 2
+		case 7:
+			return 4
+		default:
+			return 3
+		}
+	}
+
+	bass := c.Pitches()[0]
+	intervals := slices.Clone(c.Quality.Intervals())
+	slices.SortStableFunc(intervals, func(a, b int) int {
+		if rank(a) != rank(b) {
+			return rank(a) - rank(b)
+		}
+
+		return b - a
+	})
+
+	required := []pitch.Type{bass}
+	for _, v := range intervals {
+		if p := c.Root.Transpose(v); !slices.Contains(required, p) {
+			required = append(required, p)
+		}
+	}
+
+	return required[:min(voices, len(required))]
+}
+
+// smooth returns true when moving from previous voicing to next one has no parallel fifths or octaves and no voice
+// crosses or overlaps the previous position of its neighbours
+func smooth(previous Voicing, next Voicing) bool {
+	for i := range next {
+		if i > 0 && next[i].Midi() < previous[i-1].Midi() {
+			return false
+		}
+
+		if i < len(next)-1 && next[i].Midi() > previous[i+1].Midi() {
+			return false
+		}
+
+		for j := i + 1; j < len(next); j++ {
+			before := (previous[j].Midi() - previous[i].Midi()) % 12
+			after := (next[j].Midi() - next[i].Midi()) % 12
+			moved := previous[i] != next[i] && previous[j] != next[j]
+			if moved && before == after && (after == 0 || after == 7) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+
+	return v
+}
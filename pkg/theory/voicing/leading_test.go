@@ -0,0 +1,126 @@
+package voicing_test
+
+import (
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+	"github.com/edipermadi/music-db/pkg/theory/voicing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parseChords(t *testing.T, symbols ...string) []chord.Chord {
+	chords := make([]chord.Chord, 0)
+	for _, v := range symbols {
+		c, err := chord.Parse(v)
+		require.NoError(t, err)
+		chords = append(chords, c)
+	}
+
+	return chords
+}
+
+func TestLead(t *testing.T) {
+	chords := parseChords(t, "Dm7", "G7", "Cmaj7")
+	voicings, err := voicing.Lead(chords, 4, voicing.DefaultRange)
+	require.NoError(t, err)
+	require.Len(t, voicings, 3)
+
+	for i, v := range voicings {
+		require.Len(t, v, 4)
+
+		// the lowest voice takes the bass and voices ascend within range
+		assert.Equal(t, chords[i].Pitches()[0], v[0].Pitch)
+		for j := range v {
+			assert.Contains(t, chords[i].Pitches(), v[j].Pitch)
+			assert.GreaterOrEqual(t, v[j].Midi(), voicing.DefaultRange.Low.Midi())
+			assert.LessOrEqual(t, v[j].Midi(), voicing.DefaultRange.High.Midi())
+			if j > 0 {
+				assert.Less(t, v[j-1].Midi(), v[j].Midi())
+			}
+		}
+	}
+
+	// upper voices move by step at most, the seventh resolves down to the third
+	for i := 1; i < len(voicings); i++ {
+		for j := 1; j < 4; j++ {
+			assert.LessOrEqual(t, voicing.Voicing{voicings[i][j]}.Movement(voicing.Voicing{voicings[i-1][j]}), 2)
+		}
+	}
+	assert.Equal(t, "F4", voicings[1][2].String())
+	assert.Equal(t, "E4", voicings[2][1].String())
+}
+
+func TestLead_ParallelFifths(t *testing.T) {
+	// moving root position triads by step invites parallel fifths between the bass and an upper voice
+	voicings, err := voicing.Lead(parseChords(t, "C", "D", "E"), 3, voicing.DefaultRange)
+	require.NoError(t, err)
+
+	for i := 1; i < len(voicings); i++ {
+		previous, next := voicings[i-1], voicings[i]
+		for a := range next {
+			for b := a + 1; b < len(next); b++ {
+				before := (previous[b].Midi() - previous[a].Midi()) % 12
+				after := (next[b].Midi() - next[a].Midi()) % 12
+				if previous[a] != next[a] && previous[b] != next[b] {
+					assert.False(t, before == after && (after == 0 || after == 7), "parallel interval between voices %d and %d", a, b)
+				}
+			}
+		}
+	}
+}
+
+func TestLead_FewerVoices(t *testing.T) {
+	// the fifth is omitted first
+	voicings, err := voicing.Lead(parseChords(t, "G7"), 3, voicing.DefaultRange)
+	require.NoError(t, err)
+
+	pitches := make([]pitch.Type, 0)
+	for _, v := range voicings[0] {
+		pitches = append(pitches, v.Pitch)
+	}
+	assert.ElementsMatch(t, []pitch.Type{pitch.GNatural, pitch.BNatural, pitch.FNatural}, pitches)
+}
+
+func TestLead_NotFound(t *testing.T) {
+	_, err := voicing.Lead(nil, 4, voicing.DefaultRange)
+	assert.ErrorIs(t, err, voicing.ErrVoicingNotFound)
+
+	_, err = voicing.Lead(parseChords(t, "C"), 0, voicing.DefaultRange)
+	assert.ErrorIs(t, err, voicing.ErrVoicingNotFound)
+
+	narrow := voicing.Range{Low: pitch.NewNote(pitch.CNatural, 4), High: pitch.NewNote(pitch.DNatural, 4)}
+	_, err = voicing.Lead(parseChords(t, "C"), 4, narrow)
+	assert.ErrorIs(t, err, voicing.ErrVoicingNotFound)
+
+	inverted := voicing.Range{Low: pitch.NewNote(pitch.CNatural, 5), High: pitch.NewNote(pitch.CNatural, 4)}
+	assert.False(t, inverted.Valid())
+	_, err = voicing.Lead(parseChords(t, "C"), 4, inverted)
+	assert.ErrorIs(t, err, voicing.ErrVoicingNotFound)
+}
+
+func TestLead_Limits(t *testing.T) {
+	// ranges beyond four octaves or MIDI note numbers are invalid
+	wide := voicing.Range{Low: pitch.NewNote(pitch.CNatural, -1), High: pitch.NewNote(pitch.GNatural, 9)}
+	assert.False(t, wide.Valid())
+	_, err := voicing.Lead(parseChords(t, "C"), 4, wide)
+	assert.ErrorIs(t, err, voicing.ErrVoicingNotFound)
+
+	beyond := voicing.Range{Low: pitch.NewNote(pitch.CNatural, 8), High: pitch.NewNote(pitch.CNatural, 10)}
+	assert.False(t, beyond.Valid())
+
+	octaves := voicing.Range{Low: pitch.NewNote(pitch.CNatural, 2), High: pitch.NewNote(pitch.CNatural, 6)}
+	assert.True(t, octaves.Valid())
+
+	// the lowest voice stays within two octaves below the voice above it
+	voicings, err := voicing.Lead(parseChords(t, "C", "F", "G7", "C"), 5, octaves)
+	require.NoError(t, err)
+	for _, v := range voicings {
+		assert.LessOrEqual(t, v[1].Midi()-v[0].Midi(), voicing.MaxBassGap)
+	}
+
+	// six voices of a diminished seventh chord over four octaves have too many voicings to compare
+	_, err = voicing.Lead(parseChords(t, "Cdim7", "Cmaj7#11"), 6, octaves)
+	assert.ErrorIs(t, err, voicing.ErrTooManyVoicings)
+}
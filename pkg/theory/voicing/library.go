@@ -0,0 +1,275 @@
+package voicing
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/pitch"
+)
+
+// Type is a type for chord voicing
+type Type int
+
+// Chord voicing enumerations
+const (
+	Invalid   Type = iota
+	Close     Type = iota
+	Open      Type = iota
+	Drop2     Type = iota
+	Drop3     Type = iota
+	Drop24    Type = iota
+	Shell     Type = iota
+	RootlessA Type = iota
+	RootlessB Type = iota
+	Quartal   Type = iota
+	SoWhat    Type = iota
+)
+
+// AllTypes returns all chord voicings
+func AllTypes() []Type {
+	return []Type{
+		Close,
+		Open,
+		Drop2,
+		Drop3,
+		Drop24,
+		Shell,
+		RootlessA,
+		RootlessB,
+		Quartal,
+		SoWhat,
+	}
+}
+
+// String returns chord voicing name
+func (t Type) String() string {
+	if t < Close || t > SoWhat {
+		return "Invalid"
+	}
+
+	return [...]string{
+		"Invalid",
+		"Close",
+		"Open",
+		"Drop2",
+		"Drop3",
+		"Drop24",
+		"Shell",
+		"RootlessA",
+		"RootlessB",
+		"Quartal",
+		"SoWhat",
+	}[t]
+}
+
+// Identifier returns chord voicing identifier as used in query parameters, such as "drop_2"
+func (t Type) Identifier() string {
+	if t < Close || t > SoWhat {
+		return "invalid"
+	}
+
+	return [...]string{
+		"invalid",
+		"close",
+		"open",
+		"drop_2",
+		"drop_3",
+		"drop_2_4",
+		"shell",
+		"rootless_a",
+		"rootless_b",
+		"quartal",
+		"so_what",
+	}[t]
+}
+
+// FromString returns chord voicing from its name or identifier, such as "Drop2" or "drop_2"
+func FromString(name string) Type {
+	for _, v := range AllTypes() {
+		if v.String() == name || v.Identifier() == name {
+			return v
+		}
+	}
+
+	return Invalid
+}
+
+// Voice returns chord notes in the voicing from the lowest one. Close position stacks chord pitches from the bass at
+// given octave within an octave, open position raises every other voice of it by an octave and drop voicings lower the
+// second, third or second and fourth highest voice of it by an octave, the latter two need four voices at least. Shell
+// voicings take the bass with the third and seventh, rootless voicings take the third, fifth, seventh and ninth
+// starting from the third for type A or from the seventh for type B, where the thirteenth replaces the fifth and the
+// major ninth is added when the chord has none. Quartal voicings stack the longest chain of chord pitches a perfect
+// fourth apart, three of them at least, such as E A D G C F of Dm11 or G C F B♭ of C7sus4. So What voicings stack three
+// perfect fourths and a major third on a chord pitch, from the root when possible, which suits minor eleventh chords.
+// Found is false when the chord lacks tones the voicing is built from.
+func (t Type) Voice(c chord.Chord, octave int) (Voicing, bool) {
+	pitches := c.Pitches()
+	if len(pitches) == 0 {
+		return nil, false
+	}
+
+	closed := Voicing(pitch.Slice(pitches).Notes(octave))
+	switch t {
+	case Close:
+		return closed, true
+	case Open:
+		for i := 1; i < len(closed); i += 2 {
+			closed[i] = closed[i].Transpose(12)
+		}
+		return sorted(closed), true
+	case Drop2:
+		return drop(closed, 2)
+	case Drop3, Drop24:
+		if len(closed) < 4 {
+			return nil, false
+		}
+		if t == Drop3 {
+			return drop(closed, 3)
+		}
+		dropped, _ := drop(closed, 4)
+		return drop(dropped, 2)
+	case Shell:
+		third, hasThird := tone(c.Quality, 3, 4, 2, 5)
+		seventh, hasSeventh := tone(c.Quality, 10, 11, 9)
+		if !hasThird || !hasSeventh {
+			return nil, false
+		}
+		guides := make([]pitch.Type, 0)
+		for _, v := range []int{third, seventh} {
+			if p := c.Root.Transpose(v); p != pitches[0] {
+				guides = append(guides, p)
+			}
+		}
+		return stacked(octave, pitches[0], guides...), true
+	case RootlessA, RootlessB:
+		third, hasThird := tone(c.Quality, 3, 4)
+		seventh, hasSeventh := tone(c.Quality, 10, 11, 9)
+		if !hasThird || !hasSeventh {
+			return nil, false
+		}
+		fifth, hasFifth := tone(c.Quality, 21, 20, 7, 6, 8)
+		if !hasFifth {
+			return nil, false
+		}
+		ninth, hasNinth := tone(c.Quality, 14, 13, 15)
+		if !hasNinth {
+			ninth = 14
+		}
+
+		tones := []int{third, fifth, seventh, ninth}
+		if t == RootlessB {
+			tones = []int{seventh, ninth, third, fifth}
+		}
+
+		voiced := make([]pitch.Type, 0)
+		for _, v := range tones {
+			voiced = append(voiced, c.Root.Transpose(v))
+		}
+		return stacked(octave, voiced[0], voiced[1:]...), true
+	case Quartal:
+		return quartal(c, octave)
+	case SoWhat:
+		for _, v := range c.Quality.Intervals() {
+			first := c.Root.Transpose(v)
+			if chordTones(pitches, first, 0, 5, 10, 15, 19) {
+				return intervals(first, octave, 0, 5, 10, 15, 19), true
+			}
+		}
+		return nil, false
+	default:
+		return nil, false
+	}
+}
+
+// tone returns the first of given intervals found among chord tones
+func tone(q chord.Quality, candidates ...int) (int, bool) {
+	for _, v := range candidates {
+		if slices.Contains(q.Intervals(), v) {
+			return v, true
+		}
+	}
+
+	return 0, false
+}
+
+// chordTones returns true when pitches at given semitones above the first one are all chord pitches
+func chordTones(pitches []pitch.Type, first pitch.Type, semitones ...int) bool {
+	for _, v := range semitones {
+		if !slices.Contains(pitches, first.Transpose(v)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// quartal returns the longest chain of chord pitches a perfect fourth apart, chains start from a chord pitch without a
+// chord pitch a fourth below it and the earliest one from the root is taken among chains of the same length
+func quartal(c chord.Chord, octave int) (Voicing, bool) {
+	pitches := c.Pitches()
+	var first pitch.Type
+	longest := 0
+	for _, v := range c.Quality.Intervals() {
+		start := c.Root.Transpose(v)
+		if slices.Contains(pitches, start.Transpose(-5)) {
+			continue
+		}
+
+		count := 1
+		for count < len(pitches) && slices.Contains(pitches, start.Transpose(5*count)) {
+			count++
+		}
+
+		if count > longest {
+			first, longest = start, count
+		}
+	}
+
+	if longest < 3 {
+		return nil, false
+	}
+
+	semitones := make([]int, 0)
+	for i := range longest {
+		semitones = append(semitones, 5*i)
+	}
+
+	return intervals(first, octave, semitones...), true
+}
+
+// drop lowers the n-th highest voice by an octave
+func drop(v Voicing, n int) (Voicing, bool) {
+	if len(v) < n || n < 2 {
+		return nil, false
+	}
+
+	dropped := slices.Clone(v)
+	dropped[len(v)-n] = dropped[len(v)-n].Transpose(-12)
+	return sorted(dropped), true
+}
+
+// stacked returns notes where the first pitch is placed at given octave and each next pitch is placed at the closest
+// position above the previous one
+func stacked(octave int, first pitch.Type, rest ...pitch.Type) Voicing {
+	return pitch.Slice(append([]pitch.Type{first}, rest...)).Notes(octave)
+}
+
+// intervals returns notes at given semitones above the root placed at given octave
+func intervals(root pitch.Type, octave int, semitones ...int) Voicing {
+	notes := make(Voicing, 0)
+	for _, v := range semitones {
+		notes = append(notes, pitch.NewNote(root, octave).Transpose(v))
+	}
+
+	return notes
+}
+
+func sorted(v Voicing) Voicing {
+	sort.SliceStable(v, func(i, j int) bool {
+		return v[i].Midi() < v[j].Midi()
+	})
+
+	return v
+}
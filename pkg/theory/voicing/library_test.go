@@ -0,0 +1,90 @@
+package voicing_test
+
+import (
+	"testing"
+
+	"github.com/edipermadi/music-db/pkg/theory/chord"
+	"github.com/edipermadi/music-db/pkg/theory/voicing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromString(t *testing.T) {
+	for _, v := range voicing.AllTypes() {
+		assert.Equal(t, v, voicing.FromString(v.String()))
+		assert.Equal(t, v, voicing.FromString(v.Identifier()))
+	}
+
+	assert.Equal(t, voicing.Invalid, voicing.FromString("cluster"))
+	assert.Equal(t, "Invalid", voicing.Invalid.String())
+	assert.Equal(t, "invalid", voicing.Invalid.Identifier())
+}
+
+func TestType_Voice(t *testing.T) {
+	type testCase struct {
+		Voicing  voicing.Type
+		Chord    string
+		Expected []string
+	}
+
+	testCases := []testCase{
+		{Voicing: voicing.Close, Chord: "Cmaj7", Expected: []string{"C4", "E4", "G4", "B4"}},
+		{Voicing: voicing.Close, Chord: "C/E", Expected: []string{"E4", "G4", "C5"}},
+		{Voicing: voicing.Open, Chord: "C", Expected: []string{"C4", "G4", "E5"}},
+		{Voicing: voicing.Open, Chord: "Cmaj7", Expected: []string{"C4", "G4", "E5", "B5"}},
+		{Voicing: voicing.Drop2, Chord: "Cmaj7", Expected: []string{"G3", "C4", "E4", "B4"}},
+		{Voicing: voicing.Drop3, Chord: "Cmaj7", Expected: []string{"E3", "C4", "G4", "B4"}},
+		{Voicing: voicing.Drop24, Chord: "Cmaj7", Expected: []string{"C3", "G3", "E4", "B4"}},
+		{Voicing: voicing.Shell, Chord: "G7", Expected: []string{"G4", "B4", "F5"}},
+		{Voicing: voicing.Shell, Chord: "Dm7", Expected: []string{"D4", "F4", "C5"}},
+		{Voicing: voicing.RootlessA, Chord: "Dm7", Expected: []string{"F4", "A4", "C5", "E5"}},
+		{Voicing: voicing.RootlessB, Chord: "Dm7", Expected: []string{"C4", "E4", "F4", "A4"}},
+		{Voicing: voicing.RootlessA, Chord: "G13", Expected: []string{"B4", "E5", "F5", "A5"}},
+		{Voicing: voicing.RootlessB, Chord: "G7b9", Expected: []string{"F4", "G#4", "B4", "D5"}},
+		{Voicing: voicing.Quartal, Chord: "Dm11", Expected: []string{"E4", "A4", "D5", "G5", "C6", "F6"}},
+		{Voicing: voicing.Quartal, Chord: "C7sus4", Expected: []string{"G4", "C5", "F5", "A#5"}},
+		{Voicing: voicing.Quartal, Chord: "Csus4", Expected: []string{"G4", "C5", "F5"}},
+		{Voicing: voicing.SoWhat, Chord: "Em11", Expected: []string{"E4", "A4", "D5", "G5", "B5"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Voicing.String()+tc.Chord, func(t *testing.T) {
+			c, err := chord.Parse(tc.Chord)
+			require.NoError(t, err)
+
+			notes, found := tc.Voicing.Voice(c, 4)
+			require.True(t, found)
+
+			voiced := make([]string, 0)
+			for _, v := range notes {
+				voiced = append(voiced, v.String())
+			}
+			assert.Equal(t, tc.Expected, voiced)
+		})
+	}
+}
+
+func TestType_Voice_NotFound(t *testing.T) {
+	triad, err := chord.Parse("C")
+	require.NoError(t, err)
+
+	for _, v := range []voicing.Type{voicing.Drop3, voicing.Drop24, voicing.Shell, voicing.RootlessA, voicing.RootlessB, voicing.Invalid} {
+		_, found := v.Voice(triad, 4)
+		assert.False(t, found, v.String())
+	}
+
+	_, found := voicing.Close.Voice(chord.Chord{}, 4)
+	assert.False(t, found)
+
+	// fourths stacked on chords without fourths between their pitches are not chord tones
+	for _, v := range []string{"C", "Cmaj7", "Dm7", "G7", "Em7"} {
+		c, err := chord.Parse(v)
+		require.NoError(t, err)
+
+		_, found = voicing.Quartal.Voice(c, 4)
+		assert.False(t, found, v)
+
+		_, found = voicing.SoWhat.Voice(c, 4)
+		assert.False(t, found, v)
+	}
+}